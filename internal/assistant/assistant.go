@@ -0,0 +1,88 @@
+// Package assistant 定义群组助手的回复接口。
+// 群组启用助手后，成员在消息中 @助手 即可触发回复，具体的回复逻辑由注册的 Responder 实现，
+// 接入大模型等外部服务时只需实现 Responder 并在启动时调用 Register 注册。
+package assistant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrUnknownResponder 未注册的回复器名称
+	ErrUnknownResponder = errors.New("unknown assistant responder")
+	// ErrInvalidConfig 回复器配置无效
+	ErrInvalidConfig = errors.New("invalid assistant config")
+)
+
+// Message 会话上下文中的一条消息，按时间从早到晚排列
+type Message struct {
+	SenderID      string
+	SenderName    string
+	Content       string
+	FromAssistant bool // 是否为助手自己之前的回复
+	CreatedAt     time.Time
+}
+
+// Request 一次助手调用的输入
+type Request struct {
+	GroupID       string
+	GroupName     string
+	AssistantName string
+	UserID        string // 提及助手的用户
+	Username      string
+	Prompt        string    // 去掉 @助手 之后的消息内容
+	History       []Message // 触发消息之前的群聊记录，不包含触发消息本身
+}
+
+// Responder 助手回复器。Respond 通过 emit 逐段输出回复内容，
+// emit 返回错误（如上下文取消）时应停止生成并返回该错误
+type Responder interface {
+	Respond(ctx context.Context, req *Request, emit func(delta string) error) error
+}
+
+// Factory 根据群组保存的配置创建回复器，config 为空表示使用默认配置
+type Factory func(config json.RawMessage) (Responder, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = map[string]Factory{
+		EchoResponderName: NewEchoResponder,
+		RuleResponderName: NewRuleResponder,
+	}
+)
+
+// Register 注册回复器，名称重复时覆盖已有的注册
+func Register(name string, factory Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+	factories[name] = factory
+}
+
+// New 按名称和配置创建回复器
+func New(name string, config json.RawMessage) (Responder, error) {
+	factoriesMu.RLock()
+	factory, ok := factories[name]
+	factoriesMu.RUnlock()
+	if !ok {
+		return nil, ErrUnknownResponder
+	}
+	return factory(config)
+}
+
+// Names 返回所有已注册的回复器名称
+func Names() []string {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,114 @@
+package assistant
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+const (
+	// EchoResponderName 原样复述提问的回复器，用于测试和联调
+	EchoResponderName = "echo"
+	// RuleResponderName 按规则匹配提问给出固定回复的回复器
+	RuleResponderName = "rule"
+
+	defaultRuleFallback = "抱歉，我还不知道怎么回答这个问题。"
+)
+
+// EchoResponder 逐词复述提问，不依赖任何外部服务
+type EchoResponder struct{}
+
+// NewEchoResponder 创建复述回复器，忽略配置
+func NewEchoResponder(json.RawMessage) (Responder, error) {
+	return EchoResponder{}, nil
+}
+
+func (EchoResponder) Respond(ctx context.Context, req *Request, emit func(delta string) error) error {
+	prompt := strings.TrimSpace(req.Prompt)
+	if prompt == "" {
+		return emit("@" + req.Username + " 你好，有什么可以帮你？")
+	}
+
+	if err := emit("@" + req.Username + " "); err != nil {
+		return err
+	}
+	return streamWords(ctx, prompt, emit)
+}
+
+// RuleConfig 规则回复器的配置
+type RuleConfig struct {
+	Rules []struct {
+		Pattern string `json:"pattern"` // 正则表达式，匹配去掉 @助手 后的提问
+		Reply   string `json:"reply"`
+	} `json:"rules"`
+	Fallback string `json:"fallback"` // 没有规则命中时的回复
+}
+
+type ruleResponderRule struct {
+	re    *regexp.Regexp
+	reply string
+}
+
+// RuleResponder 按配置顺序匹配提问，使用第一条命中规则的回复
+type RuleResponder struct {
+	rules    []ruleResponderRule
+	fallback string
+}
+
+// NewRuleResponder 根据配置创建规则回复器，规则的正则表达式无效时返回 ErrInvalidConfig
+func NewRuleResponder(config json.RawMessage) (Responder, error) {
+	var cfg RuleConfig
+	if len(config) > 0 {
+		if err := json.Unmarshal(config, &cfg); err != nil {
+			return nil, ErrInvalidConfig
+		}
+	}
+
+	responder := &RuleResponder{fallback: cfg.Fallback}
+	if responder.fallback == "" {
+		responder.fallback = defaultRuleFallback
+	}
+	for _, rule := range cfg.Rules {
+		re, err := regexp.Compile(rule.Pattern)
+		if err != nil || rule.Reply == "" {
+			return nil, ErrInvalidConfig
+		}
+		responder.rules = append(responder.rules, ruleResponderRule{re: re, reply: rule.Reply})
+	}
+
+	return responder, nil
+}
+
+func (r *RuleResponder) Respond(ctx context.Context, req *Request, emit func(delta string) error) error {
+	reply := r.fallback
+	for _, rule := range r.rules {
+		if rule.re.MatchString(req.Prompt) {
+			reply = rule.reply
+			break
+		}
+	}
+
+	reply = strings.NewReplacer("{username}", req.Username, "{group}", req.GroupName).Replace(reply)
+	return streamWords(ctx, reply, emit)
+}
+
+// streamWords 按空白切分文本逐段输出，保留原有的空白，模拟流式生成
+func streamWords(ctx context.Context, text string, emit func(delta string) error) error {
+	start := 0
+	for i, r := range text {
+		if i > start && r == ' ' {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := emit(text[start:i]); err != nil {
+				return err
+			}
+			start = i
+		}
+	}
+	if start < len(text) {
+		return emit(text[start:])
+	}
+	return nil
+}
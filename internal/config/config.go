@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -14,6 +15,8 @@ type Config struct {
 	Database DatabaseConfig `yaml:"database"`
 	Redis    RedisConfig    `yaml:"redis"`
 	JWT      JWTConfig      `yaml:"jwt"`
+	Storage  StorageConfig  `yaml:"storage"`
+	Group    GroupConfig    `yaml:"group"`
 }
 
 // ServerConfig 服务器配置
@@ -47,6 +50,21 @@ type JWTConfig struct {
 	RefreshExpiry int    `yaml:"refreshExpiry"`
 }
 
+// StorageConfig 本地文件存储配置
+type StorageConfig struct {
+	Dir string `yaml:"dir"` // 存储根目录，为空时使用 ./storage
+}
+
+// GroupConfig 群组配置
+type GroupConfig struct {
+	JoinRequestExpiry int `yaml:"joinRequestExpiry"` // 入群申请的有效期（小时），为 0 时使用 168 小时
+}
+
+const (
+	defaultStorageDir        = "storage"
+	defaultJoinRequestExpiry = 7 * 24 * time.Hour
+)
+
 var (
 	// GlobalConfig 全局配置实例
 	GlobalConfig *Config
@@ -99,3 +117,19 @@ func (c *DatabaseConfig) GetDSN() string {
 func (c *RedisConfig) GetRedisAddr() string {
 	return fmt.Sprintf("%s:%d", c.Host, c.Port)
 }
+
+// GetStorageDir 获取本地文件存储根目录
+func GetStorageDir() string {
+	if GlobalConfig == nil || GlobalConfig.Storage.Dir == "" {
+		return defaultStorageDir
+	}
+	return GlobalConfig.Storage.Dir
+}
+
+// GetJoinRequestExpiry 获取入群申请的有效期，超过有效期未审批的申请自动过期
+func GetJoinRequestExpiry() time.Duration {
+	if GlobalConfig == nil || GlobalConfig.Group.JoinRequestExpiry <= 0 {
+		return defaultJoinRequestExpiry
+	}
+	return time.Duration(GlobalConfig.Group.JoinRequestExpiry) * time.Hour
+}
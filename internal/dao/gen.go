@@ -16,74 +16,199 @@ import (
 )
 
 var (
-	Q                = new(Query)
-	Friend           *friend
-	FriendRequest    *friendRequest
-	Group            *group
-	GroupJoinRequest *groupJoinRequest
-	GroupMember      *groupMember
-	InvitationCode   *invitationCode
-	Message          *message
-	MessageReceipt   *messageReceipt
-	User             *user
+	Q                   = new(Query)
+	BotToken            *botToken
+	CardAction          *cardAction
+	CardMessage         *cardMessage
+	ConversationSetting *conversationSetting
+	ExportJob           *exportJob
+	Friend              *friend
+	FriendRequest       *friendRequest
+	Group               *group
+	GroupAssistant      *groupAssistant
+	GroupAuditLog       *groupAuditLog
+	GroupAutoReply      *groupAutoReply
+	GroupBan            *groupBan
+	GroupCommand        *groupCommand
+	GroupJoinRequest    *groupJoinRequest
+	GroupJoinRule       *groupJoinRule
+	GroupMember         *groupMember
+	GroupPermission     *groupPermission
+	GroupTag            *groupTag
+	GroupTopic          *groupTopic
+	GroupTopicRead      *groupTopicRead
+	ImportJob           *importJob
+	ImportMapping       *importMapping
+	IncomingWebhook     *incomingWebhook
+	InvitationCode      *invitationCode
+	InvitationUse       *invitationUse
+	Message             *message
+	MessageReceipt      *messageReceipt
+	Poll                *poll
+	PollOption          *pollOption
+	PollVote            *pollVote
+	Reminder            *reminder
+	User                *user
+	Webhook             *webhook
+	WebhookDelivery     *webhookDelivery
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	BotToken = &Q.BotToken
+	CardAction = &Q.CardAction
+	CardMessage = &Q.CardMessage
+	ConversationSetting = &Q.ConversationSetting
+	ExportJob = &Q.ExportJob
 	Friend = &Q.Friend
 	FriendRequest = &Q.FriendRequest
 	Group = &Q.Group
+	GroupAssistant = &Q.GroupAssistant
+	GroupAuditLog = &Q.GroupAuditLog
+	GroupAutoReply = &Q.GroupAutoReply
+	GroupBan = &Q.GroupBan
+	GroupCommand = &Q.GroupCommand
 	GroupJoinRequest = &Q.GroupJoinRequest
+	GroupJoinRule = &Q.GroupJoinRule
 	GroupMember = &Q.GroupMember
+	GroupPermission = &Q.GroupPermission
+	GroupTag = &Q.GroupTag
+	GroupTopic = &Q.GroupTopic
+	GroupTopicRead = &Q.GroupTopicRead
+	ImportJob = &Q.ImportJob
+	ImportMapping = &Q.ImportMapping
+	IncomingWebhook = &Q.IncomingWebhook
 	InvitationCode = &Q.InvitationCode
+	InvitationUse = &Q.InvitationUse
 	Message = &Q.Message
 	MessageReceipt = &Q.MessageReceipt
+	Poll = &Q.Poll
+	PollOption = &Q.PollOption
+	PollVote = &Q.PollVote
+	Reminder = &Q.Reminder
 	User = &Q.User
+	Webhook = &Q.Webhook
+	WebhookDelivery = &Q.WebhookDelivery
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:               db,
-		Friend:           newFriend(db, opts...),
-		FriendRequest:    newFriendRequest(db, opts...),
-		Group:            newGroup(db, opts...),
-		GroupJoinRequest: newGroupJoinRequest(db, opts...),
-		GroupMember:      newGroupMember(db, opts...),
-		InvitationCode:   newInvitationCode(db, opts...),
-		Message:          newMessage(db, opts...),
-		MessageReceipt:   newMessageReceipt(db, opts...),
-		User:             newUser(db, opts...),
+		db:                  db,
+		BotToken:            newBotToken(db, opts...),
+		CardAction:          newCardAction(db, opts...),
+		CardMessage:         newCardMessage(db, opts...),
+		ConversationSetting: newConversationSetting(db, opts...),
+		ExportJob:           newExportJob(db, opts...),
+		Friend:              newFriend(db, opts...),
+		FriendRequest:       newFriendRequest(db, opts...),
+		Group:               newGroup(db, opts...),
+		GroupAssistant:      newGroupAssistant(db, opts...),
+		GroupAuditLog:       newGroupAuditLog(db, opts...),
+		GroupAutoReply:      newGroupAutoReply(db, opts...),
+		GroupBan:            newGroupBan(db, opts...),
+		GroupCommand:        newGroupCommand(db, opts...),
+		GroupJoinRequest:    newGroupJoinRequest(db, opts...),
+		GroupJoinRule:       newGroupJoinRule(db, opts...),
+		GroupMember:         newGroupMember(db, opts...),
+		GroupPermission:     newGroupPermission(db, opts...),
+		GroupTag:            newGroupTag(db, opts...),
+		GroupTopic:          newGroupTopic(db, opts...),
+		GroupTopicRead:      newGroupTopicRead(db, opts...),
+		ImportJob:           newImportJob(db, opts...),
+		ImportMapping:       newImportMapping(db, opts...),
+		IncomingWebhook:     newIncomingWebhook(db, opts...),
+		InvitationCode:      newInvitationCode(db, opts...),
+		InvitationUse:       newInvitationUse(db, opts...),
+		Message:             newMessage(db, opts...),
+		MessageReceipt:      newMessageReceipt(db, opts...),
+		Poll:                newPoll(db, opts...),
+		PollOption:          newPollOption(db, opts...),
+		PollVote:            newPollVote(db, opts...),
+		Reminder:            newReminder(db, opts...),
+		User:                newUser(db, opts...),
+		Webhook:             newWebhook(db, opts...),
+		WebhookDelivery:     newWebhookDelivery(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	Friend           friend
-	FriendRequest    friendRequest
-	Group            group
-	GroupJoinRequest groupJoinRequest
-	GroupMember      groupMember
-	InvitationCode   invitationCode
-	Message          message
-	MessageReceipt   messageReceipt
-	User             user
+	BotToken            botToken
+	CardAction          cardAction
+	CardMessage         cardMessage
+	ConversationSetting conversationSetting
+	ExportJob           exportJob
+	Friend              friend
+	FriendRequest       friendRequest
+	Group               group
+	GroupAssistant      groupAssistant
+	GroupAuditLog       groupAuditLog
+	GroupAutoReply      groupAutoReply
+	GroupBan            groupBan
+	GroupCommand        groupCommand
+	GroupJoinRequest    groupJoinRequest
+	GroupJoinRule       groupJoinRule
+	GroupMember         groupMember
+	GroupPermission     groupPermission
+	GroupTag            groupTag
+	GroupTopic          groupTopic
+	GroupTopicRead      groupTopicRead
+	ImportJob           importJob
+	ImportMapping       importMapping
+	IncomingWebhook     incomingWebhook
+	InvitationCode      invitationCode
+	InvitationUse       invitationUse
+	Message             message
+	MessageReceipt      messageReceipt
+	Poll                poll
+	PollOption          pollOption
+	PollVote            pollVote
+	Reminder            reminder
+	User                user
+	Webhook             webhook
+	WebhookDelivery     webhookDelivery
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:               db,
-		Friend:           q.Friend.clone(db),
-		FriendRequest:    q.FriendRequest.clone(db),
-		Group:            q.Group.clone(db),
-		GroupJoinRequest: q.GroupJoinRequest.clone(db),
-		GroupMember:      q.GroupMember.clone(db),
-		InvitationCode:   q.InvitationCode.clone(db),
-		Message:          q.Message.clone(db),
-		MessageReceipt:   q.MessageReceipt.clone(db),
-		User:             q.User.clone(db),
+		db:                  db,
+		BotToken:            q.BotToken.clone(db),
+		CardAction:          q.CardAction.clone(db),
+		CardMessage:         q.CardMessage.clone(db),
+		ConversationSetting: q.ConversationSetting.clone(db),
+		ExportJob:           q.ExportJob.clone(db),
+		Friend:              q.Friend.clone(db),
+		FriendRequest:       q.FriendRequest.clone(db),
+		Group:               q.Group.clone(db),
+		GroupAssistant:      q.GroupAssistant.clone(db),
+		GroupAuditLog:       q.GroupAuditLog.clone(db),
+		GroupAutoReply:      q.GroupAutoReply.clone(db),
+		GroupBan:            q.GroupBan.clone(db),
+		GroupCommand:        q.GroupCommand.clone(db),
+		GroupJoinRequest:    q.GroupJoinRequest.clone(db),
+		GroupJoinRule:       q.GroupJoinRule.clone(db),
+		GroupMember:         q.GroupMember.clone(db),
+		GroupPermission:     q.GroupPermission.clone(db),
+		GroupTag:            q.GroupTag.clone(db),
+		GroupTopic:          q.GroupTopic.clone(db),
+		GroupTopicRead:      q.GroupTopicRead.clone(db),
+		ImportJob:           q.ImportJob.clone(db),
+		ImportMapping:       q.ImportMapping.clone(db),
+		IncomingWebhook:     q.IncomingWebhook.clone(db),
+		InvitationCode:      q.InvitationCode.clone(db),
+		InvitationUse:       q.InvitationUse.clone(db),
+		Message:             q.Message.clone(db),
+		MessageReceipt:      q.MessageReceipt.clone(db),
+		Poll:                q.Poll.clone(db),
+		PollOption:          q.PollOption.clone(db),
+		PollVote:            q.PollVote.clone(db),
+		Reminder:            q.Reminder.clone(db),
+		User:                q.User.clone(db),
+		Webhook:             q.Webhook.clone(db),
+		WebhookDelivery:     q.WebhookDelivery.clone(db),
 	}
 }
 
@@ -97,42 +222,117 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:               db,
-		Friend:           q.Friend.replaceDB(db),
-		FriendRequest:    q.FriendRequest.replaceDB(db),
-		Group:            q.Group.replaceDB(db),
-		GroupJoinRequest: q.GroupJoinRequest.replaceDB(db),
-		GroupMember:      q.GroupMember.replaceDB(db),
-		InvitationCode:   q.InvitationCode.replaceDB(db),
-		Message:          q.Message.replaceDB(db),
-		MessageReceipt:   q.MessageReceipt.replaceDB(db),
-		User:             q.User.replaceDB(db),
+		db:                  db,
+		BotToken:            q.BotToken.replaceDB(db),
+		CardAction:          q.CardAction.replaceDB(db),
+		CardMessage:         q.CardMessage.replaceDB(db),
+		ConversationSetting: q.ConversationSetting.replaceDB(db),
+		ExportJob:           q.ExportJob.replaceDB(db),
+		Friend:              q.Friend.replaceDB(db),
+		FriendRequest:       q.FriendRequest.replaceDB(db),
+		Group:               q.Group.replaceDB(db),
+		GroupAssistant:      q.GroupAssistant.replaceDB(db),
+		GroupAuditLog:       q.GroupAuditLog.replaceDB(db),
+		GroupAutoReply:      q.GroupAutoReply.replaceDB(db),
+		GroupBan:            q.GroupBan.replaceDB(db),
+		GroupCommand:        q.GroupCommand.replaceDB(db),
+		GroupJoinRequest:    q.GroupJoinRequest.replaceDB(db),
+		GroupJoinRule:       q.GroupJoinRule.replaceDB(db),
+		GroupMember:         q.GroupMember.replaceDB(db),
+		GroupPermission:     q.GroupPermission.replaceDB(db),
+		GroupTag:            q.GroupTag.replaceDB(db),
+		GroupTopic:          q.GroupTopic.replaceDB(db),
+		GroupTopicRead:      q.GroupTopicRead.replaceDB(db),
+		ImportJob:           q.ImportJob.replaceDB(db),
+		ImportMapping:       q.ImportMapping.replaceDB(db),
+		IncomingWebhook:     q.IncomingWebhook.replaceDB(db),
+		InvitationCode:      q.InvitationCode.replaceDB(db),
+		InvitationUse:       q.InvitationUse.replaceDB(db),
+		Message:             q.Message.replaceDB(db),
+		MessageReceipt:      q.MessageReceipt.replaceDB(db),
+		Poll:                q.Poll.replaceDB(db),
+		PollOption:          q.PollOption.replaceDB(db),
+		PollVote:            q.PollVote.replaceDB(db),
+		Reminder:            q.Reminder.replaceDB(db),
+		User:                q.User.replaceDB(db),
+		Webhook:             q.Webhook.replaceDB(db),
+		WebhookDelivery:     q.WebhookDelivery.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	Friend           IFriendDo
-	FriendRequest    IFriendRequestDo
-	Group            IGroupDo
-	GroupJoinRequest IGroupJoinRequestDo
-	GroupMember      IGroupMemberDo
-	InvitationCode   IInvitationCodeDo
-	Message          IMessageDo
-	MessageReceipt   IMessageReceiptDo
-	User             IUserDo
+	BotToken            IBotTokenDo
+	CardAction          ICardActionDo
+	CardMessage         ICardMessageDo
+	ConversationSetting IConversationSettingDo
+	ExportJob           IExportJobDo
+	Friend              IFriendDo
+	FriendRequest       IFriendRequestDo
+	Group               IGroupDo
+	GroupAssistant      IGroupAssistantDo
+	GroupAuditLog       IGroupAuditLogDo
+	GroupAutoReply      IGroupAutoReplyDo
+	GroupBan            IGroupBanDo
+	GroupCommand        IGroupCommandDo
+	GroupJoinRequest    IGroupJoinRequestDo
+	GroupJoinRule       IGroupJoinRuleDo
+	GroupMember         IGroupMemberDo
+	GroupPermission     IGroupPermissionDo
+	GroupTag            IGroupTagDo
+	GroupTopic          IGroupTopicDo
+	GroupTopicRead      IGroupTopicReadDo
+	ImportJob           IImportJobDo
+	ImportMapping       IImportMappingDo
+	IncomingWebhook     IIncomingWebhookDo
+	InvitationCode      IInvitationCodeDo
+	InvitationUse       IInvitationUseDo
+	Message             IMessageDo
+	MessageReceipt      IMessageReceiptDo
+	Poll                IPollDo
+	PollOption          IPollOptionDo
+	PollVote            IPollVoteDo
+	Reminder            IReminderDo
+	User                IUserDo
+	Webhook             IWebhookDo
+	WebhookDelivery     IWebhookDeliveryDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		Friend:           q.Friend.WithContext(ctx),
-		FriendRequest:    q.FriendRequest.WithContext(ctx),
-		Group:            q.Group.WithContext(ctx),
-		GroupJoinRequest: q.GroupJoinRequest.WithContext(ctx),
-		GroupMember:      q.GroupMember.WithContext(ctx),
-		InvitationCode:   q.InvitationCode.WithContext(ctx),
-		Message:          q.Message.WithContext(ctx),
-		MessageReceipt:   q.MessageReceipt.WithContext(ctx),
-		User:             q.User.WithContext(ctx),
+		BotToken:            q.BotToken.WithContext(ctx),
+		CardAction:          q.CardAction.WithContext(ctx),
+		CardMessage:         q.CardMessage.WithContext(ctx),
+		ConversationSetting: q.ConversationSetting.WithContext(ctx),
+		ExportJob:           q.ExportJob.WithContext(ctx),
+		Friend:              q.Friend.WithContext(ctx),
+		FriendRequest:       q.FriendRequest.WithContext(ctx),
+		Group:               q.Group.WithContext(ctx),
+		GroupAssistant:      q.GroupAssistant.WithContext(ctx),
+		GroupAuditLog:       q.GroupAuditLog.WithContext(ctx),
+		GroupAutoReply:      q.GroupAutoReply.WithContext(ctx),
+		GroupBan:            q.GroupBan.WithContext(ctx),
+		GroupCommand:        q.GroupCommand.WithContext(ctx),
+		GroupJoinRequest:    q.GroupJoinRequest.WithContext(ctx),
+		GroupJoinRule:       q.GroupJoinRule.WithContext(ctx),
+		GroupMember:         q.GroupMember.WithContext(ctx),
+		GroupPermission:     q.GroupPermission.WithContext(ctx),
+		GroupTag:            q.GroupTag.WithContext(ctx),
+		GroupTopic:          q.GroupTopic.WithContext(ctx),
+		GroupTopicRead:      q.GroupTopicRead.WithContext(ctx),
+		ImportJob:           q.ImportJob.WithContext(ctx),
+		ImportMapping:       q.ImportMapping.WithContext(ctx),
+		IncomingWebhook:     q.IncomingWebhook.WithContext(ctx),
+		InvitationCode:      q.InvitationCode.WithContext(ctx),
+		InvitationUse:       q.InvitationUse.WithContext(ctx),
+		Message:             q.Message.WithContext(ctx),
+		MessageReceipt:      q.MessageReceipt.WithContext(ctx),
+		Poll:                q.Poll.WithContext(ctx),
+		PollOption:          q.PollOption.WithContext(ctx),
+		PollVote:            q.PollVote.WithContext(ctx),
+		Reminder:            q.Reminder.WithContext(ctx),
+		User:                q.User.WithContext(ctx),
+		Webhook:             q.Webhook.WithContext(ctx),
+		WebhookDelivery:     q.WebhookDelivery.WithContext(ctx),
 	}
 }
 
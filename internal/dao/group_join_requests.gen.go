@@ -36,6 +36,9 @@ func newGroupJoinRequest(db *gorm.DB, opts ...gen.DOOption) groupJoinRequest {
 	_groupJoinRequest.TargetGroupID = field.NewString(tableName, "target_group_id")
 	_groupJoinRequest.Status = field.NewString(tableName, "status")
 	_groupJoinRequest.Message = field.NewString(tableName, "message")
+	_groupJoinRequest.DecidedBy = field.NewString(tableName, "decided_by")
+	_groupJoinRequest.DecisionReason = field.NewString(tableName, "decision_reason")
+	_groupJoinRequest.DecidedAt = field.NewTime(tableName, "decided_at")
 
 	_groupJoinRequest.fillFieldMap()
 
@@ -45,15 +48,18 @@ func newGroupJoinRequest(db *gorm.DB, opts ...gen.DOOption) groupJoinRequest {
 type groupJoinRequest struct {
 	groupJoinRequestDo
 
-	ALL           field.Asterisk
-	ID            field.Uint
-	CreatedAt     field.Time
-	UpdatedAt     field.Time
-	DeletedAt     field.Field
-	SenderID      field.String
-	TargetGroupID field.String
-	Status        field.String
-	Message       field.String
+	ALL            field.Asterisk
+	ID             field.Uint
+	CreatedAt      field.Time
+	UpdatedAt      field.Time
+	DeletedAt      field.Field
+	SenderID       field.String
+	TargetGroupID  field.String
+	Status         field.String
+	Message        field.String
+	DecidedBy      field.String
+	DecisionReason field.String
+	DecidedAt      field.Time
 
 	fieldMap map[string]field.Expr
 }
@@ -78,6 +84,9 @@ func (g *groupJoinRequest) updateTableName(table string) *groupJoinRequest {
 	g.TargetGroupID = field.NewString(table, "target_group_id")
 	g.Status = field.NewString(table, "status")
 	g.Message = field.NewString(table, "message")
+	g.DecidedBy = field.NewString(table, "decided_by")
+	g.DecisionReason = field.NewString(table, "decision_reason")
+	g.DecidedAt = field.NewTime(table, "decided_at")
 
 	g.fillFieldMap()
 
@@ -94,7 +103,7 @@ func (g *groupJoinRequest) GetFieldByName(fieldName string) (field.OrderExpr, bo
 }
 
 func (g *groupJoinRequest) fillFieldMap() {
-	g.fieldMap = make(map[string]field.Expr, 8)
+	g.fieldMap = make(map[string]field.Expr, 11)
 	g.fieldMap["id"] = g.ID
 	g.fieldMap["created_at"] = g.CreatedAt
 	g.fieldMap["updated_at"] = g.UpdatedAt
@@ -103,6 +112,9 @@ func (g *groupJoinRequest) fillFieldMap() {
 	g.fieldMap["target_group_id"] = g.TargetGroupID
 	g.fieldMap["status"] = g.Status
 	g.fieldMap["message"] = g.Message
+	g.fieldMap["decided_by"] = g.DecidedBy
+	g.fieldMap["decision_reason"] = g.DecisionReason
+	g.fieldMap["decided_at"] = g.DecidedAt
 }
 
 func (g groupJoinRequest) clone(db *gorm.DB) groupJoinRequest {
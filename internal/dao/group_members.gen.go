@@ -31,6 +31,9 @@ func newGroupMember(db *gorm.DB, opts ...gen.DOOption) groupMember {
 	_groupMember.GroupID = field.NewString(tableName, "group_id")
 	_groupMember.UserID = field.NewString(tableName, "user_id")
 	_groupMember.Role = field.NewString(tableName, "role")
+	_groupMember.MutedUntil = field.NewTime(tableName, "muted_until")
+	_groupMember.AnnouncementAckVersion = field.NewInt(tableName, "announcement_ack_version")
+	_groupMember.Nickname = field.NewString(tableName, "nickname")
 	_groupMember.CreatedAt = field.NewTime(tableName, "created_at")
 	_groupMember.DeletedAt = field.NewField(tableName, "deleted_at")
 
@@ -42,12 +45,15 @@ func newGroupMember(db *gorm.DB, opts ...gen.DOOption) groupMember {
 type groupMember struct {
 	groupMemberDo
 
-	ALL       field.Asterisk
-	GroupID   field.String
-	UserID    field.String
-	Role      field.String
-	CreatedAt field.Time
-	DeletedAt field.Field
+	ALL                    field.Asterisk
+	GroupID                field.String
+	UserID                 field.String
+	Role                   field.String
+	MutedUntil             field.Time
+	AnnouncementAckVersion field.Int
+	Nickname               field.String
+	CreatedAt              field.Time
+	DeletedAt              field.Field
 
 	fieldMap map[string]field.Expr
 }
@@ -67,6 +73,9 @@ func (g *groupMember) updateTableName(table string) *groupMember {
 	g.GroupID = field.NewString(table, "group_id")
 	g.UserID = field.NewString(table, "user_id")
 	g.Role = field.NewString(table, "role")
+	g.MutedUntil = field.NewTime(table, "muted_until")
+	g.AnnouncementAckVersion = field.NewInt(table, "announcement_ack_version")
+	g.Nickname = field.NewString(table, "nickname")
 	g.CreatedAt = field.NewTime(table, "created_at")
 	g.DeletedAt = field.NewField(table, "deleted_at")
 
@@ -85,10 +94,13 @@ func (g *groupMember) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (g *groupMember) fillFieldMap() {
-	g.fieldMap = make(map[string]field.Expr, 5)
+	g.fieldMap = make(map[string]field.Expr, 8)
 	g.fieldMap["group_id"] = g.GroupID
 	g.fieldMap["user_id"] = g.UserID
 	g.fieldMap["role"] = g.Role
+	g.fieldMap["muted_until"] = g.MutedUntil
+	g.fieldMap["announcement_ack_version"] = g.AnnouncementAckVersion
+	g.fieldMap["nickname"] = g.Nickname
 	g.fieldMap["created_at"] = g.CreatedAt
 	g.fieldMap["deleted_at"] = g.DeletedAt
 }
@@ -32,6 +32,20 @@ func newGroup(db *gorm.DB, opts ...gen.DOOption) group {
 	_group.Name = field.NewString(tableName, "name")
 	_group.OwnerID = field.NewString(tableName, "owner_id")
 	_group.MemberCount = field.NewInt(tableName, "member_count")
+	_group.MaxMembers = field.NewInt(tableName, "max_members")
+	_group.Topic = field.NewString(tableName, "topic")
+	_group.WelcomeMessage = field.NewString(tableName, "welcome_message")
+	_group.MuteAll = field.NewBool(tableName, "mute_all")
+	_group.SlowModeSeconds = field.NewInt(tableName, "slow_mode_seconds")
+	_group.Description = field.NewString(tableName, "description")
+	_group.AvatarFile = field.NewString(tableName, "avatar_file")
+	_group.Announcement = field.NewString(tableName, "announcement")
+	_group.AnnouncementVersion = field.NewInt(tableName, "announcement_version")
+	_group.AnnouncementAt = field.NewTime(tableName, "announcement_at")
+	_group.Visibility = field.NewString(tableName, "visibility")
+	_group.JoinPolicy = field.NewString(tableName, "join_policy")
+	_group.IsChannel = field.NewBool(tableName, "is_channel")
+	_group.IsForum = field.NewBool(tableName, "is_forum")
 	_group.CreatedAt = field.NewTime(tableName, "created_at")
 	_group.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_group.DeletedAt = field.NewField(tableName, "deleted_at")
@@ -44,14 +58,28 @@ func newGroup(db *gorm.DB, opts ...gen.DOOption) group {
 type group struct {
 	groupDo
 
-	ALL         field.Asterisk
-	ID          field.String
-	Name        field.String
-	OwnerID     field.String
-	MemberCount field.Int
-	CreatedAt   field.Time
-	UpdatedAt   field.Time
-	DeletedAt   field.Field
+	ALL                 field.Asterisk
+	ID                  field.String
+	Name                field.String
+	OwnerID             field.String
+	MemberCount         field.Int
+	MaxMembers          field.Int
+	Topic               field.String
+	WelcomeMessage      field.String
+	MuteAll             field.Bool
+	SlowModeSeconds     field.Int
+	Description         field.String
+	AvatarFile          field.String
+	Announcement        field.String
+	AnnouncementVersion field.Int
+	AnnouncementAt      field.Time
+	Visibility          field.String
+	JoinPolicy          field.String
+	IsChannel           field.Bool
+	IsForum             field.Bool
+	CreatedAt           field.Time
+	UpdatedAt           field.Time
+	DeletedAt           field.Field
 
 	fieldMap map[string]field.Expr
 }
@@ -72,6 +100,20 @@ func (g *group) updateTableName(table string) *group {
 	g.Name = field.NewString(table, "name")
 	g.OwnerID = field.NewString(table, "owner_id")
 	g.MemberCount = field.NewInt(table, "member_count")
+	g.MaxMembers = field.NewInt(table, "max_members")
+	g.Topic = field.NewString(table, "topic")
+	g.WelcomeMessage = field.NewString(table, "welcome_message")
+	g.MuteAll = field.NewBool(table, "mute_all")
+	g.SlowModeSeconds = field.NewInt(table, "slow_mode_seconds")
+	g.Description = field.NewString(table, "description")
+	g.AvatarFile = field.NewString(table, "avatar_file")
+	g.Announcement = field.NewString(table, "announcement")
+	g.AnnouncementVersion = field.NewInt(table, "announcement_version")
+	g.AnnouncementAt = field.NewTime(table, "announcement_at")
+	g.Visibility = field.NewString(table, "visibility")
+	g.JoinPolicy = field.NewString(table, "join_policy")
+	g.IsChannel = field.NewBool(table, "is_channel")
+	g.IsForum = field.NewBool(table, "is_forum")
 	g.CreatedAt = field.NewTime(table, "created_at")
 	g.UpdatedAt = field.NewTime(table, "updated_at")
 	g.DeletedAt = field.NewField(table, "deleted_at")
@@ -91,11 +133,25 @@ func (g *group) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (g *group) fillFieldMap() {
-	g.fieldMap = make(map[string]field.Expr, 7)
+	g.fieldMap = make(map[string]field.Expr, 21)
 	g.fieldMap["id"] = g.ID
 	g.fieldMap["name"] = g.Name
 	g.fieldMap["owner_id"] = g.OwnerID
 	g.fieldMap["member_count"] = g.MemberCount
+	g.fieldMap["max_members"] = g.MaxMembers
+	g.fieldMap["topic"] = g.Topic
+	g.fieldMap["welcome_message"] = g.WelcomeMessage
+	g.fieldMap["mute_all"] = g.MuteAll
+	g.fieldMap["slow_mode_seconds"] = g.SlowModeSeconds
+	g.fieldMap["description"] = g.Description
+	g.fieldMap["avatar_file"] = g.AvatarFile
+	g.fieldMap["announcement"] = g.Announcement
+	g.fieldMap["announcement_version"] = g.AnnouncementVersion
+	g.fieldMap["announcement_at"] = g.AnnouncementAt
+	g.fieldMap["visibility"] = g.Visibility
+	g.fieldMap["join_policy"] = g.JoinPolicy
+	g.fieldMap["is_channel"] = g.IsChannel
+	g.fieldMap["is_forum"] = g.IsForum
 	g.fieldMap["created_at"] = g.CreatedAt
 	g.fieldMap["updated_at"] = g.UpdatedAt
 	g.fieldMap["deleted_at"] = g.DeletedAt
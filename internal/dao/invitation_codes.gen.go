@@ -35,6 +35,12 @@ func newInvitationCode(db *gorm.DB, opts ...gen.DOOption) invitationCode {
 	_invitationCode.UUID = field.NewString(tableName, "uuid")
 	_invitationCode.Name = field.NewString(tableName, "name")
 	_invitationCode.Code = field.NewString(tableName, "code")
+	_invitationCode.CreatorID = field.NewString(tableName, "creator_id")
+	_invitationCode.MaxUses = field.NewInt(tableName, "max_uses")
+	_invitationCode.UseCount = field.NewInt(tableName, "use_count")
+	_invitationCode.ExpiresAt = field.NewTime(tableName, "expires_at")
+	_invitationCode.RequiresApproval = field.NewBool(tableName, "requires_approval")
+	_invitationCode.RevokedAt = field.NewTime(tableName, "revoked_at")
 
 	_invitationCode.fillFieldMap()
 
@@ -44,14 +50,20 @@ func newInvitationCode(db *gorm.DB, opts ...gen.DOOption) invitationCode {
 type invitationCode struct {
 	invitationCodeDo
 
-	ALL       field.Asterisk
-	ID        field.Uint
-	CreatedAt field.Time
-	UpdatedAt field.Time
-	DeletedAt field.Field
-	UUID      field.String
-	Name      field.String
-	Code      field.String
+	ALL              field.Asterisk
+	ID               field.Uint
+	CreatedAt        field.Time
+	UpdatedAt        field.Time
+	DeletedAt        field.Field
+	UUID             field.String
+	Name             field.String
+	Code             field.String
+	CreatorID        field.String
+	MaxUses          field.Int
+	UseCount         field.Int
+	ExpiresAt        field.Time
+	RequiresApproval field.Bool
+	RevokedAt        field.Time
 
 	fieldMap map[string]field.Expr
 }
@@ -75,6 +87,12 @@ func (i *invitationCode) updateTableName(table string) *invitationCode {
 	i.UUID = field.NewString(table, "uuid")
 	i.Name = field.NewString(table, "name")
 	i.Code = field.NewString(table, "code")
+	i.CreatorID = field.NewString(table, "creator_id")
+	i.MaxUses = field.NewInt(table, "max_uses")
+	i.UseCount = field.NewInt(table, "use_count")
+	i.ExpiresAt = field.NewTime(table, "expires_at")
+	i.RequiresApproval = field.NewBool(table, "requires_approval")
+	i.RevokedAt = field.NewTime(table, "revoked_at")
 
 	i.fillFieldMap()
 
@@ -91,7 +109,7 @@ func (i *invitationCode) GetFieldByName(fieldName string) (field.OrderExpr, bool
 }
 
 func (i *invitationCode) fillFieldMap() {
-	i.fieldMap = make(map[string]field.Expr, 7)
+	i.fieldMap = make(map[string]field.Expr, 13)
 	i.fieldMap["id"] = i.ID
 	i.fieldMap["created_at"] = i.CreatedAt
 	i.fieldMap["updated_at"] = i.UpdatedAt
@@ -99,6 +117,12 @@ func (i *invitationCode) fillFieldMap() {
 	i.fieldMap["uuid"] = i.UUID
 	i.fieldMap["name"] = i.Name
 	i.fieldMap["code"] = i.Code
+	i.fieldMap["creator_id"] = i.CreatorID
+	i.fieldMap["max_uses"] = i.MaxUses
+	i.fieldMap["use_count"] = i.UseCount
+	i.fieldMap["expires_at"] = i.ExpiresAt
+	i.fieldMap["requires_approval"] = i.RequiresApproval
+	i.fieldMap["revoked_at"] = i.RevokedAt
 }
 
 func (i invitationCode) clone(db *gorm.DB) invitationCode {
@@ -32,7 +32,9 @@ func newMessage(db *gorm.DB, opts ...gen.DOOption) message {
 	_message.FromUserID = field.NewString(tableName, "from_user_id")
 	_message.TargetID = field.NewString(tableName, "target_id")
 	_message.Type = field.NewString(tableName, "type")
+	_message.Kind = field.NewString(tableName, "kind")
 	_message.Content = field.NewString(tableName, "content")
+	_message.TopicID = field.NewString(tableName, "topic_id")
 	_message.CreatedAt = field.NewTime(tableName, "created_at")
 
 	_message.fillFieldMap()
@@ -48,7 +50,9 @@ type message struct {
 	FromUserID field.String
 	TargetID   field.String
 	Type       field.String
+	Kind       field.String
 	Content    field.String
+	TopicID    field.String
 	CreatedAt  field.Time
 
 	fieldMap map[string]field.Expr
@@ -70,7 +74,9 @@ func (m *message) updateTableName(table string) *message {
 	m.FromUserID = field.NewString(table, "from_user_id")
 	m.TargetID = field.NewString(table, "target_id")
 	m.Type = field.NewString(table, "type")
+	m.Kind = field.NewString(table, "kind")
 	m.Content = field.NewString(table, "content")
+	m.TopicID = field.NewString(table, "topic_id")
 	m.CreatedAt = field.NewTime(table, "created_at")
 
 	m.fillFieldMap()
@@ -88,12 +94,14 @@ func (m *message) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (m *message) fillFieldMap() {
-	m.fieldMap = make(map[string]field.Expr, 6)
+	m.fieldMap = make(map[string]field.Expr, 8)
 	m.fieldMap["id"] = m.ID
 	m.fieldMap["from_user_id"] = m.FromUserID
 	m.fieldMap["target_id"] = m.TargetID
 	m.fieldMap["type"] = m.Type
+	m.fieldMap["kind"] = m.Kind
 	m.fieldMap["content"] = m.Content
+	m.fieldMap["topic_id"] = m.TopicID
 	m.fieldMap["created_at"] = m.CreatedAt
 }
 
@@ -31,6 +31,9 @@ func newUser(db *gorm.DB, opts ...gen.DOOption) user {
 	_user.ID = field.NewString(tableName, "id")
 	_user.Username = field.NewString(tableName, "username")
 	_user.PasswordHash = field.NewString(tableName, "password_hash")
+	_user.Type = field.NewString(tableName, "type")
+	_user.BotOwnerID = field.NewString(tableName, "bot_owner_id")
+	_user.Organization = field.NewString(tableName, "organization")
 	_user.CreatedAt = field.NewTime(tableName, "created_at")
 	_user.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_user.DeletedAt = field.NewField(tableName, "deleted_at")
@@ -47,6 +50,9 @@ type user struct {
 	ID           field.String
 	Username     field.String
 	PasswordHash field.String
+	Type         field.String
+	BotOwnerID   field.String
+	Organization field.String
 	CreatedAt    field.Time
 	UpdatedAt    field.Time
 	DeletedAt    field.Field
@@ -69,6 +75,9 @@ func (u *user) updateTableName(table string) *user {
 	u.ID = field.NewString(table, "id")
 	u.Username = field.NewString(table, "username")
 	u.PasswordHash = field.NewString(table, "password_hash")
+	u.Type = field.NewString(table, "type")
+	u.BotOwnerID = field.NewString(table, "bot_owner_id")
+	u.Organization = field.NewString(table, "organization")
 	u.CreatedAt = field.NewTime(table, "created_at")
 	u.UpdatedAt = field.NewTime(table, "updated_at")
 	u.DeletedAt = field.NewField(table, "deleted_at")
@@ -88,10 +97,13 @@ func (u *user) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (u *user) fillFieldMap() {
-	u.fieldMap = make(map[string]field.Expr, 6)
+	u.fieldMap = make(map[string]field.Expr, 9)
 	u.fieldMap["id"] = u.ID
 	u.fieldMap["username"] = u.Username
 	u.fieldMap["password_hash"] = u.PasswordHash
+	u.fieldMap["type"] = u.Type
+	u.fieldMap["bot_owner_id"] = u.BotOwnerID
+	u.fieldMap["organization"] = u.Organization
 	u.fieldMap["created_at"] = u.CreatedAt
 	u.fieldMap["updated_at"] = u.UpdatedAt
 	u.fieldMap["deleted_at"] = u.DeletedAt
@@ -22,6 +22,31 @@ func Migrate() error {
 		&model.InvitationCode{},
 		&model.GroupJoinRequest{},
 		&model.MessageReceipt{},
+		&model.ConversationSetting{},
+		&model.ExportJob{},
+		&model.ImportJob{},
+		&model.ImportMapping{},
+		&model.Poll{},
+		&model.PollOption{},
+		&model.PollVote{},
+		&model.CardMessage{},
+		&model.CardAction{},
+		&model.BotToken{},
+		&model.Webhook{},
+		&model.WebhookDelivery{},
+		&model.IncomingWebhook{},
+		&model.GroupCommand{},
+		&model.Reminder{},
+		&model.GroupAutoReply{},
+		&model.GroupAssistant{},
+		&model.GroupPermission{},
+		&model.GroupBan{},
+		&model.InvitationUse{},
+		&model.GroupTag{},
+		&model.GroupTopic{},
+		&model.GroupTopicRead{},
+		&model.GroupAuditLog{},
+		&model.GroupJoinRule{},
 	)
 
 	if err != nil {
@@ -116,6 +141,31 @@ func DropTables() error {
 		&model.FriendRequest{},
 		&model.GroupJoinRequest{},
 		&model.MessageReceipt{},
+		&model.ConversationSetting{},
+		&model.ExportJob{},
+		&model.ImportJob{},
+		&model.ImportMapping{},
+		&model.Poll{},
+		&model.PollOption{},
+		&model.PollVote{},
+		&model.CardMessage{},
+		&model.CardAction{},
+		&model.BotToken{},
+		&model.Webhook{},
+		&model.WebhookDelivery{},
+		&model.IncomingWebhook{},
+		&model.GroupCommand{},
+		&model.Reminder{},
+		&model.GroupAutoReply{},
+		&model.GroupAssistant{},
+		&model.GroupPermission{},
+		&model.GroupBan{},
+		&model.InvitationUse{},
+		&model.GroupTag{},
+		&model.GroupTopic{},
+		&model.GroupTopicRead{},
+		&model.GroupAuditLog{},
+		&model.GroupJoinRule{},
 	}
 
 	for _, table := range tables {
@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// SaveGroupAssistantRequest 启用或更新群组助手请求，未填写的字段保持不变
+type SaveGroupAssistantRequest struct {
+	Name        string          `json:"name"`      // 助手名称，成员通过 @名称 提及助手
+	Responder   string          `json:"responder"` // 回复器名称，如 echo、rule
+	Config      json.RawMessage `json:"config"`    // 回复器配置
+	ContextSize *int            `json:"context_size"`
+	Enabled     *bool           `json:"enabled"`
+}
+
+// GroupAssistantResponse 群组助手信息响应
+type GroupAssistantResponse struct {
+	GroupID     string          `json:"group_id"`
+	UserID      string          `json:"user_id"` // 助手账号ID，即回复消息的 from_user
+	Name        string          `json:"name"`
+	Avatar      string          `json:"avatar"`
+	Responder   string          `json:"responder"`
+	Config      json.RawMessage `json:"config,omitempty"`
+	ContextSize int             `json:"context_size"`
+	Enabled     bool            `json:"enabled"`
+	CreatorID   string          `json:"creator_id"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// AssistantMessageDelta 助手流式回复的增量更新，同一条回复的所有更新使用相同的 MessageID
+type AssistantMessageDelta struct {
+	MessageID string `json:"message_id"`
+	GroupID   string `json:"group_id"`
+	From      string `json:"from"` // 助手账号ID
+	Delta     string `json:"delta,omitempty"`
+	Done      bool   `json:"done"`
+	Error     string `json:"error,omitempty"` // 生成失败时的原因，此时不会有最终消息
+}
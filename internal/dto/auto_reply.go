@@ -0,0 +1,38 @@
+package dto
+
+import "time"
+
+// UpdateWelcomeMessageRequest 设置群组欢迎语请求，空字符串表示不发送欢迎语
+type UpdateWelcomeMessageRequest struct {
+	WelcomeMessage string `json:"welcome_message"` // 支持 {username} 和 {group} 占位符
+}
+
+// CreateAutoReplyRequest 创建自动回复规则请求
+type CreateAutoReplyRequest struct {
+	MatchType       string `json:"match_type"` // keyword / regex
+	Pattern         string `json:"pattern"`
+	Reply           string `json:"reply"`
+	CooldownSeconds int    `json:"cooldown_seconds"` // 默认 60 秒
+}
+
+// UpdateAutoReplyRequest 更新自动回复规则请求，未填写的字段保持不变
+type UpdateAutoReplyRequest struct {
+	MatchType       *string `json:"match_type"`
+	Pattern         *string `json:"pattern"`
+	Reply           *string `json:"reply"`
+	CooldownSeconds *int    `json:"cooldown_seconds"`
+	Enabled         *bool   `json:"enabled"`
+}
+
+// AutoReplyResponse 自动回复规则响应
+type AutoReplyResponse struct {
+	RuleID          string    `json:"rule_id"`
+	GroupID         string    `json:"group_id"`
+	MatchType       string    `json:"match_type"`
+	Pattern         string    `json:"pattern"`
+	Reply           string    `json:"reply"`
+	CooldownSeconds int       `json:"cooldown_seconds"`
+	Enabled         bool      `json:"enabled"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
@@ -0,0 +1,48 @@
+package dto
+
+import "time"
+
+// CreateBotRequest 创建机器人请求
+type CreateBotRequest struct {
+	Username string `json:"username"`
+}
+
+// BotResponse 机器人信息响应
+type BotResponse struct {
+	BotID       string     `json:"bot_id"`
+	Username    string     `json:"username"`
+	Avatar      string     `json:"avatar"`
+	TokenPrefix string     `json:"token_prefix,omitempty"` // 当前有效令牌的前几位
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// BotTokenResponse 创建机器人或重新生成令牌的响应，令牌明文只返回这一次
+type BotTokenResponse struct {
+	Bot   BotResponse `json:"bot"`
+	Token string      `json:"token"`
+}
+
+// AddBotToGroupResponse 将机器人加入群组的响应
+type AddBotToGroupResponse struct {
+	BotID       string            `json:"bot_id"`
+	GroupID     string            `json:"group_id"`
+	Status      string            `json:"status"` // joined：已加入；pending：等待群主审批
+	MemberEvent *GroupMemberEvent `json:"-"`      // 机器人直接加入时在事务中记录的成员变化事件
+}
+
+// SendMessageRequest 通过 HTTP 接口发送消息请求，用户和机器人均可使用
+type SendMessageRequest struct {
+	ChatType string `json:"chat_type"` // private 或 group
+	TargetID string `json:"target_id"` // 好友ID或群ID
+	Kind     string `json:"kind"`      // text / image / file，默认为 text
+	Content  string `json:"content"`
+	TopicID  string `json:"topic_id"` // 论坛模式群组中的话题ID（可选）
+}
+
+// SendMessageResponse 发送消息响应
+type SendMessageResponse struct {
+	MessageID       string `json:"message_id"`
+	Timestamp       int64  `json:"timestamp"`
+	CommandResponse string `json:"command_response,omitempty"` // 发送斜杠命令时仅调用者可见的回复
+}
@@ -0,0 +1,77 @@
+package dto
+
+import "time"
+
+// Card 交互式卡片内容
+type Card struct {
+	Title   string       `json:"title"`
+	Fields  []CardField  `json:"fields,omitempty"`
+	Images  []string     `json:"images,omitempty"` // 图片地址
+	Actions []CardButton `json:"actions,omitempty"`
+	Footer  string       `json:"footer,omitempty"` // 卡片底部的状态说明，如审批结果
+}
+
+// CardField 卡片中的键值字段
+type CardField struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// CardButton 卡片按钮
+type CardButton struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
+	Style string `json:"style,omitempty"` // primary / danger / default
+	Value string `json:"value,omitempty"` // 点击时随回调发送的值
+}
+
+// SendCardRequest 发送卡片消息请求
+// Handler 和 CallbackURL 均可选，分别表示由服务端注册的处理器或外部回调地址处理按钮点击
+type SendCardRequest struct {
+	ChatType    string `json:"chat_type"` // private 或 group
+	TargetID    string `json:"target_id"` // 好友ID或群ID
+	Card        Card   `json:"card"`
+	Handler     string `json:"handler"`
+	CallbackURL string `json:"callback_url"`
+}
+
+// CardActionRequest 点击卡片按钮请求
+type CardActionRequest struct {
+	ActionID string `json:"action_id"`
+}
+
+// CardResponse 卡片详情，同时作为卡片更新事件的数据
+type CardResponse struct {
+	CardID     string    `json:"card_id"`
+	MessageID  string    `json:"message_id"`
+	ChatType   string    `json:"chat_type"`
+	TargetID   string    `json:"target_id"`
+	CreatorID  string    `json:"creator_id"`
+	Card       Card      `json:"card"`
+	MyActionID string    `json:"my_action_id,omitempty"` // 当前用户点击过的按钮，更新事件中不包含
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// SendCardResponse 发送卡片消息的响应，CallbackSecret 为回调请求的签名密钥，只返回给发送者这一次
+type SendCardResponse struct {
+	CardResponse
+	CallbackSecret string `json:"callback_secret,omitempty"`
+}
+
+// CardCallbackEvent 发送给卡片回调地址的按钮点击事件
+type CardCallbackEvent struct {
+	CardID    string `json:"card_id"`
+	MessageID string `json:"message_id"`
+	ChatType  string `json:"chat_type"`
+	TargetID  string `json:"target_id"`
+	ActionID  string `json:"action_id"`
+	Value     string `json:"value,omitempty"`
+	UserID    string `json:"user_id"`
+	Username  string `json:"username"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// CardCallbackResponse 卡片回调地址的响应，Card 不为空时更新卡片内容
+type CardCallbackResponse struct {
+	Card *Card `json:"card"`
+}
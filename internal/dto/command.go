@@ -0,0 +1,57 @@
+package dto
+
+import "time"
+
+// RegisterCommandRequest 在群组中注册斜杠命令的请求
+// 机器人使用自己的令牌注册；群主为传入 Webhook 注册时需填写 WebhookID 和 CallbackURL
+type RegisterCommandRequest struct {
+	Name        string `json:"name"` // 不含前缀 /，只能包含小写字母、数字、下划线和连字符
+	Description string `json:"description"`
+	Usage       string `json:"usage"`
+	CallbackURL string `json:"callback_url"` // 为空时通过 WebSocket 转发给机器人
+	WebhookID   string `json:"webhook_id"`
+}
+
+// CommandInfo 可用命令信息
+type CommandInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Usage       string `json:"usage,omitempty"`
+	Builtin     bool   `json:"builtin"`
+	BotID       string `json:"bot_id,omitempty"`
+	BotName     string `json:"bot_name,omitempty"`
+}
+
+// RegisterCommandResponse 注册命令的响应，命令有回调地址时 CallbackSecret 为回调请求的签名密钥
+type RegisterCommandResponse struct {
+	CommandInfo
+	CallbackSecret string `json:"callback_secret,omitempty"`
+}
+
+// CommandInvocation 转发给机器人（WebSocket 事件或回调地址）的命令调用
+type CommandInvocation struct {
+	InvocationID string `json:"invocation_id"`
+	Command      string `json:"command"`
+	Args         string `json:"args"`
+	GroupID      string `json:"group_id"`
+	UserID       string `json:"user_id"`
+	Username     string `json:"username"`
+	Timestamp    int64  `json:"timestamp"`
+}
+
+// CommandCallbackResponse 命令回调地址的响应，Text 为空表示不回复
+type CommandCallbackResponse struct {
+	Text       string `json:"text"`
+	Visibility string `json:"visibility"` // ephemeral：仅调用者可见（默认）；group：以机器人身份发送到群组
+}
+
+// ReminderResponse 提醒，到期时作为 reminder 事件推送
+type ReminderResponse struct {
+	ReminderID string    `json:"reminder_id"`
+	UserID     string    `json:"user_id"`
+	ChatType   string    `json:"chat_type"`
+	TargetID   string    `json:"target_id"`
+	Text       string    `json:"text"`
+	RemindAt   time.Time `json:"remind_at"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+import "time"
+
+// CreateExportRequest 创建聊天记录导出任务请求
+type CreateExportRequest struct {
+	ChatType string   `json:"chat_type"` // private 或 group
+	TargetID string   `json:"target_id"` // 好友ID或群ID
+	Formats  []string `json:"formats"`   // json / html / txt，为空时导出全部格式
+}
+
+// ExportJobResponse 导出任务信息响应
+type ExportJobResponse struct {
+	JobID       string     `json:"job_id"`
+	ChatType    string     `json:"chat_type"`
+	TargetID    string     `json:"target_id"`
+	Formats     []string   `json:"formats"`
+	Status      string     `json:"status"` // pending / running / completed / failed / expired
+	Error       string     `json:"error,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"` // 导出文件的过期时间，过期后不能再下载
+}
+
+// ExportMessage 导出文件中的单条消息
+type ExportMessage struct {
+	MessageID  string    `json:"message_id"`
+	SenderID   string    `json:"sender_id"`
+	SenderName string    `json:"sender_name"`
+	Kind       string    `json:"kind"`
+	Content    string    `json:"content"`
+	Attachment string    `json:"attachment,omitempty"` // 图片、文件消息的附件地址
+	CreatedAt  time.Time `json:"created_at"`
+}
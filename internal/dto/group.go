@@ -1,8 +1,17 @@
 package dto
 
+import (
+	"encoding/json"
+	"time"
+)
+
 // CreateGroupRequest 创建群组请求
 type CreateGroupRequest struct {
-	Name string `json:"name"` // 群组名称，1-50字符
+	Name       string `json:"name"`        // 群组名称，1-50字符
+	Visibility string `json:"visibility"`  // 可见性（可选）：public / link / private，默认 public
+	JoinPolicy string `json:"join_policy"` // 加入方式（可选）：open / approval / invite_only，默认 approval
+	Channel    bool   `json:"channel"`     // 是否创建为频道，频道中只有群主和管理员可以发言
+	Forum      bool   `json:"forum"`       // 是否开启论坛模式，消息按话题分开
 }
 
 // GroupResponse 群组信息响应
@@ -11,48 +20,80 @@ type GroupResponse struct {
 	Name        string `json:"name"`
 	OwnerID     string `json:"owner_id"`
 	MemberCount int    `json:"member_count"`
+	Visibility  string `json:"visibility"`
+	JoinPolicy  string `json:"join_policy"`
+	Channel     bool   `json:"channel"`
+	Forum       bool   `json:"forum"`
 	CreatedAt   string `json:"created_at"`
 }
 
 // GroupDetailResponse 群组详情响应
 type GroupDetailResponse struct {
-	GroupID     string            `json:"group_id"`
-	Name        string            `json:"name"`
-	OwnerID     string            `json:"owner_id"`
-	OwnerName   string            `json:"owner_name"`
-	MemberCount int               `json:"member_count"`
-	CreatedAt   string            `json:"created_at"`
-	Members     []GroupMemberInfo `json:"members"`
+	GroupID      string                     `json:"group_id"`
+	Name         string                     `json:"name"`
+	OwnerID      string                     `json:"owner_id"`
+	OwnerName    string                     `json:"owner_name"`
+	MemberCount  int                        `json:"member_count"`
+	MaxMembers   int                        `json:"max_members"` // 成员数量上限，0 表示不限
+	Description  string                     `json:"description,omitempty"`
+	Avatar       string                     `json:"avatar"`
+	Tags         []string                   `json:"tags"`
+	Visibility   string                     `json:"visibility"`
+	JoinPolicy   string                     `json:"join_policy"`
+	Topic        string                     `json:"topic,omitempty"`
+	Welcome      string                     `json:"welcome_message,omitempty"`
+	Announcement *GroupAnnouncementResponse `json:"announcement,omitempty"` // 没有群公告时为空
+	MuteAll      bool                       `json:"mute_all"`
+	SlowMode     int                        `json:"slow_mode_seconds"`
+	Channel      bool                       `json:"channel"`
+	Forum        bool                       `json:"forum"`
+	CreatedAt    string                     `json:"created_at"`
+	Members      []GroupMemberInfo          `json:"members"`      // 最多返回前 100 个成员
+	MoreMembers  bool                       `json:"more_members"` // 为 true 时需要通过成员列表接口获取其余成员
 }
 
 // GroupMemberInfo 群组成员信息
 type GroupMemberInfo struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	Role     string `json:"role"`
-	JoinedAt string `json:"joined_at"`
+	UserID     string `json:"user_id"`
+	Username   string `json:"username"`
+	Nickname   string `json:"nickname,omitempty"` // 群昵称，未设置时为空
+	Role       string `json:"role"`
+	JoinedAt   string `json:"joined_at"`
+	MutedUntil string `json:"muted_until,omitempty"` // 禁言中的成员才有值
+	Online     bool   `json:"online"`
 }
 
-// GroupListResponse 群组列表项
-type GroupListResponse struct {
-	GroupID     string `json:"group_id"`
-	Name        string `json:"name"`
-	OwnerID     string `json:"owner_id"`
-	MemberCount int    `json:"member_count"`
-	Role        string `json:"role"`
-	CreatedAt   string `json:"created_at"`
+// GroupMemberListResponse 群成员分页列表
+type GroupMemberListResponse struct {
+	Members    []GroupMemberInfo `json:"members"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+	HasMore    bool              `json:"has_more"`
 }
 
-// JoinGroupRequest 加入群组请求
-type JoinGroupRequest struct {
-	InviteCode string `json:"invite_code"` // 邀请码（可选）
+// GroupListResponse 群组列表项
+type GroupListResponse struct {
+	GroupID             string   `json:"group_id"`
+	Name                string   `json:"name"`
+	OwnerID             string   `json:"owner_id"`
+	MemberCount         int      `json:"member_count"`
+	Role                string   `json:"role"`
+	Description         string   `json:"description,omitempty"`
+	Avatar              string   `json:"avatar"`
+	Tags                []string `json:"tags"`
+	Visibility          string   `json:"visibility"`
+	JoinPolicy          string   `json:"join_policy"`
+	AnnouncementPending bool     `json:"announcement_pending"` // 有尚未确认的群公告
+	Channel             bool     `json:"channel"`
+	Forum               bool     `json:"forum"`
+	CreatedAt           string   `json:"created_at"`
 }
 
 // JoinGroupResponse 加入群组响应
 type JoinGroupResponse struct {
-	GroupID string `json:"group_id"`
-	Name    string `json:"name"`
-	Status  string `json:"status"` // joined
+	GroupID     string            `json:"group_id"`
+	Name        string            `json:"name"`
+	Status      string            `json:"status"` // joined
+	MemberEvent *GroupMemberEvent `json:"-"`      // 加入群组时在事务中记录的成员变化事件，用于提交后推送
 }
 
 // TransferGroupRequest 转让群组请求
@@ -67,18 +108,64 @@ type JoinGroupByCodeRequest struct {
 
 // JoinGroupByCodeResponse 通过邀请码加入群组响应
 type JoinGroupByCodeResponse struct {
-	GroupID string `json:"group_id"`
-	Name    string `json:"name"`
-	Status  string `json:"status"` // joined
+	GroupID     string            `json:"group_id"`
+	Name        string            `json:"name"`
+	Status      string            `json:"status"` // joined / pending（邀请链接需要审批且不符合自动审批规则时）
+	MemberEvent *GroupMemberEvent `json:"-"`      // 加入群组时在事务中记录的成员变化事件，用于提交后推送
 }
 
 // SearchGroupResponse 搜索群组响应
 type SearchGroupResponse struct {
-	GroupID     string `json:"group_id"`
-	Name        string `json:"name"`
-	OwnerID     string `json:"owner_id"`
-	MemberCount int    `json:"member_count"`
-	CreatedAt   string `json:"created_at"`
+	GroupID     string   `json:"group_id"`
+	Name        string   `json:"name"`
+	OwnerID     string   `json:"owner_id"`
+	MemberCount int      `json:"member_count"`
+	MaxMembers  int      `json:"max_members"` // 成员数量上限，0 表示不限
+	Description string   `json:"description,omitempty"`
+	Avatar      string   `json:"avatar"`
+	Tags        []string `json:"tags"`
+	Visibility  string   `json:"visibility"`
+	JoinPolicy  string   `json:"join_policy"`
+	Channel     bool     `json:"channel"`
+	Forum       bool     `json:"forum"`
+	CreatedAt   string   `json:"created_at"`
+}
+
+// GroupDirectoryItem 群组目录中的群组，附带用于排序的近期活跃度
+type GroupDirectoryItem struct {
+	SearchGroupResponse
+	RecentMessages int64 `json:"recent_messages"` // 最近 7 天的消息数
+}
+
+// GroupDirectoryResponse 群组目录分页响应
+type GroupDirectoryResponse struct {
+	Groups     []GroupDirectoryItem `json:"groups"`
+	NextCursor string               `json:"next_cursor,omitempty"`
+	HasMore    bool                 `json:"has_more"`
+}
+
+// UpdateGroupAccessRequest 修改群组可见性和加入方式请求，空字符串表示不修改
+type UpdateGroupAccessRequest struct {
+	Visibility string `json:"visibility"`            // public / link / private
+	JoinPolicy string `json:"join_policy"`           // open / approval / invite_only
+	MaxMembers *int   `json:"max_members,omitempty"` // 成员数量上限，0 表示不限，不传表示不修改
+}
+
+// GroupJoinRule 入群自动审批规则，符合任一规则的入群申请自动通过
+type GroupJoinRule struct {
+	Type  string `json:"type"`            // friend_of_member / organization / invite_link
+	Value string `json:"value,omitempty"` // organization 规则为组织名称，invite_link 规则为邀请码
+}
+
+// GroupJoinRulesRequest 设置群组的自动审批规则，覆盖已有的规则，传空列表表示清除
+type GroupJoinRulesRequest struct {
+	Rules []GroupJoinRule `json:"rules"`
+}
+
+// GroupJoinRulesResponse 群组的自动审批规则
+type GroupJoinRulesResponse struct {
+	GroupID string          `json:"group_id"`
+	Rules   []GroupJoinRule `json:"rules"`
 }
 
 // RequestJoinGroupRequest 申请加入群组请求
@@ -88,9 +175,10 @@ type RequestJoinGroupRequest struct {
 
 // RequestJoinGroupResponse 申请加入群组响应
 type RequestJoinGroupResponse struct {
-	GroupID string `json:"group_id"`
-	Name    string `json:"name"`
-	Status  string `json:"status"` // pending
+	GroupID     string            `json:"group_id"`
+	Name        string            `json:"name"`
+	Status      string            `json:"status"` // pending / joined（群组允许直接加入或符合自动审批规则时）
+	MemberEvent *GroupMemberEvent `json:"-"`      // 加入群组时在事务中记录的成员变化事件，用于提交后推送
 }
 
 // PendingJoinRequest 待审核的入群请求信息
@@ -102,9 +190,265 @@ type PendingJoinRequest struct {
 	Username  string `json:"username"`
 	Message   string `json:"message"`
 	CreatedAt string `json:"created_at"`
+	ExpiresAt string `json:"expires_at"` // 超过该时间未审批的申请自动过期
 }
 
 // ApproveJoinRequestRequest 审批入群请求
 type ApproveJoinRequestRequest struct {
 	Action string `json:"action"` // approve 或 reject
+	Reason string `json:"reason"` // 审批理由，可选，最多 200 个字符
+}
+
+// BulkReviewJoinRequestsRequest 批量审批入群请求
+type BulkReviewJoinRequestsRequest struct {
+	UserIDs []string `json:"user_ids"` // 申请者ID列表，最多 100 个
+	Action  string   `json:"action"`   // approve 或 reject
+	Reason  string   `json:"reason"`   // 审批理由，可选，对所有申请生效
+}
+
+// JoinRequestReviewResult 批量审批中单个申请的处理结果
+type JoinRequestReviewResult struct {
+	UserID string `json:"user_id"`
+	Status string `json:"status"`          // approved / rejected / failed
+	Error  string `json:"error,omitempty"` // 处理失败的原因
+}
+
+// BulkReviewJoinRequestsResponse 批量审批入群请求的响应
+type BulkReviewJoinRequestsResponse struct {
+	Results []JoinRequestReviewResult `json:"results"`
+}
+
+// JoinRequestEvent 入群申请提交、审批、过期或撤回事件，推送给申请者和有审批权限的成员
+type JoinRequestEvent struct {
+	RequestID   uint              `json:"request_id"`
+	GroupID     string            `json:"group_id"`
+	GroupName   string            `json:"group_name"`
+	UserID      string            `json:"user_id"` // 申请者
+	Username    string            `json:"username"`
+	Message     string            `json:"message,omitempty"`
+	Status      string            `json:"status"`                // pending / approved / rejected / expired / cancelled
+	Reason      string            `json:"reason,omitempty"`      // 审批理由
+	OperatorID  string            `json:"operator_id,omitempty"` // 审批人，过期和撤回时为空
+	CreatedAt   time.Time         `json:"created_at"`
+	ExpiresAt   time.Time         `json:"expires_at"`
+	DecidedAt   *time.Time        `json:"decided_at,omitempty"`
+	MemberEvent *GroupMemberEvent `json:"-"` // 审批通过时在事务中记录的成员加入事件
+}
+
+// GroupPermissionsResponse 群组权限矩阵响应
+type GroupPermissionsResponse struct {
+	GroupID       string            `json:"group_id"`
+	Permissions   map[string]string `json:"permissions"`    // 权限 -> 所需的最低角色
+	MyRole        string            `json:"my_role"`        // 当前用户在群组中的角色
+	MyPermissions []string          `json:"my_permissions"` // 当前用户拥有的权限
+}
+
+// UpdateGroupPermissionsRequest 更新群组权限矩阵请求，只修改传入的权限
+type UpdateGroupPermissionsRequest struct {
+	Permissions map[string]string `json:"permissions"`
+}
+
+// UpdateMemberRoleRequest 设置群成员角色请求
+type UpdateMemberRoleRequest struct {
+	Role string `json:"role"` // admin / member
+}
+
+// MuteMemberRequest 禁言群成员请求
+type MuteMemberRequest struct {
+	DurationSeconds int `json:"duration_seconds"` // 禁言时长，最长 30 天
+}
+
+// UpdateGroupModerationRequest 更新全员禁言和慢速模式请求，未填写的字段保持不变
+type UpdateGroupModerationRequest struct {
+	MuteAll         *bool `json:"mute_all"`
+	SlowModeSeconds *int  `json:"slow_mode_seconds"` // 0 表示关闭慢速模式
+	Channel         *bool `json:"channel"`           // 开启或关闭频道模式，仅群主可以修改
+}
+
+// GroupModerationEvent 群组禁言或慢速模式变化事件，推送给群组所有在线成员
+type GroupModerationEvent struct {
+	GroupID         string     `json:"group_id"`
+	Action          string     `json:"action"` // member_muted / member_unmuted / settings_updated
+	OperatorID      string     `json:"operator_id"`
+	UserID          string     `json:"user_id,omitempty"`     // 被禁言或解除禁言的成员
+	MutedUntil      *time.Time `json:"muted_until,omitempty"` // member_muted 时的禁言截止时间
+	MuteAll         bool       `json:"mute_all"`
+	SlowModeSeconds int        `json:"slow_mode_seconds"`
+	Channel         bool       `json:"channel"`
+}
+
+// BanMemberRequest 封禁用户请求，用户在群组中时会同时被移出
+type BanMemberRequest struct {
+	UserID          string `json:"user_id"`
+	Reason          string `json:"reason"`
+	DurationSeconds int    `json:"duration_seconds"` // 封禁时长，0 表示永久封禁
+}
+
+// GroupBanResponse 群组封禁记录响应
+type GroupBanResponse struct {
+	GroupID     string            `json:"group_id"`
+	UserID      string            `json:"user_id"`
+	Username    string            `json:"username"`
+	OperatorID  string            `json:"operator_id"`
+	Reason      string            `json:"reason,omitempty"`
+	ExpiresAt   *time.Time        `json:"expires_at,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+	Removed     bool              `json:"removed,omitempty"` // 封禁时用户在群组中，已被同时移出
+	MemberEvent *GroupMemberEvent `json:"-"`                 // 用户被同时移出时在事务中记录的成员变化事件
+}
+
+// CreateInviteLinkRequest 创建邀请链接请求
+type CreateInviteLinkRequest struct {
+	MaxUses          int  `json:"max_uses"`           // 最多可使用的次数，0 表示不限
+	ExpiresInSeconds int  `json:"expires_in_seconds"` // 有效期，0 表示永不过期
+	RequiresApproval bool `json:"requires_approval"`  // 通过该链接加入时需要审批
+}
+
+// InviteLinkResponse 邀请链接信息响应
+type InviteLinkResponse struct {
+	Code             string     `json:"code"`
+	GroupID          string     `json:"group_id"`
+	GroupName        string     `json:"group_name"`
+	CreatorID        string     `json:"creator_id,omitempty"`
+	MaxUses          int        `json:"max_uses"`
+	UseCount         int        `json:"use_count"`
+	ExpiresAt        *time.Time `json:"expires_at,omitempty"`
+	RequiresApproval bool       `json:"requires_approval"`
+	RevokedAt        *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+// InviteLinkUseResponse 通过邀请链接加入或申请加入的记录
+type InviteLinkUseResponse struct {
+	UserID    string    `json:"user_id"`
+	Username  string    `json:"username"`
+	Status    string    `json:"status"` // joined / pending / rejected
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// UpdateGroupProfileRequest 修改群组资料请求，未传的字段保持不变
+type UpdateGroupProfileRequest struct {
+	Description *string   `json:"description"` // 群组简介，最多500字符
+	Tags        *[]string `json:"tags"`        // 群组标签，最多10个，每个最多20字符
+}
+
+// UpdateGroupAnnouncementRequest 修改群公告请求
+type UpdateGroupAnnouncementRequest struct {
+	Content string `json:"content"` // 群公告内容，空字符串表示清除群公告
+}
+
+// AckGroupAnnouncementRequest 确认群公告请求
+type AckGroupAnnouncementRequest struct {
+	Version int `json:"version"` // 确认的群公告版本，必须是当前版本
+}
+
+// GroupAnnouncementResponse 群公告信息
+type GroupAnnouncementResponse struct {
+	Content   string `json:"content"`
+	Version   int    `json:"version"`
+	UpdatedAt string `json:"updated_at"`
+	Acked     bool   `json:"acked"`     // 当前用户是否已确认
+	AckCount  int64  `json:"ack_count"` // 已确认当前版本的成员数
+}
+
+// GroupProfileEvent 群组资料变化事件，推送给群组所有在线成员
+type GroupProfileEvent struct {
+	GroupID             string   `json:"group_id"`
+	OperatorID          string   `json:"operator_id"`
+	Description         string   `json:"description"`
+	Avatar              string   `json:"avatar"`
+	Tags                []string `json:"tags"`
+	Announcement        string   `json:"announcement"`
+	AnnouncementVersion int      `json:"announcement_version"`
+	Visibility          string   `json:"visibility"`
+	JoinPolicy          string   `json:"join_policy"`
+	MaxMembers          int      `json:"max_members"`
+	Forum               bool     `json:"forum"`
+}
+
+// UpdateGroupForumRequest 开启或关闭论坛模式请求
+type UpdateGroupForumRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// CreateGroupTopicRequest 创建论坛话题请求
+type CreateGroupTopicRequest struct {
+	Name string `json:"name"` // 话题名称，1-50字符
+}
+
+// PinGroupTopicRequest 置顶或取消置顶话题请求
+type PinGroupTopicRequest struct {
+	Pinned bool `json:"pinned"`
+}
+
+// GroupTopicResponse 论坛话题信息
+type GroupTopicResponse struct {
+	TopicID       string     `json:"topic_id"`
+	GroupID       string     `json:"group_id"`
+	Name          string     `json:"name"`
+	CreatorID     string     `json:"creator_id"`
+	IsPinned      bool       `json:"is_pinned"`
+	PinnedAt      *time.Time `json:"pinned_at,omitempty"`
+	LastMessageAt *time.Time `json:"last_message_at,omitempty"`
+	UnreadCount   int64      `json:"unread_count"` // 当前用户的未读消息数，自己发送的消息不计入
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// GroupTopicEvent 话题创建或置顶变化事件，推送给群组所有在线成员
+type GroupTopicEvent struct {
+	GroupID    string             `json:"group_id"`
+	Action     string             `json:"action"` // created / pinned / unpinned
+	OperatorID string             `json:"operator_id"`
+	Topic      GroupTopicResponse `json:"topic"`
+}
+
+// SetGroupNicknameRequest 设置群昵称请求，昵称为空时清除
+type SetGroupNicknameRequest struct {
+	Nickname string `json:"nickname"`
+}
+
+// GroupNicknameEvent 成员群昵称变化事件，推送给群组所有在线成员
+type GroupNicknameEvent struct {
+	GroupID    string `json:"group_id"`
+	UserID     string `json:"user_id"`
+	Nickname   string `json:"nickname"` // 为空表示已清除
+	OperatorID string `json:"operator_id"`
+}
+
+// GroupAuditLogFilter 审计日志的筛选条件，空字段表示不筛选
+type GroupAuditLogFilter struct {
+	Action   string
+	ActorID  string
+	TargetID string
+}
+
+// GroupAuditLogResponse 一条群组审计日志
+type GroupAuditLogResponse struct {
+	LogID          string          `json:"log_id"`
+	GroupID        string          `json:"group_id"`
+	ActorID        string          `json:"actor_id"`
+	ActorUsername  string          `json:"actor_username"`
+	Action         string          `json:"action"`
+	TargetID       string          `json:"target_id,omitempty"`
+	TargetUsername string          `json:"target_username,omitempty"`
+	Payload        json.RawMessage `json:"payload,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// GroupAuditLogListResponse 审计日志分页列表，按时间倒序排列
+type GroupAuditLogListResponse struct {
+	Logs       []GroupAuditLogResponse `json:"logs"`
+	NextCursor string                  `json:"next_cursor,omitempty"`
+	HasMore    bool                    `json:"has_more"`
+}
+
+// GroupMemberEvent 成员加入、退出、被移除、群主转让或群组解散事件
+type GroupMemberEvent struct {
+	GroupID          string `json:"group_id"`
+	Action           string `json:"action"`            // joined / left / removed / owner_transferred / disbanded
+	UserID           string `json:"user_id,omitempty"` // 加入、退出或被移除的成员，群主转让时为新群主
+	Username         string `json:"username,omitempty"`
+	OperatorID       string `json:"operator_id,omitempty"` // 审批、移除、转让或解散的操作者，成员自己加入或退出时为空
+	OperatorUsername string `json:"operator_username,omitempty"`
+	MessageID        string `json:"message_id,omitempty"` // 群组时间线中对应的系统消息，群组解散时为空
 }
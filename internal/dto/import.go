@@ -0,0 +1,53 @@
+package dto
+
+import "time"
+
+// ImportRecord 导入文件中的单条记录
+// NDJSON 格式下每行一条记录，通过 Type 区分记录类型；
+// JSON 格式下记录按类型分别放在 ImportDocument 的各个数组中，Type 可省略
+type ImportRecord struct {
+	Type string `json:"type"` // user / group / member / message
+
+	// user: ID、Username 必填，Password、MapTo 可选
+	// group: ID、Name、OwnerID 必填
+	// member: GroupID、UserID 必填，Role 可选，目前仅支持 member
+	// message: ChatType、FromUserID、TargetID、Content、CreatedAt 必填，Kind 可选
+	ID         string     `json:"id,omitempty"` // 外部系统中的ID
+	Username   string     `json:"username,omitempty"`
+	Password   string     `json:"password,omitempty"`
+	MapTo      string     `json:"map_to,omitempty"` // 映射到本系统中已有的用户名，不创建新用户
+	Name       string     `json:"name,omitempty"`
+	OwnerID    string     `json:"owner_id,omitempty"`
+	GroupID    string     `json:"group_id,omitempty"`
+	UserID     string     `json:"user_id,omitempty"`
+	Role       string     `json:"role,omitempty"`
+	ChatType   string     `json:"chat_type,omitempty"`
+	FromUserID string     `json:"from_user_id,omitempty"`
+	TargetID   string     `json:"target_id,omitempty"`
+	Kind       string     `json:"kind,omitempty"`
+	Content    string     `json:"content,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+}
+
+// ImportDocument JSON 格式的导入文件
+type ImportDocument struct {
+	Users    []ImportRecord `json:"users"`
+	Groups   []ImportRecord `json:"groups"`
+	Members  []ImportRecord `json:"members"`
+	Messages []ImportRecord `json:"messages"`
+}
+
+// ImportReport 导入结果统计
+type ImportReport struct {
+	JobID          string   `json:"job_id,omitempty"`
+	DryRun         bool     `json:"dry_run"`
+	Skipped        int      `json:"skipped"` // 断点续传时跳过的已处理记录数
+	Processed      int      `json:"processed"`
+	UsersCreated   int      `json:"users_created"`
+	UsersMatched   int      `json:"users_matched"` // 通过 map_to 映射到已有用户
+	GroupsCreated  int      `json:"groups_created"`
+	MembersAdded   int      `json:"members_added"`
+	MessagesAdded  int      `json:"messages_added"`
+	Invalid        int      `json:"invalid"` // 校验失败被跳过的记录数
+	ValidationErrs []string `json:"validation_errors,omitempty"`
+}
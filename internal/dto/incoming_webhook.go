@@ -0,0 +1,31 @@
+package dto
+
+import "time"
+
+// CreateIncomingWebhookRequest 创建传入 Webhook 请求
+type CreateIncomingWebhookRequest struct {
+	Name string `json:"name"` // 集成名称，作为消息发送者显示
+}
+
+// IncomingWebhookResponse 传入 Webhook 信息响应
+type IncomingWebhookResponse struct {
+	WebhookID  string     `json:"webhook_id"`
+	GroupID    string     `json:"group_id"`
+	Name       string     `json:"name"`
+	UserID     string     `json:"user_id"` // 集成账号ID，即消息的 from_user
+	Avatar     string     `json:"avatar"`
+	CreatorID  string     `json:"creator_id"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
+// IncomingWebhookURLResponse 创建传入 Webhook 或重新生成令牌的响应，地址中包含令牌，只返回这一次
+type IncomingWebhookURLResponse struct {
+	Webhook IncomingWebhookResponse `json:"webhook"`
+	URL     string                  `json:"url"`
+}
+
+// IncomingWebhookMessageRequest 外部系统通过传入 Webhook 发送的消息
+type IncomingWebhookMessageRequest struct {
+	Text string `json:"text"`
+}
@@ -14,17 +14,23 @@ type MessageResponse struct {
 	FromUserID  string    `json:"from_user_id"`
 	TargetID    string    `json:"target_id"`
 	Type        string    `json:"type"`
+	Kind        string    `json:"kind"`
 	Content     string    `json:"content"`
+	TopicID     string    `json:"topic_id,omitempty"` // 论坛模式群组中消息所属的话题
 	CreatedAt   time.Time `json:"created_at"`
 	FromUser    *UserInfo `json:"from_user,omitempty"`
 	TargetUser  *UserInfo `json:"target_user,omitempty"`
 	TargetGroup *GroupInfo `json:"target_group,omitempty"`
+	Poll        *PollResponse `json:"poll,omitempty"` // 投票消息的投票详情
+	Card        *CardResponse `json:"card,omitempty"` // 卡片消息的卡片内容
 }
 
 type UserInfo struct {
 	UserID   string `json:"user_id"`
 	Username string `json:"username"`
+	Nickname string `json:"nickname,omitempty"` // 群消息中发送者的群昵称
 	Avatar   string `json:"avatar"`
+	IsBot    bool   `json:"is_bot,omitempty"`
 }
 
 type GroupInfo struct {
@@ -63,6 +69,10 @@ type PrivateConversation struct {
 	Avatar       string    `json:"avatar"`
 	LastContent  string    `json:"last_content"`
 	LastTime     time.Time `json:"last_time"`
+	IsPinned     bool      `json:"is_pinned"`
+	IsArchived   bool      `json:"is_archived"`
+	IsMuted      bool      `json:"is_muted"`
+	MutedUntil   *time.Time `json:"muted_until,omitempty"`
 }
 
 type GroupConversation struct {
@@ -72,9 +82,36 @@ type GroupConversation struct {
 	LastTime     time.Time `json:"last_time"`
 	LastSenderID string    `json:"last_sender_id"`
 	LastSenderName string  `json:"last_sender_name"`
+	Forum          bool       `json:"forum"`
+	LastTopicID    string     `json:"last_topic_id,omitempty"`   // 论坛模式下最后一条消息所属的话题
+	LastTopicName  string     `json:"last_topic_name,omitempty"` // 论坛模式下最后一条消息所属话题的名称
+	UnreadTopics   int        `json:"unread_topics"`             // 论坛模式下有未读消息的话题数
+	IsPinned       bool       `json:"is_pinned"`
+	IsArchived     bool       `json:"is_archived"`
+	IsMuted        bool       `json:"is_muted"`
+	MutedUntil     *time.Time `json:"muted_until,omitempty"`
 }
 
 type GetConversationListResponse struct {
 	PrivateConversations []PrivateConversation `json:"private_conversations"`
 	GroupConversations   []GroupConversation   `json:"group_conversations"`
 }
+
+// UpdateConversationSettingRequest 更新会话设置请求，未传的字段保持不变
+type UpdateConversationSettingRequest struct {
+	Pinned     *bool   `json:"pinned"`
+	Archived   *bool   `json:"archived"`
+	MutedUntil *string `json:"muted_until"` // RFC3339 格式的免打扰截止时间，空字符串表示取消免打扰
+	Hidden     *bool   `json:"hidden"`      // 仅私聊可隐藏
+}
+
+// ConversationSettingResponse 会话设置响应，同时作为多设备同步事件的数据
+type ConversationSettingResponse struct {
+	ChatType   string     `json:"chat_type"`
+	TargetID   string     `json:"target_id"`
+	IsPinned   bool       `json:"is_pinned"`
+	IsArchived bool       `json:"is_archived"`
+	IsMuted    bool       `json:"is_muted"`
+	MutedUntil *time.Time `json:"muted_until,omitempty"`
+	IsHidden   bool       `json:"is_hidden"`
+}
@@ -0,0 +1,43 @@
+package dto
+
+import "time"
+
+// CreatePollRequest 在群聊中发起投票请求
+type CreatePollRequest struct {
+	GroupID        string   `json:"group_id"`
+	Question       string   `json:"question"`
+	Options        []string `json:"options"` // 2 ~ 10 个选项
+	MultipleChoice bool     `json:"multiple_choice"`
+	Anonymous      bool     `json:"anonymous"`
+	ClosesAt       string   `json:"closes_at"` // RFC3339 格式的截止时间（可选）
+}
+
+// VotePollRequest 投票请求，会覆盖用户之前的选择，传空数组表示撤回投票
+type VotePollRequest struct {
+	OptionIDs []string `json:"option_ids"`
+}
+
+// PollResponse 投票详情，同时作为投票实时更新事件的数据
+type PollResponse struct {
+	PollID         string               `json:"poll_id"`
+	MessageID      string               `json:"message_id"`
+	GroupID        string               `json:"group_id"`
+	CreatorID      string               `json:"creator_id"`
+	Question       string               `json:"question"`
+	MultipleChoice bool                 `json:"multiple_choice"`
+	Anonymous      bool                 `json:"anonymous"`
+	ClosesAt       *time.Time           `json:"closes_at,omitempty"`
+	Closed         bool                 `json:"closed"`
+	TotalVoters    int                  `json:"total_voters"`
+	Options        []PollOptionResponse `json:"options"`
+	MyOptionIDs    []string             `json:"my_option_ids,omitempty"` // 当前用户选择的选项，实时更新事件中不包含
+	CreatedAt      time.Time            `json:"created_at"`
+}
+
+// PollOptionResponse 投票选项及计票结果
+type PollOptionResponse struct {
+	OptionID  string     `json:"option_id"`
+	Text      string     `json:"text"`
+	VoteCount int        `json:"vote_count"`
+	Voters    []UserInfo `json:"voters,omitempty"` // 匿名投票不返回投票人
+}
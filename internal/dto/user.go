@@ -2,9 +2,10 @@ package dto
 
 // UserInfoResponse 用户信息响应
 type UserInfoResponse struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	Avatar   string `json:"avatar"`
+	UserID       string `json:"user_id"`
+	Username     string `json:"username"`
+	Avatar       string `json:"avatar"`
+	Organization string `json:"organization,omitempty"` // 所属的组织
 }
 
 // FriendInfoResponse 好友信息响应
@@ -0,0 +1,90 @@
+package dto
+
+import "time"
+
+// CreateWebhookRequest 注册 Webhook 请求
+type CreateWebhookRequest struct {
+	Scope    string   `json:"scope"`     // group：订阅群组事件；user：订阅与自己相关的事件
+	TargetID string   `json:"target_id"` // Scope 为 group 时为群ID，user 时可不填
+	URL      string   `json:"url"`
+	Events   []string `json:"events"`
+}
+
+// UpdateWebhookRequest 更新 Webhook 请求，未填写的字段保持不变
+type UpdateWebhookRequest struct {
+	URL     *string  `json:"url"`
+	Events  []string `json:"events"`
+	Enabled *bool    `json:"enabled"` // 重新启用时清零连续失败次数
+}
+
+// WebhookResponse Webhook 信息响应
+type WebhookResponse struct {
+	WebhookID      string    `json:"webhook_id"`
+	Scope          string    `json:"scope"`
+	TargetID       string    `json:"target_id"`
+	URL            string    `json:"url"`
+	Events         []string  `json:"events"`
+	Enabled        bool      `json:"enabled"`
+	FailureCount   int       `json:"failure_count"`
+	DisabledReason string    `json:"disabled_reason,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// WebhookSecretResponse 创建 Webhook 或重新生成密钥的响应，密钥明文只返回这一次
+type WebhookSecretResponse struct {
+	Webhook WebhookResponse `json:"webhook"`
+	Secret  string          `json:"secret"`
+}
+
+// WebhookDeliveryResponse 投递日志
+type WebhookDeliveryResponse struct {
+	DeliveryID     string     `json:"delivery_id"`
+	Event          string     `json:"event"`
+	Payload        string     `json:"payload"`
+	Status         string     `json:"status"` // pending / succeeded / failed
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  time.Time  `json:"next_attempt_at"`
+	LastStatusCode int        `json:"last_status_code,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+}
+
+// WebhookEventPayload 推送给外部系统的请求体
+type WebhookEventPayload struct {
+	ID        string      `json:"id"` // 事件ID，重试时保持不变，可用于去重
+	Event     string      `json:"event"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+// WebhookMessageData 群组新消息事件数据
+type WebhookMessageData struct {
+	MessageID string    `json:"message_id"`
+	GroupID   string    `json:"group_id"`
+	SenderID  string    `json:"sender_id"`
+	Kind      string    `json:"kind"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WebhookMemberData 成员加入或离开群组事件数据
+type WebhookMemberData struct {
+	GroupID    string `json:"group_id"`
+	UserID     string `json:"user_id"`
+	OperatorID string `json:"operator_id,omitempty"` // 审批人或移除成员的操作人
+}
+
+// WebhookFriendData 好友申请通过事件数据
+type WebhookFriendData struct {
+	SenderID   string `json:"sender_id"`
+	ReceiverID string `json:"receiver_id"`
+}
+
+// WebhookGroupData 群组解散事件数据
+type WebhookGroupData struct {
+	GroupID    string `json:"group_id"`
+	Name       string `json:"name"`
+	OperatorID string `json:"operator_id"`
+}
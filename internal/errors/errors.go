@@ -15,6 +15,7 @@ const (
 const (
 	ErrCodeInvalidRefreshToken = 2001
 	ErrCodeInvalidCredentials  = 2002
+	ErrCodeInvalidIncomingWebhook = 2003
 )
 
 const (
@@ -52,6 +53,45 @@ const (
 	ErrCodeFailedToApproveJoinRequest    = 5027
 	ErrCodeCannotRequestWithinCooldown  = 5028
 	ErrCodeAlreadyRequested             = 5029
+	ErrCodeFailedToUpdateConversation  = 5030
+	ErrCodeFailedToCreateExport        = 5031
+	ErrCodeExportJobNotFound           = 5032
+	ErrCodeExportNotReady              = 5033
+	ErrCodeFailedToCreatePoll          = 5034
+	ErrCodePollNotFound                = 5035
+	ErrCodePollClosed                  = 5036
+	ErrCodeFailedToVote                = 5037
+	ErrCodeFailedToSendCard            = 5038
+	ErrCodeCardNotFound                = 5039
+	ErrCodeCardActionAlreadyRecorded   = 5040
+	ErrCodeFailedToHandleCardAction    = 5041
+	ErrCodeFailedToSendMessage         = 5042
+	ErrCodeFailedToCreateBot           = 5043
+	ErrCodeBotNotFound                 = 5044
+	ErrCodeFailedToAddBotToGroup       = 5045
+	ErrCodeWebhookNotFound             = 5046
+	ErrCodeFailedToCreateWebhook       = 5047
+	ErrCodeWebhookDeliveryNotFound     = 5048
+	ErrCodeIncomingWebhookNotFound     = 5049
+	ErrCodeFailedToCreateIncomingHook  = 5050
+	ErrCodeCommandNotFound             = 5051
+	ErrCodeFailedToRegisterCommand     = 5052
+	ErrCodeCommandNameTaken            = 5053
+	ErrCodeAutoReplyNotFound           = 5054
+	ErrCodeFailedToCreateAutoReply     = 5055
+	ErrCodeAssistantNotFound           = 5056
+	ErrCodeFailedToSaveAssistant       = 5057
+	ErrCodePostingRestricted           = 5058
+	ErrCodeBannedFromGroup             = 5059
+	ErrCodeBanNotFound                 = 5060
+	ErrCodeInviteLinkNotFound          = 5061
+	ErrCodeGroupAvatarNotFound         = 5062
+	ErrCodeAnnouncementNotFound        = 5063
+	ErrCodeJoinNotAllowed              = 5064
+	ErrCodeTopicNotFound               = 5065
+	ErrCodeGroupFull                   = 5066
+	ErrCodeTooManyExportJobs           = 5067
+	ErrCodeCardAlreadyResolved         = 5068
 )
 
 var (
@@ -63,6 +103,7 @@ var (
 		ErrCodeUserNotFound:           "user not found",
 		ErrCodeInvalidRefreshToken:    "invalid refresh token",
 		ErrCodeInvalidCredentials:     "invalid username or password",
+		ErrCodeInvalidIncomingWebhook:      "invalid incoming webhook",
 		ErrCodeUserIDRequired:         "userID is required",
 		ErrCodeInternalError:          "internal server error",
 		ErrCodeUsernameAlreadyExists:  "username already exists",
@@ -94,6 +135,45 @@ var (
 		ErrCodeFailedToApproveJoinRequest:    "failed to approve join request",
 		ErrCodeCannotRequestWithinCooldown:  "cannot request within cooldown period",
 		ErrCodeAlreadyRequested:             "already requested",
+		ErrCodeFailedToUpdateConversation:  "failed to update conversation setting",
+		ErrCodeFailedToCreateExport:        "failed to create export job",
+		ErrCodeExportJobNotFound:           "export job not found",
+		ErrCodeExportNotReady:              "export is not ready",
+		ErrCodeFailedToCreatePoll:          "failed to create poll",
+		ErrCodePollNotFound:                "poll not found",
+		ErrCodePollClosed:                  "poll is closed",
+		ErrCodeFailedToVote:                "failed to vote",
+		ErrCodeFailedToSendCard:            "failed to send card",
+		ErrCodeCardNotFound:                "card not found",
+		ErrCodeCardActionAlreadyRecorded:   "card action already recorded",
+		ErrCodeFailedToHandleCardAction:    "failed to handle card action",
+		ErrCodeFailedToSendMessage:         "failed to send message",
+		ErrCodeFailedToCreateBot:           "failed to create bot",
+		ErrCodeBotNotFound:                 "bot not found",
+		ErrCodeFailedToAddBotToGroup:       "failed to add bot to group",
+		ErrCodeWebhookNotFound:             "webhook not found",
+		ErrCodeFailedToCreateWebhook:       "failed to create webhook",
+		ErrCodeWebhookDeliveryNotFound:     "webhook delivery not found",
+		ErrCodeIncomingWebhookNotFound:     "incoming webhook not found",
+		ErrCodeFailedToCreateIncomingHook:  "failed to create incoming webhook",
+		ErrCodeCommandNotFound:             "command not found",
+		ErrCodeFailedToRegisterCommand:     "failed to register command",
+		ErrCodeCommandNameTaken:            "command name already taken",
+		ErrCodeAutoReplyNotFound:           "auto reply not found",
+		ErrCodeFailedToCreateAutoReply:     "failed to create auto reply",
+		ErrCodeAssistantNotFound:           "assistant not found",
+		ErrCodeFailedToSaveAssistant:       "failed to save assistant",
+		ErrCodePostingRestricted:           "posting is restricted",
+		ErrCodeBannedFromGroup:             "banned from group",
+		ErrCodeBanNotFound:                 "ban not found",
+		ErrCodeInviteLinkNotFound:          "invite link not found",
+		ErrCodeGroupAvatarNotFound:         "group avatar not found",
+		ErrCodeAnnouncementNotFound:        "announcement not found",
+		ErrCodeJoinNotAllowed:              "join not allowed",
+		ErrCodeTopicNotFound:               "topic not found",
+		ErrCodeGroupFull:                   "group is full",
+		ErrCodeTooManyExportJobs:           "too many export jobs in progress",
+		ErrCodeCardAlreadyResolved:         "card already resolved",
 	}
 )
 
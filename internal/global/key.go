@@ -5,4 +5,6 @@ const (
 	JwtKeyUserName  = "user_name"
 	JwtKeyClaims    = "claims"
 	JwtBearerPrefix = "Bearer"
+	JwtKeyIsBot     = "is_bot"
+	BotTokenPrefix  = "Bot"
 )
@@ -1,8 +1,10 @@
 package middleware
 
 import (
+	"chat_backend/internal/errors"
 	"chat_backend/internal/global"
 	"chat_backend/internal/response"
+	"context"
 	"net/http"
 	"strings"
 	"time"
@@ -27,6 +29,11 @@ type JWTConfig struct {
 
 var jwtConfig *JWTConfig
 
+// BotTokenValidator 校验机器人令牌，返回机器人的用户ID和用户名
+type BotTokenValidator func(ctx context.Context, token string) (string, string, error)
+
+var botTokenValidator BotTokenValidator
+
 // GetJWTConfig 获取JWT配置（用于其他包访问）
 func GetJWTConfig() *JWTConfig {
 	return jwtConfig
@@ -41,6 +48,11 @@ func InitJWT(secretKey string, accessExpiry, refreshExpiry time.Duration) {
 	}
 }
 
+// InitBotAuth 初始化机器人令牌校验函数
+func InitBotAuth(validator BotTokenValidator) {
+	botTokenValidator = validator
+}
+
 // GenerateAccessToken 生成访问令牌
 func GenerateAccessToken(userID, username string) (string, error) {
 	if jwtConfig == nil {
@@ -114,6 +126,19 @@ func JWTMiddleware() echo.MiddlewareFunc {
 				if len(parts) == 2 && parts[0] == global.JwtBearerPrefix {
 					tokenString = parts[1]
 				}
+				// 机器人使用长期令牌认证：Authorization: Bot <token>
+				if len(parts) == 2 && parts[0] == global.BotTokenPrefix && botTokenValidator != nil {
+					botID, botName, err := botTokenValidator(c.Request().Context(), parts[1])
+					if err != nil {
+						return response.Error(c, 2001, "invalid bot token")
+					}
+
+					c.Set(global.JwtKeyUserID, botID)
+					c.Set(global.JwtKeyUserName, botName)
+					c.Set(global.JwtKeyIsBot, true)
+
+					return next(c)
+				}
 			} else {
 				// 从URL参数获取
 				tokenString = c.QueryParam("token")
@@ -130,6 +155,21 @@ func JWTMiddleware() echo.MiddlewareFunc {
 			c.Set(global.JwtKeyUserID, claims.UserID)
 			c.Set(global.JwtKeyUserName, claims.Username)
 			c.Set(global.JwtKeyClaims, claims)
+			c.Set(global.JwtKeyIsBot, false)
+
+			return next(c)
+		}
+	}
+}
+
+// BotAllowlistMiddleware 只允许机器人访问 allowed 中列出的接口，需放在 JWTMiddleware 之后。
+// allowed 的 key 为请求方法和注册的路由路径，如 "POST /api/v1/message/send"；普通用户不受限制
+func BotAllowlistMiddleware(allowed map[string]bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if isBot, _ := c.Get(global.JwtKeyIsBot).(bool); isBot && !allowed[c.Request().Method+" "+c.Path()] {
+				return response.Error(c, errors.ErrCodePermissionDenied, "bots are not allowed to use this api")
+			}
 
 			return next(c)
 		}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// GroupAssistant 群组助手配置，每个群组最多一个助手
+// 助手对应一个集成账号（UserID），成员在消息中 @助手名称 时由 Responder 生成回复并以该账号的名义发送
+type GroupAssistant struct {
+	GroupID     string    `gorm:"type:uuid;primaryKey"`
+	UserID      string    `gorm:"type:uuid;not null;uniqueIndex"`
+	CreatorID   string    `gorm:"type:uuid;not null"`
+	Name        string    `gorm:"type:text;not null"`
+	Responder   string    `gorm:"type:text;not null"`           // 已注册的回复器名称，如 echo、rule
+	Config      string    `gorm:"type:text"`                    // 回复器的 JSON 配置
+	ContextSize int       `gorm:"type:int;not null;default:20"` // 作为上下文的最近群消息条数
+	Enabled     bool      `gorm:"not null;default:true"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
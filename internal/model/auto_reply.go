@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// GroupAutoReply 群组自动回复规则，群消息存储后按创建顺序匹配，只回复第一条命中的规则
+type GroupAutoReply struct {
+	ID              string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	GroupID         string    `gorm:"type:uuid;not null;index"`
+	CreatorID       string    `gorm:"type:uuid;not null"`
+	MatchType       string    `gorm:"type:text;not null"` // keyword：包含关键词（忽略大小写）；regex：正则表达式
+	Pattern         string    `gorm:"type:text;not null"`
+	Reply           string    `gorm:"type:text;not null"`
+	CooldownSeconds int       `gorm:"type:int;not null;default:60"` // 同一规则两次回复的最小间隔
+	Enabled         bool      `gorm:"not null;default:true"`
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time `gorm:"autoUpdateTime"`
+}
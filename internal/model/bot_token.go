@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// BotToken 机器人的长期访问令牌，只保存令牌的 SHA-256 哈希
+type BotToken struct {
+	ID         string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	BotID      string    `gorm:"type:uuid;not null;index"`
+	TokenHash  string    `gorm:"type:text;not null;uniqueIndex"`
+	Prefix     string    `gorm:"type:text;not null"` // 令牌前几位，便于用户识别
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
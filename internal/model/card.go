@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// CardMessage 交互式卡片，与一条 Kind 为 card 的消息一一对应
+type CardMessage struct {
+	ID             string      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	MessageID      string      `gorm:"type:uuid;not null;uniqueIndex"`
+	Type           MessageType `gorm:"type:text;not null"`
+	TargetID       string      `gorm:"type:uuid;not null"` // 用户ID或群ID，根据Type来判断
+	CreatorID      string      `gorm:"type:uuid;not null"`
+	Content        string      `gorm:"type:text;not null"` // 卡片内容（标题、字段、图片、按钮），JSON 格式
+	Handler        string      `gorm:"type:text"`          // 按钮点击的处理器名称
+	CallbackURL    string      `gorm:"type:text"`          // 按钮点击的回调地址
+	CallbackSecret string      `gorm:"type:text"`          // 回调请求的签名密钥，只在发送卡片时返回给发送者
+	CreatedAt      time.Time   `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time   `gorm:"autoUpdateTime"`
+}
+
+// CardAction 卡片按钮点击记录，每个用户对同一张卡片只能点击一次
+type CardAction struct {
+	CardID    string    `gorm:"type:uuid;not null;primaryKey"`
+	UserID    string    `gorm:"type:uuid;not null;primaryKey"`
+	ActionID  string    `gorm:"type:text;not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
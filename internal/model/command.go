@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+// GroupCommand 机器人或集成在群组中注册的斜杠命令
+type GroupCommand struct {
+	ID             string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	GroupID        string    `gorm:"type:uuid;not null;uniqueIndex:idx_group_command"`
+	Name           string    `gorm:"type:text;not null;uniqueIndex:idx_group_command"` // 不含前缀 /
+	Description    string    `gorm:"type:text"`
+	Usage          string    `gorm:"type:text"`
+	BotID          string    `gorm:"type:uuid;not null;index"` // 处理命令的机器人或集成账号
+	CallbackURL    string    `gorm:"type:text"`                // 为空时通过 WebSocket 转发给机器人
+	CallbackSecret string    `gorm:"type:text"`                // 回调请求的签名密钥，注册时返回给注册者
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+}
+
+// Reminder 通过 /remind 命令创建的提醒
+type Reminder struct {
+	ID          string      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID      string      `gorm:"type:uuid;not null;index"`
+	ChatType    MessageType `gorm:"type:text;not null"`
+	TargetID    string      `gorm:"type:uuid;not null"` // 创建提醒时所在的会话
+	Text        string      `gorm:"type:text;not null"`
+	RemindAt    time.Time   `gorm:"not null;index"`
+	FiredAt     *time.Time  `gorm:"index"` // 到期触发的时间
+	DeliveredAt *time.Time  // 推送给用户的时间，用户离线时在下次连接时推送
+	CreatedAt   time.Time   `gorm:"autoCreateTime"`
+}
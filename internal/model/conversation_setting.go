@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// ConversationSetting 用户对单个会话的个性化设置（置顶、归档、免打扰、隐藏）
+type ConversationSetting struct {
+	UserID     string      `gorm:"type:uuid;not null;primaryKey"`
+	TargetID   string      `gorm:"type:uuid;not null;primaryKey"` // 好友ID或群ID，根据Type来判断
+	Type       MessageType `gorm:"type:text;not null;primaryKey"`
+	IsPinned   bool        `gorm:"not null;default:false"`
+	PinnedAt   *time.Time  // 置顶时间，置顶会话按该时间倒序排列
+	IsArchived bool        `gorm:"not null;default:false"`
+	MutedUntil *time.Time  // 免打扰截止时间，为空表示未开启免打扰
+	IsHidden   bool        `gorm:"not null;default:false"` // 仅私聊：隐藏会话但保留聊天记录
+	HiddenAt   *time.Time  // 隐藏时间，此后有新消息时会话会重新出现
+	UpdatedAt  time.Time   `gorm:"autoUpdateTime"`
+}
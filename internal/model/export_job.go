@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// ExportJob 聊天记录导出任务
+type ExportJob struct {
+	ID          string      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID      string      `gorm:"type:uuid;not null;index"`
+	Type        MessageType `gorm:"type:text;not null"`
+	TargetID    string      `gorm:"type:uuid;not null"`                 // 好友ID或群ID，根据Type来判断
+	Formats     string      `gorm:"type:text;not null"`                 // 逗号分隔的导出格式：json,html,txt
+	Status      string      `gorm:"type:text;not null;default:pending"` // pending / running / completed / failed / expired
+	FilePath    string      `gorm:"type:text"`                          // 生成的压缩包路径
+	Error       string      `gorm:"type:text"`
+	CreatedAt   time.Time   `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time   `gorm:"autoUpdateTime"`
+	StartedAt   *time.Time  // 开始执行的时间，超过租期仍未完成时视为执行中断
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time `gorm:"index"` // 压缩包的过期时间，过期后删除文件
+}
@@ -26,6 +26,31 @@ func main() {
 		model.FriendRequest{},
 		model.GroupJoinRequest{},
 		model.MessageReceipt{},
+		model.ConversationSetting{},
+		model.ExportJob{},
+		model.ImportJob{},
+		model.ImportMapping{},
+		model.Poll{},
+		model.PollOption{},
+		model.PollVote{},
+		model.CardMessage{},
+		model.CardAction{},
+		model.BotToken{},
+		model.Webhook{},
+		model.WebhookDelivery{},
+		model.IncomingWebhook{},
+		model.GroupCommand{},
+		model.Reminder{},
+		model.GroupAutoReply{},
+		model.GroupAssistant{},
+		model.GroupPermission{},
+		model.GroupBan{},
+		model.InvitationUse{},
+		model.GroupTag{},
+		model.GroupTopic{},
+		model.GroupTopicRead{},
+		model.GroupAuditLog{},
+		model.GroupJoinRule{},
 	)
 
 	g.Execute()
@@ -7,11 +7,25 @@ import (
 )
 
 type Group struct {
-	ID          string         `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	Name        string         `gorm:"type:text;not null"`
-	OwnerID     string         `gorm:"type:uuid;not null"`
-	MemberCount int            `gorm:"type:int;not null"`
-	CreatedAt   time.Time      `gorm:"autoCreateTime"`
-	UpdatedAt   time.Time      `gorm:"autoUpdateTime"`
-	DeletedAt   gorm.DeletedAt `gorm:"index"`
+	ID                  string         `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Name                string         `gorm:"type:text;not null"`
+	OwnerID             string         `gorm:"type:uuid;not null"`
+	MemberCount         int            `gorm:"type:int;not null"`
+	MaxMembers          int            `gorm:"type:int;not null;default:0"` // 成员数量上限，0 表示不限
+	Topic               string         `gorm:"type:text"`
+	WelcomeMessage      string         `gorm:"type:text"`                   // 新成员入群时发送的欢迎语，支持 {username} 和 {group} 占位符
+	MuteAll             bool           `gorm:"not null;default:false"`      // 全员禁言，群主和管理员不受限制
+	SlowModeSeconds     int            `gorm:"type:int;not null;default:0"` // 慢速模式下每个成员两次发言的最小间隔，0 表示关闭
+	Description         string         `gorm:"type:text"`
+	AvatarFile          string         `gorm:"type:text"` // 上传的群头像在存储目录中的文件名，为空时使用默认头像
+	Announcement        string         `gorm:"type:text"`
+	AnnouncementVersion int            `gorm:"type:int;not null;default:0"` // 每次修改群公告递增，成员确认时记录确认的版本
+	AnnouncementAt      *time.Time     // 群公告最后修改时间
+	Visibility          string         `gorm:"type:text;not null;default:public"`   // public / link / private，决定群组能否被搜索和预览
+	JoinPolicy          string         `gorm:"type:text;not null;default:approval"` // open / approval / invite_only
+	IsChannel           bool           `gorm:"not null;default:false"`              // 频道模式：只有群主和管理员可以发言，不为订阅者创建投递回执
+	IsForum             bool           `gorm:"not null;default:false"`              // 论坛模式：群组中的消息按话题分开
+	CreatedAt           time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt           time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt           gorm.DeletedAt `gorm:"index"`
 }
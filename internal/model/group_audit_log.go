@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// GroupAuditLog 群组管理操作的审计日志，只追加不修改
+type GroupAuditLog struct {
+	ID        string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	GroupID   string    `gorm:"type:uuid;not null;index:idx_audit_group_time"`
+	ActorID   string    `gorm:"type:uuid;not null;index"`
+	Action    string    `gorm:"type:text;not null;index"`
+	TargetID  *string   `gorm:"type:uuid;index"` // 被操作的用户ID，操作对象不是用户时为空
+	Payload   string    `gorm:"type:text"`       // 操作的详细内容，JSON 格式
+	CreatedAt time.Time `gorm:"autoCreateTime;index:idx_audit_group_time"`
+}
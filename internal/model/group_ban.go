@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// GroupBan 群组封禁记录，被封禁的用户不能申请或通过邀请码加入群组
+type GroupBan struct {
+	GroupID    string     `gorm:"type:uuid;primaryKey"`
+	UserID     string     `gorm:"type:uuid;primaryKey"`
+	OperatorID string     `gorm:"type:uuid;not null"`
+	Reason     string     `gorm:"type:text"`
+	ExpiresAt  *time.Time // 为空表示永久封禁
+	CreatedAt  time.Time  `gorm:"autoCreateTime"`
+}
@@ -1,11 +1,18 @@
 package model
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type GroupJoinRequest struct {
 	gorm.Model
-	SenderID      string `gorm:"type:uuid;not null;primaryKey"`
-	TargetGroupID string `gorm:"type:uuid;not null;primaryKey"`
-	Status        string `gorm:"type:text;not null;default:pending"` // only pending / rejected
-	Message       string `gorm:"type:text"`
+	SenderID       string     `gorm:"type:uuid;not null;primaryKey"`
+	TargetGroupID  string     `gorm:"type:uuid;not null;primaryKey"`
+	Status         string     `gorm:"type:text;not null;default:pending"` // pending / approved / rejected / expired / cancelled
+	Message        string     `gorm:"type:text"`
+	DecidedBy      *string    `gorm:"type:uuid"` // 审批人，过期和撤回的申请为空
+	DecisionReason string     `gorm:"type:text"` // 审批时填写的理由
+	DecidedAt      *time.Time // 审批、过期或撤回的时间
 }
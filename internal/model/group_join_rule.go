@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// GroupJoinRule 入群自动审批规则，符合任一规则的入群申请自动通过，不符合的进入人工审批
+type GroupJoinRule struct {
+	ID        string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	GroupID   string    `gorm:"type:uuid;not null;index"`
+	Type      string    `gorm:"type:text;not null"` // friend_of_member / organization / invite_link
+	Value     string    `gorm:"type:text"`          // organization 规则为组织名称，invite_link 规则为邀请码
+	CreatedBy string    `gorm:"type:uuid;not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
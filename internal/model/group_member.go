@@ -7,9 +7,12 @@ import (
 )
 
 type GroupMember struct {
-	GroupID   string         `gorm:"type:uuid;not null;primaryKey"`
-	UserID    string         `gorm:"type:uuid;not null;primaryKey"`
-	Role      string         `gorm:"type:text;not null"`
-	CreatedAt time.Time      `gorm:"autoCreateTime"`
-	DeletedAt gorm.DeletedAt `gorm:"index"`
+	GroupID                string         `gorm:"type:uuid;not null;primaryKey"`
+	UserID                 string         `gorm:"type:uuid;not null;primaryKey"`
+	Role                   string         `gorm:"type:text;not null"`
+	MutedUntil             *time.Time     // 禁言截止时间，为空或已过期表示未被禁言
+	AnnouncementAckVersion int            `gorm:"type:int;not null;default:0"` // 已确认的群公告版本
+	Nickname               string         `gorm:"type:text"`                   // 成员在本群中的昵称，为空时显示用户名
+	CreatedAt              time.Time      `gorm:"autoCreateTime"`
+	DeletedAt              gorm.DeletedAt `gorm:"index"`
 }
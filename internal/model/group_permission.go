@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// GroupPermission 群组权限矩阵中的一项，记录执行某项操作所需的最低角色
+// 群组没有配置的权限使用默认值
+type GroupPermission struct {
+	GroupID    string    `gorm:"type:uuid;primaryKey"`
+	Permission string    `gorm:"type:text;primaryKey"` // invite / approve_join / remove_member / pin_message / edit_info / mention_all / mute
+	MinRole    string    `gorm:"type:text;not null"`   // owner / admin / member
+	UpdatedAt  time.Time `gorm:"autoUpdateTime"`
+}
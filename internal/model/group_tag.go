@@ -0,0 +1,7 @@
+package model
+
+// GroupTag 群组标签，用于分类和搜索群组，标签统一保存为小写
+type GroupTag struct {
+	GroupID string `gorm:"type:uuid;primaryKey"`
+	Tag     string `gorm:"type:text;primaryKey;index"`
+}
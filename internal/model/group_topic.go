@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// GroupTopic 论坛模式群组中的话题，每个话题有独立的消息流
+type GroupTopic struct {
+	ID            string     `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	GroupID       string     `gorm:"type:uuid;not null;index"`
+	Name          string     `gorm:"type:text;not null"`
+	CreatorID     string     `gorm:"type:uuid;not null"`
+	IsPinned      bool       `gorm:"not null;default:false"`
+	PinnedAt      *time.Time // 置顶时间，置顶话题按该时间倒序排列
+	LastMessageAt *time.Time // 最后一条消息的时间，没有消息时为空
+	CreatedAt     time.Time  `gorm:"autoCreateTime"`
+}
+
+// GroupTopicRead 成员在话题中的已读位置，晚于该时间的消息计为未读
+type GroupTopicRead struct {
+	TopicID    string    `gorm:"type:uuid;not null;primaryKey"`
+	UserID     string    `gorm:"type:uuid;not null;primaryKey;index"`
+	LastReadAt time.Time `gorm:"not null"`
+}
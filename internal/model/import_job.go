@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// ImportJob 聊天记录批量导入任务，用于记录导入进度以支持失败后断点续传
+type ImportJob struct {
+	ID          string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Source      string    `gorm:"type:text;not null"`                 // 导入文件路径
+	Checksum    string    `gorm:"type:text;not null;uniqueIndex"`     // 导入文件的 SHA-256，用于识别同一个文件
+	Status      string    `gorm:"type:text;not null;default:running"` // running / completed / failed
+	Processed   int       `gorm:"type:int;not null;default:0"`        // 已处理的记录数，包括校验失败被跳过的记录
+	Invalid     int       `gorm:"type:int;not null;default:0"`        // 校验失败被跳过的记录数
+	Error       string    `gorm:"type:text"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+	CompletedAt *time.Time
+}
+
+// ImportMapping 外部系统ID与本系统ID的映射
+type ImportMapping struct {
+	JobID      string `gorm:"type:uuid;not null;primaryKey"`
+	Kind       string `gorm:"type:text;not null;primaryKey"` // user / group
+	ExternalID string `gorm:"type:text;not null;primaryKey"`
+	InternalID string `gorm:"type:uuid;not null"`
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// IncomingWebhook 外部系统向群组发消息的传入 Webhook
+// 每个 Webhook 对应一个集成账号（UserID），消息以该账号的名义发送
+type IncomingWebhook struct {
+	ID         string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	GroupID    string    `gorm:"type:uuid;not null;index"`
+	CreatorID  string    `gorm:"type:uuid;not null"`
+	UserID     string    `gorm:"type:uuid;not null;uniqueIndex"`
+	Name       string    `gorm:"type:text;not null"`
+	TokenHash  string    `gorm:"type:text;not null"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+	LastUsedAt *time.Time
+}
@@ -1,10 +1,30 @@
 package model
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type InvitationCode struct {
 	gorm.Model
-	UUID string `gorm:"type:uuid;not null"` // 群组的 ID 或用户的
-	Name string `gorm:"type:text;not null"` // 群组的或用户的
-	Code string `gorm:"type:text;not null;unique"`
+	UUID             string     `gorm:"type:uuid;not null"` // 群组的 ID 或用户的
+	Name             string     `gorm:"type:text;not null"` // 群组的或用户的
+	Code             string     `gorm:"type:text;not null;unique"`
+	CreatorID        *string    `gorm:"type:uuid;index"`    // 创建邀请链接的成员
+	MaxUses          int        `gorm:"not null;default:0"` // 最多可使用的次数，0 表示不限
+	UseCount         int        `gorm:"not null;default:0"` // 已使用的次数，需要审批时提交申请即计入
+	ExpiresAt        *time.Time // 为空表示永不过期
+	RequiresApproval bool       `gorm:"not null;default:false"` // 通过该链接加入时需要审批
+	RevokedAt        *time.Time // 撤销时间，撤销后链接立即失效
+}
+
+// InvitationUse 通过邀请链接加入或申请加入群组的记录
+type InvitationUse struct {
+	ID               string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	InvitationCodeID uint      `gorm:"not null;index"`
+	GroupID          string    `gorm:"type:uuid;not null"`
+	UserID           string    `gorm:"type:uuid;not null"`
+	Status           string    `gorm:"type:text;not null"` // joined / pending / rejected
+	CreatedAt        time.Time `gorm:"autoCreateTime"`
 }
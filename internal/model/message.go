@@ -11,11 +11,25 @@ const (
 	MessageTypeGroup   MessageType = "group"
 )
 
+// MessageKind 消息内容的种类
+type MessageKind string
+
+const (
+	MessageKindText   MessageKind = "text"
+	MessageKindImage  MessageKind = "image"  // Content 为图片地址
+	MessageKindFile   MessageKind = "file"   // Content 为文件地址
+	MessageKindPoll   MessageKind = "poll"   // Content 为投票问题，投票详情见 Poll
+	MessageKindCard   MessageKind = "card"   // Content 为卡片标题，卡片内容见 CardMessage
+	MessageKindSystem MessageKind = "system" // 系统通知，如命令执行结果，FromUserID 为触发通知的用户
+)
+
 type Message struct {
 	ID         string      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
 	FromUserID string      `gorm:"type:uuid;not null;index:idx_from;index:idx_private_chat"`
 	TargetID   string      `gorm:"type:uuid;not null;index:idx_target;index:idx_private_chat"` // 用户ID或群ID，根据Type来判断
 	Type       MessageType `gorm:"type:text;not null;index:idx_type"`
+	Kind       MessageKind `gorm:"type:text;not null;default:text"`
 	Content    string      `gorm:"type:text;not null"`
+	TopicID    *string     `gorm:"type:uuid;index:idx_topic"` // 论坛模式群组中消息所属的话题，为空表示不属于任何话题
 	CreatedAt  time.Time   `gorm:"autoCreateTime;index:idx_created"`
 }
@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// Poll 群聊投票，与一条 Kind 为 poll 的群消息一一对应
+type Poll struct {
+	ID             string     `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	MessageID      string     `gorm:"type:uuid;not null;uniqueIndex"`
+	GroupID        string     `gorm:"type:uuid;not null;index"`
+	CreatorID      string     `gorm:"type:uuid;not null"`
+	Question       string     `gorm:"type:text;not null"`
+	MultipleChoice bool       `gorm:"not null;default:false"`
+	Anonymous      bool       `gorm:"not null;default:false"`
+	ClosesAt       *time.Time // 自动截止时间，为空表示需手动关闭
+	ClosedAt       *time.Time // 手动关闭时间
+	CreatedAt      time.Time  `gorm:"autoCreateTime"`
+}
+
+// PollOption 投票选项
+type PollOption struct {
+	ID       string `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	PollID   string `gorm:"type:uuid;not null;index"`
+	Position int    `gorm:"type:int;not null"`
+	Text     string `gorm:"type:text;not null"`
+}
+
+// PollVote 投票记录，多选投票每个选项一条
+type PollVote struct {
+	PollID    string    `gorm:"type:uuid;not null;primaryKey"`
+	UserID    string    `gorm:"type:uuid;not null;primaryKey"`
+	OptionID  string    `gorm:"type:uuid;not null;primaryKey"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
@@ -6,10 +6,19 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	UserTypeUser        = "user"
+	UserTypeBot         = "bot"
+	UserTypeIntegration = "integration" // 传入 Webhook 和群组助手的发送者身份，不能登录
+)
+
 type User struct {
 	ID           string         `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
 	Username     string         `gorm:"type:text;not null;uniqueIndex"`
 	PasswordHash string         `gorm:"type:text;not null"`
+	Type         string         `gorm:"type:text;not null;default:user"` // user / bot / integration
+	BotOwnerID   *string        `gorm:"type:uuid;index"`                 // 机器人的创建者，仅机器人账号有值
+	Organization string         `gorm:"type:text;index"`                 // 用户所属的组织，只能由运维通过命令行设置，用于入群自动审批规则
 	CreatedAt    time.Time      `gorm:"autoCreateTime"`
 	UpdatedAt    time.Time      `gorm:"autoUpdateTime"`
 	DeletedAt    gorm.DeletedAt `gorm:"index"`
@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// Webhook 外部系统订阅聊天事件的回调地址
+type Webhook struct {
+	ID             string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	CreatorID      string    `gorm:"type:uuid;not null;index"`
+	Scope          string    `gorm:"type:text;not null;index:idx_webhook_scope"` // group / user
+	TargetID       string    `gorm:"type:uuid;not null;index:idx_webhook_scope"` // 群ID或用户ID，根据Scope来判断
+	URL            string    `gorm:"type:text;not null"`
+	Secret         string    `gorm:"type:text;not null"` // 用于 HMAC-SHA256 签名
+	Events         string    `gorm:"type:text;not null"` // 逗号分隔的订阅事件
+	Enabled        bool      `gorm:"not null;default:true"`
+	FailureCount   int       `gorm:"type:int;not null;default:0"` // 连续失败次数，成功后清零
+	DisabledReason string    `gorm:"type:text"`
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+}
+
+// WebhookDelivery 事件投递记录，同时作为待投递队列和投递日志
+type WebhookDelivery struct {
+	ID             string    `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	WebhookID      string    `gorm:"type:uuid;not null;index"`
+	Event          string    `gorm:"type:text;not null"`
+	Payload        string    `gorm:"type:text;not null"`
+	Status         string    `gorm:"type:text;not null;default:pending;index:idx_delivery_due"` // pending / succeeded / failed
+	Attempts       int       `gorm:"type:int;not null;default:0"`
+	NextAttemptAt  time.Time `gorm:"not null;index:idx_delivery_due"`
+	LastStatusCode int       `gorm:"type:int"`
+	LastError      string    `gorm:"type:text"`
+	CreatedAt      time.Time `gorm:"autoCreateTime;index"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+	DeliveredAt    *time.Time
+}
@@ -0,0 +1,102 @@
+package v1
+
+import (
+	"chat_backend/internal/assistant"
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/errors"
+	"chat_backend/internal/global"
+	"chat_backend/internal/response"
+	"chat_backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetAssistantResponders 获取可用的助手回复器名称
+func GetAssistantResponders(c echo.Context) error {
+	return response.Success(c, assistant.Names())
+}
+
+// GetGroupAssistant 获取群组助手配置（仅群主）
+func GetGroupAssistant(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	assistantService := service.NewAssistantService(database.GetDB())
+	result, err := assistantService.GetGroupAssistant(ctx, userID, groupID)
+	if err != nil {
+		return handleAssistantError(c, err, errors.ErrCodeInternalError)
+	}
+
+	return response.Success(c, result)
+}
+
+// SaveGroupAssistant 启用或更新群组助手（仅群主）
+func SaveGroupAssistant(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	var req dto.SaveGroupAssistantRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	assistantService := service.NewAssistantService(database.GetDB())
+	result, err := assistantService.SaveGroupAssistant(ctx, userID, groupID, req)
+	if err != nil {
+		if err == service.ErrUsernameAlreadyExists {
+			return response.Error(c, errors.ErrCodeUsernameAlreadyExists, err.Error())
+		}
+		return handleAssistantError(c, err, errors.ErrCodeFailedToSaveAssistant)
+	}
+
+	return response.Success(c, result)
+}
+
+// DeleteGroupAssistant 删除群组助手（仅群主）
+func DeleteGroupAssistant(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	assistantService := service.NewAssistantService(database.GetDB())
+	if err := assistantService.DeleteGroupAssistant(ctx, userID, groupID); err != nil {
+		return handleAssistantError(c, err, errors.ErrCodeInternalError)
+	}
+
+	return response.Success(c, nil)
+}
+
+// handleAssistantError 将群组助手相关的错误转换为响应
+func handleAssistantError(c echo.Context, err error, defaultCode int) error {
+	switch err.Error() {
+	case ErrorMessageAssistantNotFound:
+		return response.Error(c, errors.ErrCodeAssistantNotFound, err.Error())
+	case ErrorMessageInvalidAssistantName, ErrorMessageInvalidAssistantResponder, ErrorMessageInvalidAssistantConfig,
+		ErrorMessageInvalidAssistantContext:
+		return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+	case ErrorMessagePermissionDenied:
+		return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+	case ErrorMessageGroupNotFound:
+		return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+	default:
+		return response.Error(c, defaultCode, err.Error())
+	}
+}
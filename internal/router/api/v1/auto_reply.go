@@ -0,0 +1,144 @@
+package v1
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/errors"
+	"chat_backend/internal/global"
+	"chat_backend/internal/response"
+	"chat_backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// UpdateWelcomeMessage 设置群组欢迎语（仅群主），空字符串表示关闭
+func UpdateWelcomeMessage(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	var req dto.UpdateWelcomeMessageRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	autoReplyService := service.NewAutoReplyService(database.GetDB(), database.GetRedis())
+	if err := autoReplyService.SetWelcomeMessage(ctx, userID, groupID, req.WelcomeMessage); err != nil {
+		return handleAutoReplyError(c, err, errors.ErrCodeInternalError)
+	}
+
+	return response.Success(c, nil)
+}
+
+// GetAutoReplyList 获取群组的自动回复规则（仅群主）
+func GetAutoReplyList(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	autoReplyService := service.NewAutoReplyService(database.GetDB(), database.GetRedis())
+	rules, err := autoReplyService.ListAutoReplies(ctx, userID, groupID)
+	if err != nil {
+		return handleAutoReplyError(c, err, errors.ErrCodeInternalError)
+	}
+
+	return response.Success(c, rules)
+}
+
+// CreateAutoReply 为群组创建关键词或正则自动回复规则（仅群主）
+func CreateAutoReply(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	var req dto.CreateAutoReplyRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	autoReplyService := service.NewAutoReplyService(database.GetDB(), database.GetRedis())
+	rule, err := autoReplyService.CreateAutoReply(ctx, userID, groupID, req)
+	if err != nil {
+		return handleAutoReplyError(c, err, errors.ErrCodeFailedToCreateAutoReply)
+	}
+
+	return response.Success(c, rule)
+}
+
+// UpdateAutoReply 更新自动回复规则（仅群主）
+func UpdateAutoReply(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	ruleID := c.Param(ParamRuleID)
+	if groupID == "" || ruleID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageRuleIDRequired)
+	}
+
+	var req dto.UpdateAutoReplyRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	autoReplyService := service.NewAutoReplyService(database.GetDB(), database.GetRedis())
+	rule, err := autoReplyService.UpdateAutoReply(ctx, userID, groupID, ruleID, req)
+	if err != nil {
+		return handleAutoReplyError(c, err, errors.ErrCodeInternalError)
+	}
+
+	return response.Success(c, rule)
+}
+
+// DeleteAutoReply 删除自动回复规则（仅群主）
+func DeleteAutoReply(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	ruleID := c.Param(ParamRuleID)
+	if groupID == "" || ruleID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageRuleIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	autoReplyService := service.NewAutoReplyService(database.GetDB(), database.GetRedis())
+	if err := autoReplyService.DeleteAutoReply(ctx, userID, groupID, ruleID); err != nil {
+		return handleAutoReplyError(c, err, errors.ErrCodeInternalError)
+	}
+
+	return response.Success(c, nil)
+}
+
+// handleAutoReplyError 将欢迎语和自动回复相关的错误转换为响应
+func handleAutoReplyError(c echo.Context, err error, defaultCode int) error {
+	switch err.Error() {
+	case ErrorMessageAutoReplyNotFound:
+		return response.Error(c, errors.ErrCodeAutoReplyNotFound, err.Error())
+	case ErrorMessageInvalidAutoReplyMatchType, ErrorMessageInvalidAutoReplyPattern, ErrorMessageInvalidAutoReplyContent,
+		ErrorMessageInvalidAutoReplyCooldown, ErrorMessageInvalidWelcomeMessage:
+		return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+	case ErrorMessagePermissionDenied, ErrorMessageTooManyAutoReplies:
+		return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+	case ErrorMessageGroupNotFound:
+		return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+	default:
+		return response.Error(c, defaultCode, err.Error())
+	}
+}
@@ -0,0 +1,155 @@
+package v1
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/errors"
+	"chat_backend/internal/global"
+	"chat_backend/internal/response"
+	"chat_backend/internal/service"
+	"chat_backend/internal/websocket"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CreateBot 创建机器人，返回只显示一次的访问令牌
+func CreateBot(c echo.Context) error {
+	ctx := c.Request().Context()
+	var req dto.CreateBotRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+	req.Username = strings.TrimSpace(req.Username)
+	if req.Username == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageUsernameRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	botService := service.NewBotService(database.GetDB())
+	result, err := botService.CreateBot(ctx, userID, req.Username)
+	if err != nil {
+		switch err.Error() {
+		case service.ErrUsernameAlreadyExists.Error():
+			return response.Error(c, errors.ErrCodeUsernameAlreadyExists, err.Error())
+		case ErrorMessageTooManyBots:
+			return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+		default:
+			return response.Error(c, errors.ErrCodeFailedToCreateBot, err.Error())
+		}
+	}
+
+	return response.Success(c, result)
+}
+
+// GetBotList 获取当前用户创建的机器人列表
+func GetBotList(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	botService := service.NewBotService(database.GetDB())
+	bots, err := botService.ListBots(ctx, userID)
+	if err != nil {
+		return response.Error(c, errors.ErrCodeInternalError, err.Error())
+	}
+
+	return response.Success(c, bots)
+}
+
+// RegenerateBotToken 重新生成机器人令牌，旧令牌立即失效
+func RegenerateBotToken(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	botID := c.Param(ParamID)
+	if botID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageBotIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	botService := service.NewBotService(database.GetDB())
+	result, err := botService.RegenerateBotToken(ctx, userID, botID)
+	if err != nil {
+		if err.Error() == ErrorMessageBotNotFound {
+			return response.Error(c, errors.ErrCodeBotNotFound, err.Error())
+		}
+		return response.Error(c, errors.ErrCodeInternalError, err.Error())
+	}
+
+	return response.Success(c, result)
+}
+
+// DeleteBot 删除机器人
+func DeleteBot(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	botID := c.Param(ParamID)
+	if botID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageBotIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	botService := service.NewBotService(database.GetDB())
+	events, err := botService.DeleteBot(ctx, userID, botID)
+	if err != nil {
+		if err.Error() == ErrorMessageBotNotFound {
+			return response.Error(c, errors.ErrCodeBotNotFound, err.Error())
+		}
+		return response.Error(c, errors.ErrCodeInternalError, err.Error())
+	}
+
+	for _, event := range events {
+		websocket.NotifyGroupMemberChange(ctx, event)
+	}
+
+	return response.Success(c, nil)
+}
+
+// AddBotToGroup 将机器人加入群组，非群主添加时需要群主审批
+func AddBotToGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	botID := c.Param(ParamID)
+	groupID := c.Param(ParamGroupID)
+	if botID == "" || groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageBotIDAndGroupIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	botService := service.NewBotService(database.GetDB())
+	result, err := botService.AddBotToGroup(ctx, userID, botID, groupID)
+	if err != nil {
+		switch err.Error() {
+		case ErrorMessageBotNotFound:
+			return response.Error(c, errors.ErrCodeBotNotFound, err.Error())
+		case ErrorMessageGroupNotFound:
+			return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+		case ErrorMessageYouAreNotInThisGroup:
+			return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+		case ErrorMessageBannedFromGroup:
+			return response.Error(c, errors.ErrCodeBannedFromGroup, err.Error())
+		case ErrorMessageGroupFull:
+			return response.Error(c, errors.ErrCodeGroupFull, err.Error())
+		case ErrorMessageBotAlreadyInGroup:
+			return response.Error(c, errors.ErrCodeAlreadyInGroup, err.Error())
+		case ErrorMessagePendingRequestAlreadyExists:
+			return response.Error(c, errors.ErrCodeAlreadyRequested, err.Error())
+		case ErrorMessageCannotRequestWithinCooldown:
+			return response.Error(c, errors.ErrCodeCannotRequestWithinCooldown, err.Error())
+		default:
+			return response.Error(c, errors.ErrCodeFailedToAddBotToGroup, err.Error())
+		}
+	}
+
+	switch result.Status {
+	case service.BotGroupStatusJoined:
+		websocket.NotifyGroupMemberChange(ctx, result.MemberEvent)
+	case service.BotGroupStatusPending:
+		websocket.NotifyJoinRequestSubmitted(ctx, groupID, botID)
+	}
+
+	return response.Success(c, result)
+}
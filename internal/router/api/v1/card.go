@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/errors"
+	"chat_backend/internal/global"
+	"chat_backend/internal/model"
+	"chat_backend/internal/response"
+	"chat_backend/internal/service"
+	"chat_backend/internal/websocket"
+
+	"github.com/labstack/echo/v4"
+)
+
+// SendCard 发送交互式卡片消息
+func SendCard(c echo.Context) error {
+	ctx := c.Request().Context()
+	var req dto.SendCardRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+	if req.ChatType == "" || req.TargetID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageChatTypeAndTargetIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	recipientIDs, onlineUserIDs, err := websocket.GetMessageRecipients(ctx, model.MessageType(req.ChatType), req.TargetID, userID)
+	if err != nil {
+		return response.Error(c, errors.ErrCodeInternalError, err.Error())
+	}
+
+	cardService := service.NewCardService(database.GetDB(), database.GetRedis())
+	message, card, err := cardService.SendCard(ctx, userID, req, recipientIDs, onlineUserIDs)
+	if err != nil {
+		switch err.Error() {
+		case ErrorMessageInvalidConversationType, ErrorMessageInvalidCard, ErrorMessageUnknownCardHandler, ErrorMessageInvalidCallbackURL:
+			return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+		case ErrorMessageNotFriends, ErrorMessageYouAreNotInThisGroup:
+			return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+		case ErrorMessagePostingRestricted:
+			return response.Error(c, errors.ErrCodePostingRestricted, err.Error())
+		default:
+			return response.Error(c, errors.ErrCodeFailedToSendCard, err.Error())
+		}
+	}
+
+	websocket.DeliverMessage(message, &card.CardResponse, recipientIDs)
+
+	return response.Success(c, card)
+}
+
+// GetCard 获取卡片详情
+func GetCard(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	cardID := c.Param(ParamID)
+	if cardID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageCardIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	cardService := service.NewCardService(database.GetDB(), database.GetRedis())
+	card, err := cardService.GetCard(ctx, userID, cardID)
+	if err != nil {
+		return handleCardError(c, err, errors.ErrCodeInternalError)
+	}
+
+	return response.Success(c, card)
+}
+
+// HandleCardAction 点击卡片按钮，卡片被更新时推送给会话中的所有在线用户
+func HandleCardAction(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	cardID := c.Param(ParamID)
+	if cardID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageCardIDRequired)
+	}
+
+	var req dto.CardActionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+	if req.ActionID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageActionIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	cardService := service.NewCardService(database.GetDB(), database.GetRedis())
+	card, updated, err := cardService.HandleCardAction(ctx, userID, cardID, req.ActionID)
+	if err != nil {
+		return handleCardError(c, err, errors.ErrCodeFailedToHandleCardAction)
+	}
+
+	if updated {
+		update := *card
+		update.MyActionID = ""
+		if update.ChatType == string(model.MessageTypeGroup) {
+			websocket.BroadcastEventToGroup(ctx, update.TargetID, websocket.MessageTypeCardUpdate, &update)
+		} else {
+			websocket.SendEventToUser(update.CreatorID, websocket.MessageTypeCardUpdate, &update)
+			websocket.SendEventToUser(update.TargetID, websocket.MessageTypeCardUpdate, &update)
+		}
+	}
+
+	return response.Success(c, card)
+}
+
+// handleCardError 将卡片相关的错误转换为响应
+func handleCardError(c echo.Context, err error, defaultCode int) error {
+	switch err.Error() {
+	case ErrorMessageCardNotFound:
+		return response.Error(c, errors.ErrCodeCardNotFound, err.Error())
+	case ErrorMessageCardActionAlreadyRecorded:
+		return response.Error(c, errors.ErrCodeCardActionAlreadyRecorded, err.Error())
+	case ErrorMessageCardAlreadyResolved:
+		return response.Error(c, errors.ErrCodeCardAlreadyResolved, err.Error())
+	case ErrorMessageInvalidCardAction, ErrorMessageInvalidCard, ErrorMessageUnknownCardHandler:
+		return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+	case ErrorMessageYouAreNotInThisGroup, ErrorMessagePermissionDenied:
+		return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+	case ErrorMessageGroupNotFound:
+		return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+	default:
+		return response.Error(c, defaultCode, err.Error())
+	}
+}
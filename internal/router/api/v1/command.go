@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/errors"
+	"chat_backend/internal/global"
+	"chat_backend/internal/response"
+	"chat_backend/internal/service"
+	"chat_backend/internal/websocket"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetGroupCommandList 获取群组中可用的命令，包括内置命令和机器人注册的命令
+func GetGroupCommandList(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	commandService := service.NewCommandService(database.GetDB())
+	groupCommands, err := commandService.ListGroupCommands(ctx, userID, groupID)
+	if err != nil {
+		return handleCommandError(c, err, errors.ErrCodeInternalError)
+	}
+
+	return response.Success(c, append(websocket.BuiltinCommands(websocket.ChatTypeGroup), groupCommands...))
+}
+
+// RegisterGroupCommand 在群组中注册命令：机器人为自己注册，群主可以为传入 Webhook 注册
+func RegisterGroupCommand(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	var req dto.RegisterCommandRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+	req.Name = strings.TrimPrefix(strings.TrimSpace(req.Name), websocket.CommandPrefix)
+	if req.Name == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageCommandNameRequired)
+	}
+	if websocket.IsBuiltinCommand(req.Name) {
+		return response.Error(c, errors.ErrCodeInvalidRequest, ErrorMessageCommandNameReserved)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	commandService := service.NewCommandService(database.GetDB())
+	command, err := commandService.RegisterGroupCommand(ctx, userID, groupID, req)
+	if err != nil {
+		return handleCommandError(c, err, errors.ErrCodeFailedToRegisterCommand)
+	}
+
+	return response.Success(c, command)
+}
+
+// DeleteGroupCommand 删除群组命令（群主或注册该命令的机器人）
+func DeleteGroupCommand(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	name := c.Param(ParamName)
+	if groupID == "" || name == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageCommandNameRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	commandService := service.NewCommandService(database.GetDB())
+	if err := commandService.DeleteGroupCommand(ctx, userID, groupID, name); err != nil {
+		return handleCommandError(c, err, errors.ErrCodeInternalError)
+	}
+
+	return response.Success(c, nil)
+}
+
+// handleCommandError 将命令相关的错误转换为响应
+func handleCommandError(c echo.Context, err error, defaultCode int) error {
+	switch err.Error() {
+	case ErrorMessageCommandNotFound:
+		return response.Error(c, errors.ErrCodeCommandNotFound, err.Error())
+	case ErrorMessageIncomingWebhookNotFound:
+		return response.Error(c, errors.ErrCodeIncomingWebhookNotFound, err.Error())
+	case ErrorMessageInvalidCommandName, ErrorMessageInvalidCallbackURL, ErrorMessageCommandCallbackNeeded:
+		return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+	case ErrorMessageCommandNameTaken:
+		return response.Error(c, errors.ErrCodeCommandNameTaken, err.Error())
+	case ErrorMessagePermissionDenied, ErrorMessageYouAreNotInThisGroup, ErrorMessageTooManyCommands:
+		return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+	case ErrorMessageGroupNotFound:
+		return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+	default:
+		return response.Error(c, defaultCode, err.Error())
+	}
+}
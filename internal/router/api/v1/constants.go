@@ -10,25 +10,46 @@ const (
 	QueryParamRole         = "role"
 	QueryParamName         = "name"
 	QueryParamRefreshToken = "refresh_token"
+	QueryParamArchived     = "archived"
+	QueryParamGroupID      = "group_id"
+	QueryParamTag          = "tag"
+	QueryParamQuery        = "q"
+	QueryParamAfter        = "after"
+	QueryParamTopicID      = "topic_id"
+	QueryParamActorID      = "actor_id"
 
-	ParamID      = "id"
-	ParamGroupID = "group_id"
-	ParamUserID  = "user_id"
+	ParamID         = "id"
+	ParamGroupID    = "group_id"
+	ParamUserID     = "user_id"
+	ParamType       = "type"
+	ParamDeliveryID = "delivery_id"
+	ParamWebhookID  = "webhook_id"
+	ParamToken      = "token"
+	ParamName       = "name"
+	ParamRuleID     = "rule_id"
+	ParamCode       = "code"
+	ParamTopicID    = "topic_id"
+
+	FormFieldAvatar = "avatar"
 
 	DefaultLimit        = 20
 	DefaultHelloName    = "World"
 	DefaultFriendStatus = "normal"
 
-	ErrorMessageUsernameRequired          = "username is required"
-	ErrorMessagePasswordRequired          = "password is required"
-	ErrorMessageRefreshTokenRequired      = "refresh_token is required"
-	ErrorMessageTargetUserIDRequired      = "target_user_id is required"
-	ErrorMessageGroupIDRequired           = "group id is required"
-	ErrorMessageNameRequired              = "name is required"
-	ErrorMessageNewOwnerIDRequired        = "new_owner_id is required"
-	ErrorMessageGroupIDAndUserIDRequired  = "group_id and user_id are required"
-	ErrorMessageGroupIDAndUserIDRequired2 = "group id and user id are required"
-	ErrorMessageInviteCodeRequired        = "invite_code is required"
+	ErrorMessageUsernameRequired            = "username is required"
+	ErrorMessagePasswordRequired            = "password is required"
+	ErrorMessageRefreshTokenRequired        = "refresh_token is required"
+	ErrorMessageTargetUserIDRequired        = "target_user_id is required"
+	ErrorMessageGroupIDRequired             = "group id is required"
+	ErrorMessageNameRequired                = "name is required"
+	ErrorMessageNewOwnerIDRequired          = "new_owner_id is required"
+	ErrorMessageGroupIDAndUserIDRequired    = "group_id and user_id are required"
+	ErrorMessageGroupIDAndUserIDRequired2   = "group id and user id are required"
+	ErrorMessageInviteCodeRequired          = "invite_code is required"
+	ErrorMessageTypeAndIDRequired           = "type and id are required"
+	ErrorMessageChatTypeAndTargetIDRequired = "chat_type and target_id are required"
+	ErrorMessageExportIDRequired            = "export id is required"
+	ErrorMessageGroupIDAndTopicIDRequired   = "group id and topic id are required"
 
 	ErrorMessageCanNotSearchYourself  = "can not search yourself"
 	ErrorMessageNotFriendRelationship = "You are not in a friend relationship"
@@ -50,4 +71,113 @@ const (
 	ErrorMessageInvalidAction               = "invalid action"
 
 	ErrorMessageActionMustBeApproveOrReject = "action must be approve or reject"
+
+	ErrorMessageUserNotFound               = "user not found"
+	ErrorMessageInvalidConversationType    = "invalid conversation type"
+	ErrorMessageInvalidMutedUntil          = "invalid muted_until"
+	ErrorMessageOnlyPrivateCanBeHidden     = "only private conversations can be hidden"
+	ErrorMessageNotFriends                 = "you are not friends"
+	ErrorMessageInvalidExportFormat        = "invalid export format"
+	ErrorMessageExportNotReady             = "export is not ready"
+	ErrorMessagePollIDRequired             = "poll id is required"
+	ErrorMessagePollNotFound               = "poll not found"
+	ErrorMessagePollClosed                 = "poll is closed"
+	ErrorMessageInvalidPollQuestion        = "poll question must be 1 to 300 characters"
+	ErrorMessageInvalidPollOptions         = "a poll needs 2 to 10 distinct non-empty options"
+	ErrorMessageInvalidClosesAt            = "invalid closes_at"
+	ErrorMessageInvalidPollOption          = "invalid poll option"
+	ErrorMessageSingleChoicePoll           = "only one option can be chosen in a single choice poll"
+	ErrorMessageCardIDRequired             = "card id is required"
+	ErrorMessageActionIDRequired           = "action id is required"
+	ErrorMessageCardNotFound               = "card not found"
+	ErrorMessageInvalidCard                = "invalid card"
+	ErrorMessageUnknownCardHandler         = "unknown card handler"
+	ErrorMessageInvalidCallbackURL         = "invalid callback url"
+	ErrorMessageInvalidCardAction          = "invalid card action"
+	ErrorMessageCardActionAlreadyRecorded  = "card action already recorded"
+	ErrorMessageBotIDRequired              = "bot id is required"
+	ErrorMessageBotIDAndGroupIDRequired    = "bot id and group id are required"
+	ErrorMessageBotNotFound                = "bot not found"
+	ErrorMessageTooManyBots                = "too many bots"
+	ErrorMessageBotAlreadyInGroup          = "bot already in group"
+	ErrorMessageWebhookIDRequired          = "webhook id is required"
+	ErrorMessageWebhookNotFound            = "webhook not found"
+	ErrorMessageWebhookDeliveryNotFound    = "webhook delivery not found"
+	ErrorMessageInvalidWebhookScope        = "invalid webhook scope"
+	ErrorMessageInvalidWebhookURL          = "invalid webhook url"
+	ErrorMessageInvalidWebhookEvents       = "invalid webhook events"
+	ErrorMessageTooManyWebhooks            = "too many webhooks"
+	ErrorMessageIncomingWebhookNotFound    = "incoming webhook not found"
+	ErrorMessageInvalidIncomingWebhook     = "invalid incoming webhook"
+	ErrorMessageInvalidIncomingWebhookName = "invalid incoming webhook name"
+	ErrorMessageTooManyIncomingWebhooks    = "too many incoming webhooks"
+	ErrorMessageInvalidMessageContent      = "invalid message content"
+	ErrorMessageWebhookIDAndTokenRequired  = "webhook id and token are required"
+	ErrorMessageCommandNameRequired        = "command name is required"
+	ErrorMessageCommandNameReserved        = "command name is reserved"
+	ErrorMessageInvalidCommandName         = "invalid command name"
+	ErrorMessageCommandNameTaken           = "command name already taken"
+	ErrorMessageCommandNotFound            = "command not found"
+	ErrorMessageTooManyCommands            = "too many commands"
+	ErrorMessageCommandCallbackNeeded      = "callback url is required for incoming webhook commands"
+	ErrorMessageAutoReplyNotFound          = "auto reply not found"
+	ErrorMessageInvalidAutoReplyMatchType  = "invalid auto reply match type"
+	ErrorMessageInvalidAutoReplyPattern    = "invalid auto reply pattern"
+	ErrorMessageInvalidAutoReplyContent    = "invalid auto reply content"
+	ErrorMessageInvalidAutoReplyCooldown   = "invalid auto reply cooldown"
+	ErrorMessageTooManyAutoReplies         = "too many auto replies"
+	ErrorMessageInvalidWelcomeMessage      = "invalid welcome message"
+	ErrorMessageRuleIDRequired             = "rule id is required"
+	ErrorMessageAssistantNotFound          = "assistant not found"
+	ErrorMessageInvalidAssistantName       = "invalid assistant name"
+	ErrorMessageInvalidAssistantResponder  = "invalid assistant responder"
+	ErrorMessageInvalidAssistantConfig     = "invalid assistant config"
+	ErrorMessageInvalidAssistantContext    = "invalid assistant context size"
+	ErrorMessageInvalidRole                = "invalid role"
+	ErrorMessageInvalidPermission          = "invalid permission"
+	ErrorMessageCannotChangeOwnerRole      = "cannot change owner role"
+	ErrorMessageInvalidMuteDuration        = "invalid mute duration"
+	ErrorMessageInvalidSlowMode            = "invalid slow mode interval"
+	ErrorMessageCannotMuteYourself         = "cannot mute yourself"
+	ErrorMessageBannedFromGroup            = "banned from group"
+	ErrorMessageBanNotFound                = "ban not found"
+	ErrorMessageCannotBanYourself          = "cannot ban yourself"
+	ErrorMessageInvalidBanRequest          = "invalid ban request"
+	ErrorMessageUserIDRequired             = "user_id is required"
+	ErrorMessageInviteLinkNotFound         = "invite link not found"
+	ErrorMessageInviteCodeExpired          = "invite code expired"
+	ErrorMessageInviteCodeUsedUp           = "invite code usage limit reached"
+	ErrorMessageInviteCodeRevoked          = "invite code revoked"
+	ErrorMessageInvalidInviteLinkParams    = "invalid invite link settings"
+	ErrorMessageTooManyInviteLinks         = "too many invite links"
+	ErrorMessageCodeRequired               = "code is required"
+	ErrorMessageNameOrTagRequired          = "name or tag is required"
+	ErrorMessageInvalidGroupDescription    = "invalid group description"
+	ErrorMessageInvalidGroupTags           = "invalid group tags"
+	ErrorMessageInvalidGroupAnnouncement   = "invalid group announcement"
+	ErrorMessageInvalidGroupAvatar         = "invalid group avatar"
+	ErrorMessageGroupAvatarNotFound        = "group avatar not found"
+	ErrorMessageAnnouncementNotFound       = "announcement not found"
+	ErrorMessageAnnouncementOutdated       = "announcement version outdated"
+	ErrorMessageAvatarFileRequired         = "avatar file is required"
+	ErrorMessageInvalidGroupVisibility     = "invalid group visibility"
+	ErrorMessageInvalidJoinPolicy          = "invalid join policy"
+	ErrorMessageGroupInviteOnly            = "group is invite only"
+	ErrorMessageGroupNotOpen               = "group requires approval to join"
+	ErrorMessageInvalidMemberCursor        = "invalid member cursor"
+	ErrorMessageNotForumGroup              = "group is not a forum"
+	ErrorMessageTopicNotFound              = "topic not found"
+	ErrorMessageInvalidTopicName           = "invalid topic name"
+	ErrorMessageTooManyTopics              = "too many topics"
+	ErrorMessageInvalidNickname            = "invalid nickname"
+	ErrorMessageInvalidAuditCursor         = "invalid audit log cursor"
+	ErrorMessageInvalidDecisionReason      = "invalid decision reason"
+	ErrorMessageInvalidBulkReview          = "invalid bulk review request"
+	ErrorMessageInvalidMaxMembers          = "invalid max members"
+	ErrorMessageGroupFull                  = "group is full"
+	ErrorMessageInvalidJoinRule            = "invalid join rule"
+	ErrorMessageTooManyExportJobs          = "too many export jobs in progress"
+	ErrorMessageExportExpired              = "export has expired"
+	ErrorMessagePostingRestricted          = "posting is restricted"
+	ErrorMessageCardAlreadyResolved        = "card already resolved"
 )
@@ -0,0 +1,102 @@
+package v1
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/errors"
+	"chat_backend/internal/global"
+	"chat_backend/internal/response"
+	"chat_backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CreateExport 创建聊天记录导出任务
+func CreateExport(c echo.Context) error {
+	ctx := c.Request().Context()
+	var req dto.CreateExportRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+	if req.ChatType == "" || req.TargetID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageChatTypeAndTargetIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	exportService := service.NewExportService(database.GetDB())
+	job, err := exportService.CreateExportJob(ctx, userID, req.ChatType, req.TargetID, req.Formats)
+	if err != nil {
+		switch err.Error() {
+		case ErrorMessageInvalidExportFormat, ErrorMessageInvalidConversationType:
+			return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+		case ErrorMessageNotFriends, ErrorMessageYouAreNotInThisGroup:
+			return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+		case ErrorMessageGroupNotFound:
+			return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+		case ErrorMessageUserNotFound:
+			return response.Error(c, errors.ErrCodeUserNotFound, err.Error())
+		case ErrorMessageTooManyExportJobs:
+			return response.Error(c, errors.ErrCodeTooManyExportJobs, err.Error())
+		default:
+			return response.Error(c, errors.ErrCodeFailedToCreateExport, err.Error())
+		}
+	}
+
+	return response.Success(c, job)
+}
+
+// GetExportList 获取导出任务列表
+func GetExportList(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	exportService := service.NewExportService(database.GetDB())
+	jobs, err := exportService.ListExportJobs(ctx, userID)
+	if err != nil {
+		return response.Error(c, errors.ErrCodeInternalError, err.Error())
+	}
+
+	return response.Success(c, jobs)
+}
+
+// GetExport 获取导出任务状态
+func GetExport(c echo.Context) error {
+	ctx := c.Request().Context()
+	jobID := c.Param(ParamID)
+	if jobID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageExportIDRequired)
+	}
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	exportService := service.NewExportService(database.GetDB())
+	job, err := exportService.GetExportJob(ctx, userID, jobID)
+	if err != nil {
+		return response.Error(c, errors.ErrCodeExportJobNotFound, err.Error())
+	}
+
+	return response.Success(c, job)
+}
+
+// DownloadExport 下载导出完成的聊天记录压缩包
+func DownloadExport(c echo.Context) error {
+	ctx := c.Request().Context()
+	jobID := c.Param(ParamID)
+	if jobID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageExportIDRequired)
+	}
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	exportService := service.NewExportService(database.GetDB())
+	filePath, fileName, err := exportService.GetExportFile(ctx, userID, jobID)
+	if err != nil {
+		switch err.Error() {
+		case ErrorMessageExportNotReady, ErrorMessageExportExpired:
+			return response.Error(c, errors.ErrCodeExportNotReady, err.Error())
+		default:
+			return response.Error(c, errors.ErrCodeExportJobNotFound, err.Error())
+		}
+	}
+
+	return c.Attachment(filePath, fileName)
+}
@@ -7,6 +7,8 @@ import (
 	"chat_backend/internal/global"
 	"chat_backend/internal/response"
 	"chat_backend/internal/service"
+	"chat_backend/internal/websocket"
+	"context"
 
 	"github.com/labstack/echo/v4"
 )
@@ -25,9 +27,14 @@ func CreateGroup(c echo.Context) error {
 	userID := c.Get(global.JwtKeyUserID).(string)
 
 	groupService := service.NewGroupService(database.GetDB())
-	group, err := groupService.CreateGroup(ctx, userID, req.Name)
+	group, err := groupService.CreateGroup(ctx, userID, req)
 	if err != nil {
-		return response.Error(c, errors.ErrCodeFailedToCreateGroup, err.Error())
+		switch err.Error() {
+		case ErrorMessageInvalidGroupVisibility, ErrorMessageInvalidJoinPolicy:
+			return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+		default:
+			return response.Error(c, errors.ErrCodeFailedToCreateGroup, err.Error())
+		}
 	}
 
 	return response.Success(c, group)
@@ -38,7 +45,7 @@ func GetGroupList(c echo.Context) error {
 	ctx := c.Request().Context()
 	role := c.QueryParam(QueryParamRole)
 	if role != "" {
-		if role != service.RoleOwner && role != service.RoleMember {
+		if role != service.RoleOwner && role != service.RoleAdmin && role != service.RoleMember {
 			return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
 		}
 	}
@@ -71,6 +78,8 @@ func GetGroupDetail(c echo.Context) error {
 		return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
 	}
 
+	fillMemberOnlineStatus(groupDetail.Members)
+
 	return response.Success(c, groupDetail)
 }
 
@@ -81,25 +90,30 @@ func JoinGroup(c echo.Context) error {
 	if groupID == "" {
 		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
 	}
-	var req dto.JoinGroupRequest
-	if err := c.Bind(&req); err != nil {
-		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
-	}
 	userID := c.Get(global.JwtKeyUserID).(string)
 
 	groupService := service.NewGroupService(database.GetDB())
-	result, err := groupService.JoinGroup(ctx, userID, groupID, req.InviteCode)
+	result, err := groupService.JoinGroup(ctx, userID, groupID)
 	if err != nil {
 		switch err.Error() {
 		case ErrorMessageGroupNotFound:
 			return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+		case ErrorMessageBannedFromGroup:
+			return response.Error(c, errors.ErrCodeBannedFromGroup, err.Error())
+		case ErrorMessageGroupFull:
+			return response.Error(c, errors.ErrCodeGroupFull, err.Error())
 		case ErrorMessageAlreadyInGroup:
 			return response.Error(c, errors.ErrCodeAlreadyInGroup, err.Error())
+		case ErrorMessageGroupInviteOnly, ErrorMessageGroupNotOpen:
+			return response.Error(c, errors.ErrCodeJoinNotAllowed, err.Error())
 		default:
 			return response.Error(c, errors.ErrCodeFailedToJoinGroup, err.Error())
 		}
 	}
 
+	websocket.NotifyGroupMemberChange(ctx, result.MemberEvent)
+	websocket.SendWelcomeMessage(ctx, result.GroupID, userID)
+
 	return response.Success(c, result)
 }
 
@@ -115,7 +129,7 @@ func LeaveGroup(c echo.Context) error {
 	userID := c.Get(global.JwtKeyUserID).(string)
 
 	groupService := service.NewGroupService(database.GetDB())
-	err := groupService.LeaveGroup(ctx, userID, groupID)
+	event, err := groupService.LeaveGroup(ctx, userID, groupID)
 	if err != nil {
 		switch err.Error() {
 		case ErrorMessageGroupNotFound:
@@ -129,6 +143,8 @@ func LeaveGroup(c echo.Context) error {
 		}
 	}
 
+	websocket.NotifyGroupMemberChange(ctx, event)
+
 	return response.Success(c, nil)
 }
 
@@ -144,7 +160,7 @@ func DisbandGroup(c echo.Context) error {
 	userID := c.Get(global.JwtKeyUserID).(string)
 
 	groupService := service.NewGroupService(database.GetDB())
-	err := groupService.DisbandGroup(ctx, userID, groupID)
+	memberIDs, err := groupService.DisbandGroup(ctx, userID, groupID)
 	if err != nil {
 		switch err.Error() {
 		case ErrorMessageGroupNotFound:
@@ -156,6 +172,8 @@ func DisbandGroup(c echo.Context) error {
 		}
 	}
 
+	websocket.NotifyGroupDisbanded(ctx, groupID, userID, memberIDs)
+
 	return response.Success(c, nil)
 }
 
@@ -179,7 +197,7 @@ func TransferGroup(c echo.Context) error {
 	}
 
 	groupService := service.NewGroupService(database.GetDB())
-	err := groupService.TransferGroup(ctx, userID, groupID, req.NewOwnerID)
+	event, err := groupService.TransferGroup(ctx, userID, groupID, req.NewOwnerID)
 	if err != nil {
 		switch err.Error() {
 		case ErrorMessageGroupNotFound:
@@ -193,6 +211,8 @@ func TransferGroup(c echo.Context) error {
 		}
 	}
 
+	websocket.NotifyGroupMemberChange(ctx, event)
+
 	return response.Success(c, nil)
 }
 
@@ -208,7 +228,7 @@ func RemoveMember(c echo.Context) error {
 	userID := c.Get(global.JwtKeyUserID).(string)
 
 	groupService := service.NewGroupService(database.GetDB())
-	err := groupService.RemoveMember(ctx, userID, groupID, targetUserID)
+	event, err := groupService.RemoveMember(ctx, userID, groupID, targetUserID)
 	if err != nil {
 		switch err.Error() {
 		case ErrorMessageGroupNotFound:
@@ -226,6 +246,8 @@ func RemoveMember(c echo.Context) error {
 		}
 	}
 
+	websocket.NotifyGroupMemberChange(ctx, event)
+
 	return response.Success(c, nil)
 }
 
@@ -246,17 +268,34 @@ func JoinGroupByCode(c echo.Context) error {
 	result, err := groupService.JoinGroupByCode(ctx, userID, req.InviteCode)
 	if err != nil {
 		switch err.Error() {
-		case ErrorMessageInvalidInviteCode:
+		case ErrorMessageInvalidInviteCode, ErrorMessageInviteCodeExpired, ErrorMessageInviteCodeUsedUp, ErrorMessageInviteCodeRevoked:
 			return response.Error(c, errors.ErrCodeInvalidInviteCode, err.Error())
 		case ErrorMessageGroupNotFound:
 			return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+		case ErrorMessageBannedFromGroup:
+			return response.Error(c, errors.ErrCodeBannedFromGroup, err.Error())
+		case ErrorMessageGroupFull:
+			return response.Error(c, errors.ErrCodeGroupFull, err.Error())
 		case ErrorMessageAlreadyInGroup:
 			return response.Error(c, errors.ErrCodeAlreadyInGroup, err.Error())
+		case ErrorMessagePendingRequestAlreadyExists:
+			return response.Error(c, errors.ErrCodeAlreadyRequested, err.Error())
+		case ErrorMessageCannotRequestWithinCooldown:
+			return response.Error(c, errors.ErrCodeCannotRequestWithinCooldown, err.Error())
 		default:
 			return response.Error(c, errors.ErrCodeFailedToJoinGroup, err.Error())
 		}
 	}
 
+	// 需要审批的邀请链接只提交了入群申请，审批通过后再发送欢迎消息
+	switch result.Status {
+	case service.StatusJoined:
+		websocket.NotifyGroupMemberChange(ctx, result.MemberEvent)
+		websocket.SendWelcomeMessage(ctx, result.GroupID, userID)
+	case service.StatusPending:
+		websocket.NotifyJoinRequestSubmitted(ctx, result.GroupID, userID)
+	}
+
 	return response.Success(c, result)
 }
 
@@ -264,12 +303,13 @@ func JoinGroupByCode(c echo.Context) error {
 func SearchGroup(c echo.Context) error {
 	ctx := c.Request().Context()
 	groupName := c.QueryParam(QueryParamName)
-	if groupName == "" {
-		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageNameRequired)
+	tag := c.QueryParam(QueryParamTag)
+	if groupName == "" && tag == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageNameOrTagRequired)
 	}
 
 	groupService := service.NewGroupService(database.GetDB())
-	groups, err := groupService.SearchGroup(ctx, groupName)
+	groups, err := groupService.SearchGroup(ctx, groupName, tag)
 	if err != nil {
 		return response.Error(c, errors.ErrCodeInternalError, err.Error())
 	}
@@ -298,30 +338,51 @@ func RequestJoinGroup(c echo.Context) error {
 		switch err.Error() {
 		case ErrorMessageGroupNotFound:
 			return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+		case ErrorMessageBannedFromGroup:
+			return response.Error(c, errors.ErrCodeBannedFromGroup, err.Error())
+		case ErrorMessageGroupFull:
+			return response.Error(c, errors.ErrCodeGroupFull, err.Error())
 		case ErrorMessageAlreadyInGroup:
 			return response.Error(c, errors.ErrCodeAlreadyInGroup, err.Error())
 		case ErrorMessagePendingRequestAlreadyExists:
 			return response.Error(c, errors.ErrCodeAlreadyRequested, err.Error())
 		case ErrorMessageCannotRequestWithinCooldown:
 			return response.Error(c, errors.ErrCodeCannotRequestWithinCooldown, err.Error())
+		case ErrorMessageGroupInviteOnly:
+			return response.Error(c, errors.ErrCodeJoinNotAllowed, err.Error())
 		default:
 			return response.Error(c, errors.ErrCodeFailedToRequestJoinGroup, err.Error())
 		}
 	}
 
+	// 开放加入的群组不需要审批，申请时直接加入
+	switch result.Status {
+	case service.StatusJoined:
+		websocket.NotifyGroupMemberChange(ctx, result.MemberEvent)
+		websocket.SendWelcomeMessage(ctx, result.GroupID, userID)
+	case service.StatusPending:
+		websocket.NotifyJoinRequestSubmitted(ctx, result.GroupID, userID)
+	}
+
 	return response.Success(c, result)
 }
 
-// GetPendingJoinRequests 获取待审核的入群请求
+// GetPendingJoinRequests 获取待审核的入群请求，可以通过 group_id 只查看一个群组的请求
 func GetPendingJoinRequests(c echo.Context) error {
 	ctx := c.Request().Context()
+	groupID := c.QueryParam(QueryParamGroupID)
 
 	userID := c.Get(global.JwtKeyUserID).(string)
 
 	groupService := service.NewGroupService(database.GetDB())
-	requests, err := groupService.GetPendingJoinRequests(ctx, userID)
+	requests, err := groupService.GetPendingJoinRequests(ctx, userID, groupID)
 	if err != nil {
-		return response.Error(c, errors.ErrCodeInternalError, err.Error())
+		switch err.Error() {
+		case ErrorMessagePermissionDenied:
+			return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+		default:
+			return response.Error(c, errors.ErrCodeInternalError, err.Error())
+		}
 	}
 
 	return response.Success(c, requests)
@@ -349,7 +410,7 @@ func ApproveJoinRequest(c echo.Context) error {
 	userID := c.Get(global.JwtKeyUserID).(string)
 
 	groupService := service.NewGroupService(database.GetDB())
-	err := groupService.ApproveJoinRequest(ctx, userID, groupID, senderID, req.Action)
+	event, err := groupService.ApproveJoinRequest(ctx, userID, groupID, senderID, req.Action, req.Reason)
 	if err != nil {
 		switch err.Error() {
 		case ErrorMessageGroupNotFound:
@@ -360,12 +421,100 @@ func ApproveJoinRequest(c echo.Context) error {
 			return response.Error(c, errors.ErrCodeJoinRequestNotFound, err.Error())
 		case ErrorMessageInvalidAction:
 			return response.Error(c, errors.ErrCodeInvalidAction, err.Error())
+		case ErrorMessageBannedFromGroup:
+			return response.Error(c, errors.ErrCodeBannedFromGroup, err.Error())
+		case ErrorMessageGroupFull:
+			return response.Error(c, errors.ErrCodeGroupFull, err.Error())
 		case ErrorMessageAlreadyInGroup:
 			return response.Error(c, errors.ErrCodeAlreadyInGroup, err.Error())
+		case ErrorMessageInvalidDecisionReason:
+			return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
 		default:
 			return response.Error(c, errors.ErrCodeFailedToApproveJoinRequest, err.Error())
 		}
 	}
 
-	return response.Success(c, nil)
+	notifyJoinRequestReviewed(ctx, event)
+
+	return response.Success(c, event)
+}
+
+// BulkReviewJoinRequests 批量审批入群请求，返回每个申请的处理结果
+func BulkReviewJoinRequests(c echo.Context) error {
+	ctx := c.Request().Context()
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	var req dto.BulkReviewJoinRequestsRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	if req.Action != "approve" && req.Action != "reject" {
+		return response.Error(c, errors.ErrCodeInvalidAction, ErrorMessageActionMustBeApproveOrReject)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	results, events, err := groupService.ReviewJoinRequests(ctx, userID, groupID, req)
+	if err != nil {
+		switch err.Error() {
+		case ErrorMessageGroupNotFound:
+			return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+		case ErrorMessagePermissionDenied:
+			return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+		case ErrorMessageInvalidAction:
+			return response.Error(c, errors.ErrCodeInvalidAction, err.Error())
+		case ErrorMessageInvalidBulkReview, ErrorMessageInvalidDecisionReason:
+			return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+		default:
+			return response.Error(c, errors.ErrCodeFailedToApproveJoinRequest, err.Error())
+		}
+	}
+
+	for _, event := range events {
+		notifyJoinRequestReviewed(ctx, event)
+	}
+
+	return response.Success(c, dto.BulkReviewJoinRequestsResponse{Results: results})
+}
+
+// CancelJoinRequest 撤回自己提交的入群申请
+func CancelJoinRequest(c echo.Context) error {
+	ctx := c.Request().Context()
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	event, err := groupService.CancelJoinRequest(ctx, userID, groupID)
+	if err != nil {
+		switch err.Error() {
+		case ErrorMessageGroupNotFound:
+			return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+		case ErrorMessageJoinRequestNotFound:
+			return response.Error(c, errors.ErrCodeJoinRequestNotFound, err.Error())
+		default:
+			return response.Error(c, errors.ErrCodeInternalError, err.Error())
+		}
+	}
+
+	websocket.NotifyJoinRequestUpdated(ctx, event)
+
+	return response.Success(c, event)
+}
+
+// notifyJoinRequestReviewed 推送审批结果，审批通过时同时推送成员加入事件并发送欢迎消息
+func notifyJoinRequestReviewed(ctx context.Context, event *dto.JoinRequestEvent) {
+	if event.Status == service.StatusApproved {
+		websocket.NotifyGroupMemberChange(ctx, event.MemberEvent)
+		websocket.SendWelcomeMessage(ctx, event.GroupID, event.UserID)
+	}
+	websocket.NotifyJoinRequestUpdated(ctx, event)
 }
@@ -0,0 +1,144 @@
+package v1
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/errors"
+	"chat_backend/internal/global"
+	"chat_backend/internal/response"
+	"chat_backend/internal/service"
+	"chat_backend/internal/websocket"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetGroupDirectory 分页获取公开群组目录
+func GetGroupDirectory(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	limitStr := c.QueryParam(QueryParamLimit)
+	limit := DefaultLimit
+	if limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 {
+			limit = l
+		}
+	}
+
+	groupService := service.NewGroupService(database.GetDB())
+	result, err := groupService.GetGroupDirectory(ctx, c.QueryParam(QueryParamTag), limit, c.QueryParam(QueryParamCursor))
+	if err != nil {
+		return response.Error(c, errors.ErrCodeInternalError, err.Error())
+	}
+
+	return response.Success(c, result)
+}
+
+// GetGroupPreview 获取群组的公开信息，私有群组只对成员可见
+func GetGroupPreview(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	preview, err := groupService.GetGroupPreview(ctx, userID, groupID)
+	if err != nil {
+		return handleGroupAccessError(c, err)
+	}
+
+	return response.Success(c, preview)
+}
+
+// UpdateGroupAccess 修改群组可见性和加入方式
+func UpdateGroupAccess(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	var req dto.UpdateGroupAccessRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	event, err := groupService.UpdateGroupAccess(ctx, userID, groupID, req)
+	if err != nil {
+		return handleGroupAccessError(c, err)
+	}
+
+	websocket.BroadcastEventToGroup(ctx, groupID, websocket.MessageTypeGroupProfile, event)
+
+	return response.Success(c, event)
+}
+
+// handleGroupAccessError 将群组可见性、加入方式和自动审批规则相关的错误转换为响应
+func handleGroupAccessError(c echo.Context, err error) error {
+	switch err.Error() {
+	case ErrorMessageInvalidGroupVisibility, ErrorMessageInvalidJoinPolicy, ErrorMessageInvalidMaxMembers, ErrorMessageInvalidJoinRule:
+		return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+	case ErrorMessagePermissionDenied:
+		return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+	case ErrorMessageGroupNotFound:
+		return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+	default:
+		return response.Error(c, errors.ErrCodeInternalError, err.Error())
+	}
+}
+
+// GetGroupJoinRules 获取群组的入群自动审批规则
+func GetGroupJoinRules(c echo.Context) error {
+	ctx := c.Request().Context()
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	rules, err := groupService.GetGroupJoinRules(ctx, userID, groupID)
+	if err != nil {
+		return handleGroupAccessError(c, err)
+	}
+
+	return response.Success(c, rules)
+}
+
+// UpdateGroupJoinRules 设置群组的入群自动审批规则，覆盖已有的规则
+func UpdateGroupJoinRules(c echo.Context) error {
+	ctx := c.Request().Context()
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	var req dto.GroupJoinRulesRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	rules, err := groupService.UpdateGroupJoinRules(ctx, userID, groupID, req)
+	if err != nil {
+		switch err.Error() {
+		case ErrorMessageInviteLinkNotFound:
+			return response.Error(c, errors.ErrCodeInviteLinkNotFound, err.Error())
+		default:
+			return handleGroupAccessError(c, err)
+		}
+	}
+
+	return response.Success(c, rules)
+}
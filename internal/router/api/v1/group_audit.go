@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/errors"
+	"chat_backend/internal/global"
+	"chat_backend/internal/response"
+	"chat_backend/internal/service"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetGroupAuditLogs 分页获取群组的审计日志，支持按操作类型、操作者和被操作的用户筛选
+func GetGroupAuditLogs(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	limitStr := c.QueryParam(QueryParamLimit)
+	limit := DefaultLimit
+	if limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 {
+			limit = l
+		}
+	}
+
+	filter := dto.GroupAuditLogFilter{
+		Action:   c.QueryParam(QueryParamAction),
+		ActorID:  c.QueryParam(QueryParamActorID),
+		TargetID: c.QueryParam(QueryParamTargetUserID),
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	result, err := groupService.ListGroupAuditLogs(ctx, userID, groupID, filter, limit, c.QueryParam(QueryParamCursor))
+	if err != nil {
+		switch err.Error() {
+		case ErrorMessagePermissionDenied, ErrorMessageYouAreNotInThisGroup:
+			return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+		case ErrorMessageInvalidAuditCursor:
+			return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+		default:
+			return response.Error(c, errors.ErrCodeInternalError, err.Error())
+		}
+	}
+
+	return response.Success(c, result)
+}
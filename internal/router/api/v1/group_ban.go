@@ -0,0 +1,101 @@
+package v1
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/errors"
+	"chat_backend/internal/global"
+	"chat_backend/internal/response"
+	"chat_backend/internal/service"
+	"chat_backend/internal/websocket"
+
+	"github.com/labstack/echo/v4"
+)
+
+// BanMember 封禁用户，用户在群组中时同时将其移出
+func BanMember(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	var req dto.BanMemberRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+	if req.UserID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageUserIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	ban, err := groupService.BanMember(ctx, userID, groupID, req)
+	if err != nil {
+		return handleGroupBanError(c, err)
+	}
+
+	websocket.NotifyGroupMemberChange(ctx, ban.MemberEvent)
+
+	return response.Success(c, ban)
+}
+
+// GetGroupBanList 获取群组当前生效的封禁列表
+func GetGroupBanList(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	bans, err := groupService.ListBans(ctx, userID, groupID)
+	if err != nil {
+		return handleGroupBanError(c, err)
+	}
+
+	return response.Success(c, bans)
+}
+
+// UnbanMember 解除封禁
+func UnbanMember(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	targetUserID := c.Param(ParamUserID)
+	if groupID == "" || targetUserID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDAndUserIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	if err := groupService.UnbanMember(ctx, userID, groupID, targetUserID); err != nil {
+		return handleGroupBanError(c, err)
+	}
+
+	return response.Success(c, nil)
+}
+
+// handleGroupBanError 将群组封禁相关的错误转换为响应
+func handleGroupBanError(c echo.Context, err error) error {
+	switch err.Error() {
+	case ErrorMessageBanNotFound:
+		return response.Error(c, errors.ErrCodeBanNotFound, err.Error())
+	case ErrorMessageUserNotFound:
+		return response.Error(c, errors.ErrCodeUserNotFound, err.Error())
+	case ErrorMessageInvalidBanRequest, ErrorMessageCannotBanYourself, ErrorMessageCannotRemoveOwner:
+		return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+	case ErrorMessagePermissionDenied:
+		return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+	case ErrorMessageGroupNotFound:
+		return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+	default:
+		return response.Error(c, errors.ErrCodeInternalError, err.Error())
+	}
+}
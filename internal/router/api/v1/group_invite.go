@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/errors"
+	"chat_backend/internal/global"
+	"chat_backend/internal/response"
+	"chat_backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CreateInviteLink 创建群组邀请链接
+func CreateInviteLink(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	var req dto.CreateInviteLinkRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	invite, err := groupService.CreateInviteLink(ctx, userID, groupID, req)
+	if err != nil {
+		return handleGroupInviteError(c, err)
+	}
+
+	return response.Success(c, invite)
+}
+
+// GetInviteLinkList 获取群组的邀请链接列表
+func GetInviteLinkList(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	invites, err := groupService.ListInviteLinks(ctx, userID, groupID)
+	if err != nil {
+		return handleGroupInviteError(c, err)
+	}
+
+	return response.Success(c, invites)
+}
+
+// RevokeInviteLink 撤销邀请链接
+func RevokeInviteLink(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	code := c.Param(ParamCode)
+	if groupID == "" || code == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageCodeRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	if err := groupService.RevokeInviteLink(ctx, userID, groupID, code); err != nil {
+		return handleGroupInviteError(c, err)
+	}
+
+	return response.Success(c, nil)
+}
+
+// GetInviteLinkUses 获取通过邀请链接加入或申请加入的用户
+func GetInviteLinkUses(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	code := c.Param(ParamCode)
+	if groupID == "" || code == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageCodeRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	uses, err := groupService.ListInviteLinkUses(ctx, userID, groupID, code)
+	if err != nil {
+		return handleGroupInviteError(c, err)
+	}
+
+	return response.Success(c, uses)
+}
+
+// handleGroupInviteError 将邀请链接管理相关的错误转换为响应
+func handleGroupInviteError(c echo.Context, err error) error {
+	switch err.Error() {
+	case ErrorMessageInviteLinkNotFound:
+		return response.Error(c, errors.ErrCodeInviteLinkNotFound, err.Error())
+	case ErrorMessageInvalidInviteLinkParams:
+		return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+	case ErrorMessagePermissionDenied, ErrorMessageTooManyInviteLinks:
+		return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+	case ErrorMessageGroupNotFound:
+		return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+	default:
+		return response.Error(c, errors.ErrCodeInternalError, err.Error())
+	}
+}
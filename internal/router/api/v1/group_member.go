@@ -0,0 +1,116 @@
+package v1
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/errors"
+	"chat_backend/internal/global"
+	"chat_backend/internal/response"
+	"chat_backend/internal/service"
+	"chat_backend/internal/websocket"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetGroupMemberList 分页获取群成员，支持按角色筛选和按用户名搜索
+func GetGroupMemberList(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	role := c.QueryParam(QueryParamRole)
+	if role != "" && role != service.RoleOwner && role != service.RoleAdmin && role != service.RoleMember {
+		return response.Error(c, errors.ErrCodeInvalidRequest, ErrorMessageInvalidRole)
+	}
+
+	limitStr := c.QueryParam(QueryParamLimit)
+	limit := DefaultLimit
+	if limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 {
+			limit = l
+		}
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	memberService := service.NewGroupMemberService(database.GetDB(), database.GetRedis())
+	result, err := memberService.ListMembers(ctx, userID, groupID, role, c.QueryParam(QueryParamQuery), limit, c.QueryParam(QueryParamCursor))
+	if err != nil {
+		switch err.Error() {
+		case ErrorMessageYouAreNotInThisGroup:
+			return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+		case ErrorMessageInvalidMemberCursor:
+			return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+		default:
+			return response.Error(c, errors.ErrCodeInternalError, err.Error())
+		}
+	}
+
+	fillMemberOnlineStatus(result.Members)
+
+	return response.Success(c, result)
+}
+
+// fillMemberOnlineStatus 根据当前的 WebSocket 连接填充成员的在线状态
+func fillMemberOnlineStatus(members []dto.GroupMemberInfo) {
+	cm := websocket.GetConnectionManager()
+	for i := range members {
+		members[i].Online = cm.IsOnline(members[i].UserID)
+	}
+}
+
+// SetMyNickname 设置自己在群组中的昵称，昵称为空时清除
+func SetMyNickname(c echo.Context) error {
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	return setGroupNickname(c, groupID, c.Get(global.JwtKeyUserID).(string))
+}
+
+// SetMemberNickname 修改其他成员的群昵称（管理员及以上，只能修改角色低于自己的成员）
+func SetMemberNickname(c echo.Context) error {
+	groupID := c.Param(ParamID)
+	targetUserID := c.Param(ParamUserID)
+	if groupID == "" || targetUserID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDAndUserIDRequired)
+	}
+
+	return setGroupNickname(c, groupID, targetUserID)
+}
+
+// setGroupNickname 设置群昵称并推送给群组所有在线成员
+func setGroupNickname(c echo.Context, groupID string, targetUserID string) error {
+	ctx := c.Request().Context()
+
+	var req dto.SetGroupNicknameRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	event, err := groupService.SetMemberNickname(ctx, userID, groupID, targetUserID, req.Nickname)
+	if err != nil {
+		switch err.Error() {
+		case ErrorMessageInvalidNickname:
+			return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+		case ErrorMessageTargetUserNotInGroup:
+			return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+		case ErrorMessagePermissionDenied, ErrorMessageYouAreNotInThisGroup:
+			return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+		default:
+			return response.Error(c, errors.ErrCodeInternalError, err.Error())
+		}
+	}
+
+	websocket.BroadcastEventToGroup(ctx, groupID, websocket.MessageTypeGroupNickname, event)
+
+	return response.Success(c, event)
+}
@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/errors"
+	"chat_backend/internal/global"
+	"chat_backend/internal/response"
+	"chat_backend/internal/service"
+	"chat_backend/internal/websocket"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// MuteMember 禁言群成员一段时间
+func MuteMember(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	targetUserID := c.Param(ParamUserID)
+	if groupID == "" || targetUserID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDAndUserIDRequired)
+	}
+
+	var req dto.MuteMemberRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	moderationService := service.NewGroupModerationService(database.GetDB(), database.GetRedis())
+	event, err := moderationService.MuteMember(ctx, userID, groupID, targetUserID, time.Duration(req.DurationSeconds)*time.Second)
+	if err != nil {
+		return handleGroupModerationError(c, err)
+	}
+
+	websocket.BroadcastEventToGroup(ctx, groupID, websocket.MessageTypeGroupModeration, event)
+
+	return response.Success(c, event)
+}
+
+// UnmuteMember 解除群成员的禁言
+func UnmuteMember(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	targetUserID := c.Param(ParamUserID)
+	if groupID == "" || targetUserID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDAndUserIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	moderationService := service.NewGroupModerationService(database.GetDB(), database.GetRedis())
+	event, err := moderationService.UnmuteMember(ctx, userID, groupID, targetUserID)
+	if err != nil {
+		return handleGroupModerationError(c, err)
+	}
+
+	websocket.BroadcastEventToGroup(ctx, groupID, websocket.MessageTypeGroupModeration, event)
+
+	return response.Success(c, event)
+}
+
+// UpdateGroupModeration 开启或关闭全员禁言、设置慢速模式
+func UpdateGroupModeration(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	var req dto.UpdateGroupModerationRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	moderationService := service.NewGroupModerationService(database.GetDB(), database.GetRedis())
+	event, err := moderationService.UpdateGroupModeration(ctx, userID, groupID, req)
+	if err != nil {
+		return handleGroupModerationError(c, err)
+	}
+
+	websocket.BroadcastEventToGroup(ctx, groupID, websocket.MessageTypeGroupModeration, event)
+
+	return response.Success(c, event)
+}
+
+// handleGroupModerationError 将禁言和慢速模式相关的错误转换为响应
+func handleGroupModerationError(c echo.Context, err error) error {
+	switch err.Error() {
+	case ErrorMessageInvalidMuteDuration, ErrorMessageInvalidSlowMode, ErrorMessageCannotMuteYourself, ErrorMessageTargetUserNotInGroup:
+		return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+	case ErrorMessagePermissionDenied:
+		return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+	case ErrorMessageGroupNotFound:
+		return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+	default:
+		return response.Error(c, errors.ErrCodeInternalError, err.Error())
+	}
+}
@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/errors"
+	"chat_backend/internal/global"
+	"chat_backend/internal/response"
+	"chat_backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GetGroupPermissions 获取群组权限矩阵以及当前用户拥有的权限
+func GetGroupPermissions(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	result, err := groupService.GetGroupPermissions(ctx, userID, groupID)
+	if err != nil {
+		return handleGroupPermissionError(c, err)
+	}
+
+	return response.Success(c, result)
+}
+
+// UpdateGroupPermissions 修改群组权限矩阵（仅群主）
+func UpdateGroupPermissions(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	var req dto.UpdateGroupPermissionsRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	result, err := groupService.UpdateGroupPermissions(ctx, userID, groupID, req.Permissions)
+	if err != nil {
+		return handleGroupPermissionError(c, err)
+	}
+
+	return response.Success(c, result)
+}
+
+// UpdateMemberRole 设置群成员为管理员或普通成员（仅群主）
+func UpdateMemberRole(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	targetUserID := c.Param(ParamUserID)
+	if groupID == "" || targetUserID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDAndUserIDRequired)
+	}
+
+	var req dto.UpdateMemberRoleRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	if err := groupService.UpdateMemberRole(ctx, userID, groupID, targetUserID, req.Role); err != nil {
+		return handleGroupPermissionError(c, err)
+	}
+
+	return response.Success(c, nil)
+}
+
+// handleGroupPermissionError 将群组角色和权限相关的错误转换为响应
+func handleGroupPermissionError(c echo.Context, err error) error {
+	switch err.Error() {
+	case ErrorMessageInvalidRole, ErrorMessageInvalidPermission, ErrorMessageTargetUserNotInGroup, ErrorMessageCannotChangeOwnerRole:
+		return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+	case ErrorMessagePermissionDenied, ErrorMessageYouAreNotInThisGroup:
+		return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+	case ErrorMessageGroupNotFound:
+		return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+	default:
+		return response.Error(c, errors.ErrCodeInternalError, err.Error())
+	}
+}
@@ -0,0 +1,202 @@
+package v1
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/errors"
+	"chat_backend/internal/global"
+	"chat_backend/internal/response"
+	"chat_backend/internal/service"
+	"chat_backend/internal/websocket"
+
+	"github.com/labstack/echo/v4"
+)
+
+// UpdateGroupProfile 修改群组简介和标签
+func UpdateGroupProfile(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	var req dto.UpdateGroupProfileRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	event, err := groupService.UpdateGroupProfile(ctx, userID, groupID, req)
+	if err != nil {
+		return handleGroupProfileError(c, err)
+	}
+
+	websocket.BroadcastEventToGroup(ctx, groupID, websocket.MessageTypeGroupProfile, event)
+
+	return response.Success(c, event)
+}
+
+// UploadGroupAvatar 上传群头像，文件放在 multipart 表单的 avatar 字段中
+func UploadGroupAvatar(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	fileHeader, err := c.FormFile(FormFieldAvatar)
+	if err != nil {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageAvatarFileRequired)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+	defer file.Close()
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	event, err := groupService.SetGroupAvatar(ctx, userID, groupID, file)
+	if err != nil {
+		return handleGroupProfileError(c, err)
+	}
+
+	websocket.BroadcastEventToGroup(ctx, groupID, websocket.MessageTypeGroupProfile, event)
+
+	return response.Success(c, event)
+}
+
+// DeleteGroupAvatar 删除群头像，恢复为默认头像
+func DeleteGroupAvatar(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	event, err := groupService.RemoveGroupAvatar(ctx, userID, groupID)
+	if err != nil {
+		return handleGroupProfileError(c, err)
+	}
+
+	websocket.BroadcastEventToGroup(ctx, groupID, websocket.MessageTypeGroupProfile, event)
+
+	return response.Success(c, event)
+}
+
+// GetGroupAvatar 获取群组上传的头像图片，头像地址中包含版本号，可以长期缓存
+func GetGroupAvatar(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	groupService := service.NewGroupService(database.GetDB())
+	filePath, err := groupService.GetGroupAvatarFile(ctx, groupID)
+	if err != nil {
+		return response.Error(c, errors.ErrCodeGroupAvatarNotFound, err.Error())
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "public, max-age=31536000")
+	return c.File(filePath)
+}
+
+// UpdateGroupAnnouncement 修改群公告，成员需要重新确认
+func UpdateGroupAnnouncement(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	var req dto.UpdateGroupAnnouncementRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	event, err := groupService.UpdateGroupAnnouncement(ctx, userID, groupID, req.Content)
+	if err != nil {
+		return handleGroupProfileError(c, err)
+	}
+
+	websocket.BroadcastEventToGroup(ctx, groupID, websocket.MessageTypeGroupProfile, event)
+
+	return response.Success(c, event)
+}
+
+// GetGroupAnnouncement 获取群公告以及自己的确认状态
+func GetGroupAnnouncement(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	announcement, err := groupService.GetGroupAnnouncement(ctx, userID, groupID)
+	if err != nil {
+		return handleGroupProfileError(c, err)
+	}
+
+	return response.Success(c, announcement)
+}
+
+// AckGroupAnnouncement 确认已阅读当前版本的群公告
+func AckGroupAnnouncement(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	var req dto.AckGroupAnnouncementRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	announcement, err := groupService.AckGroupAnnouncement(ctx, userID, groupID, req.Version)
+	if err != nil {
+		return handleGroupProfileError(c, err)
+	}
+
+	return response.Success(c, announcement)
+}
+
+// handleGroupProfileError 将群组资料相关的错误转换为响应
+func handleGroupProfileError(c echo.Context, err error) error {
+	switch err.Error() {
+	case ErrorMessageInvalidGroupDescription, ErrorMessageInvalidGroupTags, ErrorMessageInvalidGroupAnnouncement,
+		ErrorMessageInvalidGroupAvatar, ErrorMessageAnnouncementOutdated:
+		return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+	case ErrorMessageAnnouncementNotFound:
+		return response.Error(c, errors.ErrCodeAnnouncementNotFound, err.Error())
+	case ErrorMessagePermissionDenied, ErrorMessageYouAreNotInThisGroup:
+		return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+	case ErrorMessageGroupNotFound:
+		return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+	default:
+		return response.Error(c, errors.ErrCodeInternalError, err.Error())
+	}
+}
@@ -0,0 +1,151 @@
+package v1
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/errors"
+	"chat_backend/internal/global"
+	"chat_backend/internal/response"
+	"chat_backend/internal/service"
+	"chat_backend/internal/websocket"
+
+	"github.com/labstack/echo/v4"
+)
+
+// UpdateGroupForum 开启或关闭群组的论坛模式
+func UpdateGroupForum(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	var req dto.UpdateGroupForumRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	event, err := groupService.UpdateGroupForum(ctx, userID, groupID, req.Enabled)
+	if err != nil {
+		return handleGroupTopicError(c, err)
+	}
+
+	websocket.BroadcastEventToGroup(ctx, groupID, websocket.MessageTypeGroupProfile, event)
+
+	return response.Success(c, event)
+}
+
+// GetGroupTopicList 获取论坛话题列表及自己在每个话题中的未读消息数
+func GetGroupTopicList(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	topics, err := groupService.ListGroupTopics(ctx, userID, groupID)
+	if err != nil {
+		return handleGroupTopicError(c, err)
+	}
+
+	return response.Success(c, topics)
+}
+
+// CreateGroupTopic 在论坛模式的群组中创建话题
+func CreateGroupTopic(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	var req dto.CreateGroupTopicRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	event, err := groupService.CreateGroupTopic(ctx, userID, groupID, req.Name)
+	if err != nil {
+		return handleGroupTopicError(c, err)
+	}
+
+	websocket.BroadcastEventToGroup(ctx, groupID, websocket.MessageTypeGroupTopic, event)
+
+	return response.Success(c, event.Topic)
+}
+
+// PinGroupTopic 置顶或取消置顶话题
+func PinGroupTopic(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	topicID := c.Param(ParamTopicID)
+	if groupID == "" || topicID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDAndTopicIDRequired)
+	}
+
+	var req dto.PinGroupTopicRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	event, err := groupService.PinGroupTopic(ctx, userID, groupID, topicID, req.Pinned)
+	if err != nil {
+		return handleGroupTopicError(c, err)
+	}
+
+	websocket.BroadcastEventToGroup(ctx, groupID, websocket.MessageTypeGroupTopic, event)
+
+	return response.Success(c, event.Topic)
+}
+
+// MarkGroupTopicRead 将话题中的消息标记为已读
+func MarkGroupTopicRead(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	topicID := c.Param(ParamTopicID)
+	if groupID == "" || topicID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDAndTopicIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	groupService := service.NewGroupService(database.GetDB())
+	if err := groupService.MarkGroupTopicRead(ctx, userID, groupID, topicID); err != nil {
+		return handleGroupTopicError(c, err)
+	}
+
+	return response.Success(c, nil)
+}
+
+// handleGroupTopicError 将论坛话题相关的错误转换为响应
+func handleGroupTopicError(c echo.Context, err error) error {
+	switch err.Error() {
+	case ErrorMessageInvalidTopicName, ErrorMessageNotForumGroup, ErrorMessageTooManyTopics:
+		return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+	case ErrorMessageTopicNotFound:
+		return response.Error(c, errors.ErrCodeTopicNotFound, err.Error())
+	case ErrorMessagePermissionDenied, ErrorMessageYouAreNotInThisGroup:
+		return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+	case ErrorMessageGroupNotFound:
+		return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+	default:
+		return response.Error(c, errors.ErrCodeInternalError, err.Error())
+	}
+}
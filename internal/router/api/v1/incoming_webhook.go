@@ -0,0 +1,162 @@
+package v1
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/errors"
+	"chat_backend/internal/global"
+	"chat_backend/internal/model"
+	"chat_backend/internal/response"
+	"chat_backend/internal/service"
+	"chat_backend/internal/websocket"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CreateIncomingWebhook 群主为群组创建传入 Webhook，返回只显示一次的地址
+func CreateIncomingWebhook(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	var req dto.CreateIncomingWebhookRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	incomingWebhookService := service.NewIncomingWebhookService(database.GetDB())
+	result, err := incomingWebhookService.CreateIncomingWebhook(ctx, userID, groupID, req.Name)
+	if err != nil {
+		if err == service.ErrUsernameAlreadyExists {
+			return response.Error(c, errors.ErrCodeUsernameAlreadyExists, err.Error())
+		}
+		return handleIncomingWebhookError(c, err, errors.ErrCodeFailedToCreateIncomingHook)
+	}
+
+	return response.Success(c, result)
+}
+
+// GetIncomingWebhookList 获取群组的传入 Webhook 列表
+func GetIncomingWebhookList(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	if groupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	incomingWebhookService := service.NewIncomingWebhookService(database.GetDB())
+	webhooks, err := incomingWebhookService.ListIncomingWebhooks(ctx, userID, groupID)
+	if err != nil {
+		return handleIncomingWebhookError(c, err, errors.ErrCodeInternalError)
+	}
+
+	return response.Success(c, webhooks)
+}
+
+// RegenerateIncomingWebhookToken 重新生成传入 Webhook 的地址，旧地址立即失效
+func RegenerateIncomingWebhookToken(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	webhookID := c.Param(ParamWebhookID)
+	if groupID == "" || webhookID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageWebhookIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	incomingWebhookService := service.NewIncomingWebhookService(database.GetDB())
+	result, err := incomingWebhookService.RegenerateIncomingWebhookToken(ctx, userID, groupID, webhookID)
+	if err != nil {
+		return handleIncomingWebhookError(c, err, errors.ErrCodeInternalError)
+	}
+
+	return response.Success(c, result)
+}
+
+// DeleteIncomingWebhook 删除传入 Webhook
+func DeleteIncomingWebhook(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	groupID := c.Param(ParamID)
+	webhookID := c.Param(ParamWebhookID)
+	if groupID == "" || webhookID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageWebhookIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	incomingWebhookService := service.NewIncomingWebhookService(database.GetDB())
+	if err := incomingWebhookService.DeleteIncomingWebhook(ctx, userID, groupID, webhookID); err != nil {
+		return handleIncomingWebhookError(c, err, errors.ErrCodeInternalError)
+	}
+
+	return response.Success(c, nil)
+}
+
+// PostIncomingWebhook 外部系统通过传入 Webhook 地址向群组发送消息，地址中的令牌即为认证凭据
+func PostIncomingWebhook(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	webhookID := c.Param(ParamID)
+	token := c.Param(ParamToken)
+	if webhookID == "" || token == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageWebhookIDAndTokenRequired)
+	}
+
+	var req dto.IncomingWebhookMessageRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	incomingWebhookService := service.NewIncomingWebhookService(database.GetDB())
+	webhook, err := incomingWebhookService.AuthenticateIncomingWebhook(ctx, webhookID, token)
+	if err != nil {
+		return response.Error(c, errors.ErrCodeInvalidIncomingWebhook, err.Error())
+	}
+
+	recipientIDs, onlineUserIDs, err := websocket.GetMessageRecipients(ctx, model.MessageTypeGroup, webhook.GroupID, webhook.UserID)
+	if err != nil {
+		return response.Error(c, errors.ErrCodeInternalError, err.Error())
+	}
+
+	message, err := incomingWebhookService.PostMessage(ctx, webhook, req.Text, recipientIDs, onlineUserIDs)
+	if err != nil {
+		if err.Error() == ErrorMessageInvalidMessageContent {
+			return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+		}
+		return response.Error(c, errors.ErrCodeFailedToSendMessage, err.Error())
+	}
+
+	// 与群成员发送的消息一样广播给群组内的在线成员
+	websocket.DeliverMessage(message, nil, recipientIDs)
+
+	return response.Success(c, dto.SendMessageResponse{
+		MessageID: message.ID,
+		Timestamp: message.CreatedAt.UnixMilli(),
+	})
+}
+
+// handleIncomingWebhookError 将传入 Webhook 相关的错误转换为响应
+func handleIncomingWebhookError(c echo.Context, err error, defaultCode int) error {
+	switch err.Error() {
+	case ErrorMessageIncomingWebhookNotFound:
+		return response.Error(c, errors.ErrCodeIncomingWebhookNotFound, err.Error())
+	case ErrorMessageInvalidIncomingWebhookName:
+		return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+	case ErrorMessagePermissionDenied, ErrorMessageTooManyIncomingWebhooks:
+		return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+	case ErrorMessageGroupNotFound:
+		return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+	default:
+		return response.Error(c, defaultCode, err.Error())
+	}
+}
@@ -2,10 +2,12 @@ package v1
 
 import (
 	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
 	"chat_backend/internal/errors"
 	"chat_backend/internal/global"
 	"chat_backend/internal/response"
 	"chat_backend/internal/service"
+	"chat_backend/internal/websocket"
 
 	"strconv"
 
@@ -23,11 +25,11 @@ func GetPrivateMessages(c echo.Context) error {
 	userID := c.Get(global.JwtKeyUserID).(string)
 
 	userService := service.NewUserService(database.GetDB())
-	isFriend, err := userService.IsFriend(ctx, userID, targetUserID)
+	canChat, err := userService.CanChatPrivately(ctx, userID, targetUserID)
 	if err != nil {
 		return response.Error(c, errors.ErrCodeInternalError, err.Error())
 	}
-	if !isFriend {
+	if !canChat {
 		return response.Error(c, errors.ErrCodePermissionDenied, ErrorMessageNotFriendRelationship)
 	}
 
@@ -50,14 +52,65 @@ func GetPrivateMessages(c echo.Context) error {
 	return response.Success(c, result)
 }
 
+// SendMessage 通过 HTTP 接口发送消息，普通用户和机器人均可使用
+func SendMessage(c echo.Context) error {
+	ctx := c.Request().Context()
+	var req dto.SendMessageRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+	if req.ChatType == "" || req.TargetID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageChatTypeAndTargetIDRequired)
+	}
+	if req.Kind == "" {
+		req.Kind = string(websocket.MessageTypeText)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	sentMsg, err := websocket.SendChatMessage(ctx, userID, websocket.WSMessage{
+		Type:     websocket.MessageType(req.Kind),
+		ChatType: websocket.ChatType(req.ChatType),
+		To:       req.TargetID,
+		Content:  req.Content,
+		TopicID:  req.TopicID,
+	})
+	if err != nil {
+		if websocket.IsPostingRestricted(err) {
+			return response.Error(c, errors.ErrCodePostingRestricted, err.Error())
+		}
+		switch err {
+		case websocket.ErrNotFriends, websocket.ErrNotGroupMember, websocket.ErrMentionAllNotAllowed:
+			return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+		case websocket.ErrSendMessageFailed, websocket.ErrCheckFriendFailed, websocket.ErrCheckMemberFailed, websocket.ErrGetGroupMembersFailed:
+			return response.Error(c, errors.ErrCodeFailedToSendMessage, err.Error())
+		case websocket.ErrTopicNotFound:
+			return response.Error(c, errors.ErrCodeTopicNotFound, err.Error())
+		default:
+			return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+		}
+	}
+
+	result := &dto.SendMessageResponse{
+		MessageID: sentMsg.MessageID,
+		Timestamp: sentMsg.Timestamp,
+	}
+	if sentMsg.Type == websocket.MessageTypeCommandResponse {
+		result.CommandResponse = sentMsg.Content
+	}
+
+	return response.Success(c, result)
+}
+
 // GetConversationList 获取会话列表
 func GetConversationList(c echo.Context) error {
 	ctx := c.Request().Context()
 
 	userID := c.Get(global.JwtKeyUserID).(string)
+	archived := c.QueryParam(QueryParamArchived) == "true"
 
 	messageService := service.NewMessageService(database.GetDB())
-	result, err := messageService.GetConversationList(ctx, userID)
+	result, err := messageService.GetConversationList(ctx, userID, archived)
 	if err != nil {
 		return response.Error(c, errors.ErrCodeInternalError, err.Error())
 	}
@@ -65,6 +118,44 @@ func GetConversationList(c echo.Context) error {
 	return response.Success(c, result)
 }
 
+// UpdateConversationSetting 更新会话设置（置顶、归档、免打扰、隐藏），并同步到用户的其他设备
+func UpdateConversationSetting(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	chatType := c.Param(ParamType)
+	targetID := c.Param(ParamID)
+	if chatType == "" || targetID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageTypeAndIDRequired)
+	}
+
+	var req dto.UpdateConversationSettingRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	conversationService := service.NewConversationService(database.GetDB())
+	setting, err := conversationService.UpdateConversationSetting(ctx, userID, chatType, targetID, req)
+	if err != nil {
+		switch err.Error() {
+		case ErrorMessageInvalidConversationType, ErrorMessageInvalidMutedUntil, ErrorMessageOnlyPrivateCanBeHidden:
+			return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+		case ErrorMessageUserNotFound:
+			return response.Error(c, errors.ErrCodeUserNotFound, err.Error())
+		case ErrorMessageYouAreNotInThisGroup:
+			return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+		default:
+			return response.Error(c, errors.ErrCodeFailedToUpdateConversation, err.Error())
+		}
+	}
+
+	// 同步到该用户所有在线设备
+	websocket.SendEventToUser(userID, websocket.MessageTypeConversationSync, setting)
+
+	return response.Success(c, setting)
+}
+
 // GetGroupMessages 获取群聊消息记录
 func GetGroupMessages(c echo.Context) error {
 	ctx := c.Request().Context()
@@ -75,8 +166,8 @@ func GetGroupMessages(c echo.Context) error {
 	}
 
 	userID := c.Get(global.JwtKeyUserID).(string)
-	groupService := service.NewGroupService(database.GetDB())
-	isGroupMember, err := groupService.IsGroupMember(ctx, groupID, userID)
+	memberService := service.NewGroupMemberService(database.GetDB(), database.GetRedis())
+	isGroupMember, err := memberService.IsMember(ctx, groupID, userID)
 	if err != nil {
 		return response.Error(c, errors.ErrCodeInternalError, err.Error())
 	}
@@ -93,9 +184,11 @@ func GetGroupMessages(c echo.Context) error {
 	}
 
 	cursor := c.QueryParam(QueryParamCursor)
+	after := c.QueryParam(QueryParamAfter)
+	topicID := c.QueryParam(QueryParamTopicID)
 
 	messageService := service.NewMessageService(database.GetDB())
-	result, err := messageService.GetGroupMessages(ctx, groupID, limit, cursor)
+	result, err := messageService.GetGroupMessages(ctx, userID, groupID, topicID, limit, cursor, after)
 	if err != nil {
 		return response.Error(c, errors.ErrCodeInternalError, err.Error())
 	}
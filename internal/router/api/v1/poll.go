@@ -0,0 +1,149 @@
+package v1
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/errors"
+	"chat_backend/internal/global"
+	"chat_backend/internal/model"
+	"chat_backend/internal/response"
+	"chat_backend/internal/service"
+	"chat_backend/internal/websocket"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CreatePoll 在群聊中发起投票
+func CreatePoll(c echo.Context) error {
+	ctx := c.Request().Context()
+	var req dto.CreatePollRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+	if req.GroupID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	recipientIDs, onlineUserIDs, err := websocket.GetMessageRecipients(ctx, model.MessageTypeGroup, req.GroupID, userID)
+	if err != nil {
+		return response.Error(c, errors.ErrCodeInternalError, err.Error())
+	}
+
+	pollService := service.NewPollService(database.GetDB(), database.GetRedis())
+	message, poll, err := pollService.CreatePoll(ctx, userID, req, recipientIDs, onlineUserIDs)
+	if err != nil {
+		switch err.Error() {
+		case ErrorMessageInvalidPollQuestion, ErrorMessageInvalidPollOptions, ErrorMessageInvalidClosesAt:
+			return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+		case ErrorMessageYouAreNotInThisGroup:
+			return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+		case ErrorMessagePostingRestricted:
+			return response.Error(c, errors.ErrCodePostingRestricted, err.Error())
+		default:
+			return response.Error(c, errors.ErrCodeFailedToCreatePoll, err.Error())
+		}
+	}
+
+	// 推送给群组内在线成员，实时更新事件中不包含个人的选择
+	broadcastPoll := *poll
+	broadcastPoll.MyOptionIDs = nil
+	websocket.DeliverMessage(message, &broadcastPoll, recipientIDs)
+
+	return response.Success(c, poll)
+}
+
+// GetPoll 获取投票详情
+func GetPoll(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	pollID := c.Param(ParamID)
+	if pollID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessagePollIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	pollService := service.NewPollService(database.GetDB(), database.GetRedis())
+	poll, err := pollService.GetPoll(ctx, userID, pollID)
+	if err != nil {
+		return handlePollError(c, err, errors.ErrCodeInternalError)
+	}
+
+	return response.Success(c, poll)
+}
+
+// VotePoll 投票，覆盖之前的选择，并向群组成员推送最新结果
+func VotePoll(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	pollID := c.Param(ParamID)
+	if pollID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessagePollIDRequired)
+	}
+
+	var req dto.VotePollRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	pollService := service.NewPollService(database.GetDB(), database.GetRedis())
+	poll, err := pollService.Vote(ctx, userID, pollID, req.OptionIDs)
+	if err != nil {
+		return handlePollError(c, err, errors.ErrCodeFailedToVote)
+	}
+
+	broadcastPollUpdate(c, poll)
+
+	return response.Success(c, poll)
+}
+
+// ClosePoll 关闭投票（发起人或拥有管理投票权限的成员）
+func ClosePoll(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	pollID := c.Param(ParamID)
+	if pollID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessagePollIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	pollService := service.NewPollService(database.GetDB(), database.GetRedis())
+	poll, err := pollService.ClosePoll(ctx, userID, pollID)
+	if err != nil {
+		return handlePollError(c, err, errors.ErrCodeInternalError)
+	}
+
+	broadcastPollUpdate(c, poll)
+
+	return response.Success(c, poll)
+}
+
+// broadcastPollUpdate 向群组在线成员推送投票结果更新
+func broadcastPollUpdate(c echo.Context, poll *dto.PollResponse) {
+	update := *poll
+	update.MyOptionIDs = nil
+	websocket.BroadcastEventToGroup(c.Request().Context(), poll.GroupID, websocket.MessageTypePollUpdate, &update)
+}
+
+// handlePollError 将投票相关的错误转换为响应
+func handlePollError(c echo.Context, err error, defaultCode int) error {
+	switch err.Error() {
+	case ErrorMessagePollNotFound:
+		return response.Error(c, errors.ErrCodePollNotFound, err.Error())
+	case ErrorMessagePollClosed:
+		return response.Error(c, errors.ErrCodePollClosed, err.Error())
+	case ErrorMessageInvalidPollOption, ErrorMessageSingleChoicePoll:
+		return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+	case ErrorMessageYouAreNotInThisGroup, ErrorMessagePermissionDenied:
+		return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+	case ErrorMessageGroupNotFound:
+		return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+	default:
+		return response.Error(c, defaultCode, err.Error())
+	}
+}
@@ -0,0 +1,179 @@
+package v1
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/errors"
+	"chat_backend/internal/global"
+	"chat_backend/internal/response"
+	"chat_backend/internal/service"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CreateWebhook 注册 Webhook，返回只显示一次的签名密钥
+func CreateWebhook(c echo.Context) error {
+	ctx := c.Request().Context()
+	var req dto.CreateWebhookRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+	if req.Scope == service.WebhookScopeGroup && req.TargetID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageGroupIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	webhookService := service.NewWebhookService(database.GetDB())
+	result, err := webhookService.CreateWebhook(ctx, userID, req)
+	if err != nil {
+		return handleWebhookError(c, err, errors.ErrCodeFailedToCreateWebhook)
+	}
+
+	return response.Success(c, result)
+}
+
+// GetWebhookList 获取 Webhook 列表：指定 group_id 时返回群组 Webhook（仅群主），否则返回自己的用户 Webhook
+func GetWebhookList(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	webhookService := service.NewWebhookService(database.GetDB())
+	webhooks, err := webhookService.ListWebhooks(ctx, userID, c.QueryParam(QueryParamGroupID))
+	if err != nil {
+		return handleWebhookError(c, err, errors.ErrCodeInternalError)
+	}
+
+	return response.Success(c, webhooks)
+}
+
+// UpdateWebhook 更新 Webhook 地址、订阅事件或启用状态
+func UpdateWebhook(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	webhookID := c.Param(ParamID)
+	if webhookID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageWebhookIDRequired)
+	}
+
+	var req dto.UpdateWebhookRequest
+	if err := c.Bind(&req); err != nil {
+		return response.Error(c, errors.ErrCodeInvalidRequest, errors.GetMessage(errors.ErrCodeInvalidRequest))
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	webhookService := service.NewWebhookService(database.GetDB())
+	webhook, err := webhookService.UpdateWebhook(ctx, userID, webhookID, req)
+	if err != nil {
+		return handleWebhookError(c, err, errors.ErrCodeInternalError)
+	}
+
+	return response.Success(c, webhook)
+}
+
+// RotateWebhookSecret 重新生成 Webhook 签名密钥
+func RotateWebhookSecret(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	webhookID := c.Param(ParamID)
+	if webhookID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageWebhookIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	webhookService := service.NewWebhookService(database.GetDB())
+	result, err := webhookService.RotateWebhookSecret(ctx, userID, webhookID)
+	if err != nil {
+		return handleWebhookError(c, err, errors.ErrCodeInternalError)
+	}
+
+	return response.Success(c, result)
+}
+
+// DeleteWebhook 删除 Webhook
+func DeleteWebhook(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	webhookID := c.Param(ParamID)
+	if webhookID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageWebhookIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	webhookService := service.NewWebhookService(database.GetDB())
+	if err := webhookService.DeleteWebhook(ctx, userID, webhookID); err != nil {
+		return handleWebhookError(c, err, errors.ErrCodeInternalError)
+	}
+
+	return response.Success(c, nil)
+}
+
+// GetWebhookDeliveries 获取 Webhook 的投递日志
+func GetWebhookDeliveries(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	webhookID := c.Param(ParamID)
+	if webhookID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageWebhookIDRequired)
+	}
+
+	limitStr := c.QueryParam(QueryParamLimit)
+	limit := DefaultLimit
+	if limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 {
+			limit = l
+		}
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	webhookService := service.NewWebhookService(database.GetDB())
+	deliveries, err := webhookService.ListDeliveries(ctx, userID, webhookID, c.QueryParam(QueryParamStatus), limit)
+	if err != nil {
+		return handleWebhookError(c, err, errors.ErrCodeInternalError)
+	}
+
+	return response.Success(c, deliveries)
+}
+
+// RetryWebhookDelivery 立即重新投递一条记录
+func RetryWebhookDelivery(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	webhookID := c.Param(ParamID)
+	if webhookID == "" {
+		return response.Error(c, errors.ErrCodeRequiredFieldMissing, ErrorMessageWebhookIDRequired)
+	}
+
+	userID := c.Get(global.JwtKeyUserID).(string)
+
+	webhookService := service.NewWebhookService(database.GetDB())
+	delivery, err := webhookService.RetryDelivery(ctx, userID, webhookID, c.Param(ParamDeliveryID))
+	if err != nil {
+		return handleWebhookError(c, err, errors.ErrCodeInternalError)
+	}
+
+	return response.Success(c, delivery)
+}
+
+// handleWebhookError 将 Webhook 相关的错误转换为响应
+func handleWebhookError(c echo.Context, err error, defaultCode int) error {
+	switch err.Error() {
+	case ErrorMessageWebhookNotFound:
+		return response.Error(c, errors.ErrCodeWebhookNotFound, err.Error())
+	case ErrorMessageWebhookDeliveryNotFound:
+		return response.Error(c, errors.ErrCodeWebhookDeliveryNotFound, err.Error())
+	case ErrorMessageInvalidWebhookScope, ErrorMessageInvalidWebhookURL, ErrorMessageInvalidWebhookEvents:
+		return response.Error(c, errors.ErrCodeInvalidRequest, err.Error())
+	case ErrorMessagePermissionDenied, ErrorMessageTooManyWebhooks:
+		return response.Error(c, errors.ErrCodePermissionDenied, err.Error())
+	case ErrorMessageGroupNotFound:
+		return response.Error(c, errors.ErrCodeGroupNotFound, err.Error())
+	default:
+		return response.Error(c, defaultCode, err.Error())
+	}
+}
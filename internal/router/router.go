@@ -8,6 +8,43 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+// botAllowedRoutes 机器人可以访问的接口，其他需要认证的接口都拒绝机器人访问。
+// 机器人只需要收发消息、查看所在群组和使用投票、卡片，可以修改数据的接口说明如下：
+//   - 发送消息、发起投票、投票、发送卡片和点击卡片按钮：与普通成员相同，由服务层检查会话权限和发言限制
+//   - 关闭投票：只能关闭自己发起的投票，除非群组把 manage_poll 权限授予了成员角色
+//   - 退出群组：机器人可以自行退出所在的群组
+//   - 注册和删除群组命令：服务层只允许机器人为自己注册命令、删除自己注册的命令
+var botAllowedRoutes = map[string]bool{
+	"GET /ws":                                 true,
+	"GET /api/v1/ws/online":                   true,
+	"GET /api/v1/ws/online/:id":               true,
+	"GET /api/v1/user/me":                     true,
+	"GET /api/v1/group":                       true,
+	"GET /api/v1/group/:id":                   true,
+	"GET /api/v1/group/:id/members":           true,
+	"GET /api/v1/group/:id/announcement":      true,
+	"GET /api/v1/group/:id/permissions":       true,
+	"GET /api/v1/group/:id/topics":            true,
+	"GET /api/v1/group/:id/commands":          true,
+	"POST /api/v1/group/:id/commands":         true,
+	"DELETE /api/v1/group/:id/commands/:name": true,
+	"POST /api/v1/group/:id/leave":            true,
+	"POST /api/v1/message/send":               true,
+	"GET /api/v1/message/conversations":       true,
+	"GET /api/v1/message/private":             true,
+	"GET /api/v1/message/group/:id":           true,
+	"POST /api/v1/poll":                       true,
+	"GET /api/v1/poll/:id":                    true,
+	"POST /api/v1/poll/:id/vote":              true,
+	"POST /api/v1/poll/:id/close":             true,
+	"POST /api/v1/card":                       true,
+	"GET /api/v1/card/:id":                    true,
+	"POST /api/v1/card/:id/actions":           true,
+}
+
+// botAccess 按 botAllowedRoutes 限制机器人访问，所有需要认证的路由组都要使用
+var botAccess = middleware.BotAllowlistMiddleware(botAllowedRoutes)
+
 // InitRouter 初始化所有路由
 func InitRouter(e *echo.Echo) {
 	// API v1 路由组
@@ -20,6 +57,12 @@ func InitRouter(e *echo.Echo) {
 	userRoutes(apiV1)
 	groupRoutes(apiV1)
 	messageRoutes(apiV1)
+	exportRoutes(apiV1)
+	pollRoutes(apiV1)
+	cardRoutes(apiV1)
+	botRoutes(apiV1)
+	webhookRoutes(apiV1)
+	incomingWebhookRoutes(apiV1)
 	wsRoutes(e)
 }
 
@@ -41,10 +84,12 @@ func authRoutes(api *echo.Group) {
 func userRoutes(api *echo.Group) {
 	user := api.Group("/user")
 	user.Use(middleware.JWTMiddleware())
+	user.Use(botAccess)
 	user.GET("/me", v1.GetMe)
 
 	user.GET("/search", v1.SearchUser)
 
+	// 机器人不参与好友关系
 	user.GET("/friend", v1.GetFriendList)
 	user.POST("/friend", v1.AddFriend)
 	user.PUT("/friend/:id", v1.ProcessFriendRequest)
@@ -53,10 +98,14 @@ func userRoutes(api *echo.Group) {
 
 // groupRoutes 群组相关路由
 func groupRoutes(api *echo.Group) {
+	// 群头像通过 img 标签直接加载，不需要认证
+	api.GET("/group/:id/avatar", v1.GetGroupAvatar)
+
 	group := api.Group("/group")
 	group.Use(middleware.JWTMiddleware())
+	group.Use(botAccess)
 
-	// 创建群组
+	// 创建群组（机器人不能创建群组）
 	group.POST("", v1.CreateGroup)
 
 	// 获取群组列表
@@ -65,23 +114,66 @@ func groupRoutes(api *echo.Group) {
 	// 获取群组详情
 	group.GET("/:id", v1.GetGroupDetail)
 
+	// 分页获取群成员
+	group.GET("/:id/members", v1.GetGroupMemberList)
+
+	// 设置自己的群昵称
+	group.PUT("/:id/nickname", v1.SetMyNickname)
+
 	// 搜索群组
 	group.GET("/search", v1.SearchGroup)
 
-	// 申请加入群组
+	// 公开群组目录
+	group.GET("/directory", v1.GetGroupDirectory)
+
+	// 预览群组公开信息
+	group.GET("/:id/preview", v1.GetGroupPreview)
+
+	// 修改群组可见性、加入方式和成员数量上限
+	group.PUT("/:id/access", v1.UpdateGroupAccess)
+
+	// 查看和设置入群自动审批规则
+	group.GET("/:id/join-rules", v1.GetGroupJoinRules)
+	group.PUT("/:id/join-rules", v1.UpdateGroupJoinRules)
+
+	// 修改群组简介和标签
+	group.PUT("/:id/profile", v1.UpdateGroupProfile)
+
+	// 上传群头像
+	group.PUT("/:id/avatar", v1.UploadGroupAvatar)
+
+	// 删除群头像
+	group.DELETE("/:id/avatar", v1.DeleteGroupAvatar)
+
+	// 获取群公告
+	group.GET("/:id/announcement", v1.GetGroupAnnouncement)
+
+	// 修改群公告
+	group.PUT("/:id/announcement", v1.UpdateGroupAnnouncement)
+
+	// 确认群公告
+	group.POST("/:id/announcement/ack", v1.AckGroupAnnouncement)
+
+	// 申请加入群组（机器人只能由创建者添加，不能自行申请）
 	group.POST("/:id/request-join", v1.RequestJoinGroup)
 
+	// 撤回自己提交的入群申请
+	group.DELETE("/:id/request-join", v1.CancelJoinRequest)
+
 	// 获取待审核的入群请求
 	group.GET("/join-requests", v1.GetPendingJoinRequests)
 
 	// 审批入群请求
 	group.POST("/:id/join-requests/:user_id/approve", v1.ApproveJoinRequest)
 
-	// 加入群组
-	//group.POST("/:id/join", v1.JoinGroup)
+	// 批量审批入群请求
+	group.POST("/:id/join-requests/bulk", v1.BulkReviewJoinRequests)
+
+	// 直接加入开放加入的群组
+	group.POST("/:id/join", v1.JoinGroup)
 
 	// 通过邀请码加入群组
-	//group.POST("/join-by-code", v1.JoinGroupByCode)
+	group.POST("/join-by-code", v1.JoinGroupByCode)
 
 	// 退出群组
 	group.POST("/:id/leave", v1.LeaveGroup)
@@ -94,17 +186,127 @@ func groupRoutes(api *echo.Group) {
 
 	// 移除群组成员
 	group.DELETE("/:group_id/member/:user_id", v1.RemoveMember)
+
+	// 获取群组权限矩阵
+	group.GET("/:id/permissions", v1.GetGroupPermissions)
+
+	// 修改群组权限矩阵
+	group.PUT("/:id/permissions", v1.UpdateGroupPermissions)
+
+	// 设置群成员角色
+	group.PUT("/:id/member/:user_id/role", v1.UpdateMemberRole)
+
+	// 修改群成员的群昵称
+	group.PUT("/:id/member/:user_id/nickname", v1.SetMemberNickname)
+
+	// 禁言群成员
+	group.PUT("/:id/member/:user_id/mute", v1.MuteMember)
+
+	// 解除群成员禁言
+	group.DELETE("/:id/member/:user_id/mute", v1.UnmuteMember)
+
+	// 设置全员禁言和慢速模式
+	group.PUT("/:id/moderation", v1.UpdateGroupModeration)
+
+	// 封禁用户（在群组中时同时移出）
+	group.POST("/:id/bans", v1.BanMember)
+
+	// 获取群组封禁列表
+	group.GET("/:id/bans", v1.GetGroupBanList)
+
+	// 解除封禁
+	group.DELETE("/:id/bans/:user_id", v1.UnbanMember)
+
+	// 获取群组审计日志（管理员及以上）
+	group.GET("/:id/audit-logs", v1.GetGroupAuditLogs)
+
+	// 创建邀请链接
+	group.POST("/:id/invites", v1.CreateInviteLink)
+
+	// 获取群组邀请链接列表
+	group.GET("/:id/invites", v1.GetInviteLinkList)
+
+	// 撤销邀请链接
+	group.DELETE("/:id/invites/:code", v1.RevokeInviteLink)
+
+	// 获取通过邀请链接加入的用户
+	group.GET("/:id/invites/:code/uses", v1.GetInviteLinkUses)
+
+	// 开启或关闭论坛模式（仅群主）
+	group.PUT("/:id/forum", v1.UpdateGroupForum)
+
+	// 获取论坛话题列表
+	group.GET("/:id/topics", v1.GetGroupTopicList)
+
+	// 创建论坛话题
+	group.POST("/:id/topics", v1.CreateGroupTopic)
+
+	// 置顶或取消置顶话题
+	group.PUT("/:id/topics/:topic_id/pin", v1.PinGroupTopic)
+
+	// 将话题标记为已读
+	group.POST("/:id/topics/:topic_id/read", v1.MarkGroupTopicRead)
+
+	// 创建传入 Webhook
+	group.POST("/:id/incoming-webhooks", v1.CreateIncomingWebhook)
+
+	// 获取传入 Webhook 列表
+	group.GET("/:id/incoming-webhooks", v1.GetIncomingWebhookList)
+
+	// 重新生成传入 Webhook 地址
+	group.POST("/:id/incoming-webhooks/:webhook_id/token", v1.RegenerateIncomingWebhookToken)
+
+	// 删除传入 Webhook
+	group.DELETE("/:id/incoming-webhooks/:webhook_id", v1.DeleteIncomingWebhook)
+
+	// 获取群组可用命令
+	group.GET("/:id/commands", v1.GetGroupCommandList)
+
+	// 注册群组命令（机器人，或群主为传入 Webhook 注册）
+	group.POST("/:id/commands", v1.RegisterGroupCommand)
+
+	// 删除群组命令
+	group.DELETE("/:id/commands/:name", v1.DeleteGroupCommand)
+
+	// 设置群组欢迎语
+	group.PUT("/:id/welcome-message", v1.UpdateWelcomeMessage)
+
+	// 获取自动回复规则
+	group.GET("/:id/auto-replies", v1.GetAutoReplyList)
+
+	// 创建自动回复规则
+	group.POST("/:id/auto-replies", v1.CreateAutoReply)
+
+	// 更新自动回复规则
+	group.PUT("/:id/auto-replies/:rule_id", v1.UpdateAutoReply)
+
+	// 删除自动回复规则
+	group.DELETE("/:id/auto-replies/:rule_id", v1.DeleteAutoReply)
+
+	// 获取可用的助手回复器
+	group.GET("/assistant/responders", v1.GetAssistantResponders)
+
+	// 获取群组助手配置
+	group.GET("/:id/assistant", v1.GetGroupAssistant)
+
+	// 启用或更新群组助手
+	group.PUT("/:id/assistant", v1.SaveGroupAssistant)
+
+	// 删除群组助手
+	group.DELETE("/:id/assistant", v1.DeleteGroupAssistant)
 }
 
 // wsRoutes WebSocket相关路由
 func wsRoutes(e *echo.Echo) {
 	ws := e.Group("/ws")
 	ws.Use(middleware.JWTMiddleware())
+	ws.Use(botAccess)
 	ws.GET("", websocket.HandleWebSocket)
 
 	// WebSocket 状态查询API
 	wsAPI := e.Group("/api/v1/ws")
 	wsAPI.Use(middleware.JWTMiddleware())
+	wsAPI.Use(botAccess)
 	wsAPI.GET("/online", websocket.GetOnlineUsers)
 	wsAPI.GET("/online/:id", websocket.IsUserOnline)
 }
@@ -113,13 +315,132 @@ func wsRoutes(e *echo.Echo) {
 func messageRoutes(api *echo.Group) {
 	message := api.Group("/message")
 	message.Use(middleware.JWTMiddleware())
+	message.Use(botAccess)
+
+	// 发送消息（普通用户和机器人均可使用）
+	message.POST("/send", v1.SendMessage)
 
 	// 获取会话列表
 	message.GET("/conversations", v1.GetConversationList)
 
+	// 更新会话设置（置顶、归档、免打扰、隐藏）
+	message.PUT("/conversations/:type/:id/settings", v1.UpdateConversationSetting)
+
 	// 获取私聊消息记录
 	message.GET("/private", v1.GetPrivateMessages)
 
 	// 获取群聊消息记录
 	message.GET("/group/:id", v1.GetGroupMessages)
 }
+
+// exportRoutes 聊天记录导出相关路由
+func exportRoutes(api *echo.Group) {
+	export := api.Group("/export")
+	export.Use(middleware.JWTMiddleware())
+	export.Use(botAccess)
+
+	// 创建导出任务
+	export.POST("", v1.CreateExport)
+
+	// 获取导出任务列表
+	export.GET("", v1.GetExportList)
+
+	// 获取导出任务状态
+	export.GET("/:id", v1.GetExport)
+
+	// 下载导出文件
+	export.GET("/:id/download", v1.DownloadExport)
+}
+
+// pollRoutes 群聊投票相关路由
+func pollRoutes(api *echo.Group) {
+	poll := api.Group("/poll")
+	poll.Use(middleware.JWTMiddleware())
+	poll.Use(botAccess)
+
+	// 发起投票
+	poll.POST("", v1.CreatePoll)
+
+	// 获取投票详情
+	poll.GET("/:id", v1.GetPoll)
+
+	// 投票
+	poll.POST("/:id/vote", v1.VotePoll)
+
+	// 关闭投票
+	poll.POST("/:id/close", v1.ClosePoll)
+}
+
+// cardRoutes 交互式卡片相关路由
+func cardRoutes(api *echo.Group) {
+	card := api.Group("/card")
+	card.Use(middleware.JWTMiddleware())
+	card.Use(botAccess)
+
+	// 发送卡片消息
+	card.POST("", v1.SendCard)
+
+	// 获取卡片详情
+	card.GET("/:id", v1.GetCard)
+
+	// 点击卡片按钮
+	card.POST("/:id/actions", v1.HandleCardAction)
+}
+
+// botRoutes 机器人管理相关路由，仅普通用户可用
+func botRoutes(api *echo.Group) {
+	bot := api.Group("/bot")
+	bot.Use(middleware.JWTMiddleware())
+	bot.Use(botAccess)
+
+	// 创建机器人
+	bot.POST("", v1.CreateBot)
+
+	// 获取机器人列表
+	bot.GET("", v1.GetBotList)
+
+	// 重新生成机器人令牌
+	bot.POST("/:id/token", v1.RegenerateBotToken)
+
+	// 删除机器人
+	bot.DELETE("/:id", v1.DeleteBot)
+
+	// 将机器人加入群组
+	bot.POST("/:id/groups/:group_id", v1.AddBotToGroup)
+}
+
+// webhookRoutes Webhook 管理相关路由，仅普通用户可用
+func webhookRoutes(api *echo.Group) {
+	webhook := api.Group("/webhook")
+	webhook.Use(middleware.JWTMiddleware())
+	webhook.Use(botAccess)
+
+	// 注册 Webhook
+	webhook.POST("", v1.CreateWebhook)
+
+	// 获取 Webhook 列表
+	webhook.GET("", v1.GetWebhookList)
+
+	// 更新 Webhook
+	webhook.PUT("/:id", v1.UpdateWebhook)
+
+	// 删除 Webhook
+	webhook.DELETE("/:id", v1.DeleteWebhook)
+
+	// 重新生成签名密钥
+	webhook.POST("/:id/secret", v1.RotateWebhookSecret)
+
+	// 获取投递日志
+	webhook.GET("/:id/deliveries", v1.GetWebhookDeliveries)
+
+	// 重新投递
+	webhook.POST("/:id/deliveries/:delivery_id/retry", v1.RetryWebhookDelivery)
+}
+
+// incomingWebhookRoutes 传入 Webhook 路由，使用地址中的令牌认证，不需要 JWT
+func incomingWebhookRoutes(api *echo.Group) {
+	hooks := api.Group("/hooks")
+
+	// 向群组发送消息
+	hooks.POST("/:id/:token", v1.PostIncomingWebhook)
+}
@@ -0,0 +1,325 @@
+package service
+
+import (
+	"chat_backend/internal/assistant"
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+const (
+	defaultAssistantContextSize = 20
+	maxAssistantContextSize     = 50
+	maxAssistantNameLength      = 32
+	maxAssistantConfigLength    = 16 * 1024
+
+	// MaxAssistantReplyLength 助手单条回复的最大长度，超出部分会被截断
+	MaxAssistantReplyLength = 4000
+)
+
+const (
+	errAssistantNotFound         = "assistant not found"
+	errInvalidAssistantName      = "invalid assistant name"
+	errInvalidAssistantResponder = "invalid assistant responder"
+	errInvalidAssistantConfig    = "invalid assistant config"
+	errInvalidAssistantContext   = "invalid assistant context size"
+)
+
+// AssistantInvocation 一次被提及后需要执行的助手调用
+type AssistantInvocation struct {
+	Assistant *model.GroupAssistant
+	Responder assistant.Responder
+	Request   *assistant.Request
+}
+
+type AssistantService struct {
+	db *gorm.DB
+}
+
+func NewAssistantService(db *gorm.DB) *AssistantService {
+	return &AssistantService{
+		db: db,
+	}
+}
+
+// GetGroupAssistant 获取群组助手配置（仅群主）
+func (s *AssistantService) GetGroupAssistant(ctx context.Context, userID string, groupID string) (*dto.GroupAssistantResponse, error) {
+	if _, err := requireGroupOwner(ctx, s.db, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	aq := dao.Use(s.db).GroupAssistant
+	groupAssistant, err := aq.WithContext(ctx).Where(aq.GroupID.Eq(groupID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(errAssistantNotFound)
+		}
+		return nil, err
+	}
+
+	return s.toGroupAssistantResponse(groupAssistant), nil
+}
+
+// SaveGroupAssistant 启用或更新群组助手（仅群主）。
+// 首次启用时创建与助手同名的集成账号作为回复的发送者，修改名称时同步修改账号的用户名
+func (s *AssistantService) SaveGroupAssistant(ctx context.Context, userID string, groupID string, req dto.SaveGroupAssistantRequest) (*dto.GroupAssistantResponse, error) {
+	if _, err := requireGroupOwner(ctx, s.db, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	aq := dao.Use(s.db).GroupAssistant
+	groupAssistant, err := aq.WithContext(ctx).Where(aq.GroupID.Eq(groupID)).First()
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	isNew := groupAssistant == nil
+	if isNew {
+		groupAssistant = &model.GroupAssistant{
+			GroupID:     groupID,
+			CreatorID:   userID,
+			Responder:   assistant.EchoResponderName,
+			ContextSize: defaultAssistantContextSize,
+			Enabled:     true,
+		}
+	}
+
+	oldName := groupAssistant.Name
+	if req.Name != "" {
+		groupAssistant.Name = strings.TrimSpace(req.Name)
+	}
+	if req.Responder != "" {
+		groupAssistant.Responder = req.Responder
+	}
+	if req.Config != nil {
+		groupAssistant.Config = string(req.Config)
+		if string(req.Config) == "null" {
+			groupAssistant.Config = ""
+		}
+	}
+	if req.ContextSize != nil {
+		groupAssistant.ContextSize = *req.ContextSize
+	}
+	if req.Enabled != nil {
+		groupAssistant.Enabled = *req.Enabled
+	}
+	if err := validateGroupAssistant(groupAssistant); err != nil {
+		return nil, err
+	}
+
+	nameChanged := groupAssistant.Name != oldName
+	if nameChanged {
+		uq := dao.Use(s.db).User
+		if _, err := uq.WithContext(ctx).Where(uq.Username.Eq(groupAssistant.Name)).First(); err == nil {
+			return nil, ErrUsernameAlreadyExists
+		}
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		uq := dao.Use(tx).User
+		if isNew {
+			integration := &model.User{
+				Username:     groupAssistant.Name,
+				PasswordHash: unusablePasswordHash,
+				Type:         model.UserTypeIntegration,
+			}
+			if err := uq.WithContext(ctx).Create(integration); err != nil {
+				return fmt.Errorf("%s: %w", errFailedToCreateUser, err)
+			}
+			groupAssistant.UserID = integration.ID
+			return dao.Use(tx).GroupAssistant.WithContext(ctx).Create(groupAssistant)
+		}
+
+		if nameChanged {
+			if _, err := uq.WithContext(ctx).Where(uq.ID.Eq(groupAssistant.UserID)).Update(uq.Username, groupAssistant.Name); err != nil {
+				return err
+			}
+		}
+		aq := dao.Use(tx).GroupAssistant
+		_, err := aq.WithContext(ctx).Where(aq.GroupID.Eq(groupID)).Updates(map[string]interface{}{
+			"name":         groupAssistant.Name,
+			"responder":    groupAssistant.Responder,
+			"config":       groupAssistant.Config,
+			"context_size": groupAssistant.ContextSize,
+			"enabled":      groupAssistant.Enabled,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetGroupAssistant(ctx, userID, groupID)
+}
+
+// DeleteGroupAssistant 停用并删除群组助手，助手账号保留，历史回复仍显示原来的名称
+func (s *AssistantService) DeleteGroupAssistant(ctx context.Context, userID string, groupID string) error {
+	if _, err := requireGroupOwner(ctx, s.db, userID, groupID); err != nil {
+		return err
+	}
+
+	aq := dao.Use(s.db).GroupAssistant
+	info, err := aq.WithContext(ctx).Where(aq.GroupID.Eq(groupID)).Delete()
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return fmt.Errorf(errAssistantNotFound)
+	}
+	return nil
+}
+
+// PrepareAssistantInvocation 检查群消息是否提及了群组的助手，提及时创建回复器并组装调用输入。
+// 上下文取自 GetGroupMessages 返回的最近群消息，不包含触发消息本身；未启用助手或未提及时返回 nil
+func (s *AssistantService) PrepareAssistantInvocation(ctx context.Context, groupID string, userID string, messageID string, content string) (*AssistantInvocation, error) {
+	aq := dao.Use(s.db).GroupAssistant
+	groupAssistant, err := aq.WithContext(ctx).Where(aq.GroupID.Eq(groupID), aq.Enabled.Is(true)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	prompt, mentioned := stripMention(content, groupAssistant.Name)
+	if !mentioned {
+		return nil, nil
+	}
+
+	responder, err := assistant.New(groupAssistant.Responder, json.RawMessage(groupAssistant.Config))
+	if err != nil {
+		return nil, err
+	}
+
+	messageService := NewMessageService(s.db)
+	recent, err := messageService.GetGroupMessages(ctx, userID, groupID, "", groupAssistant.ContextSize+1, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	req := &assistant.Request{
+		GroupID:       groupID,
+		AssistantName: groupAssistant.Name,
+		UserID:        userID,
+		Prompt:        prompt,
+	}
+	// GetGroupMessages 按时间倒序返回，上下文需要按时间正序排列
+	for i := len(recent.Messages) - 1; i >= 0; i-- {
+		msg := recent.Messages[i]
+		if msg.MessageID == messageID {
+			continue
+		}
+		history := assistant.Message{
+			SenderID:      msg.FromUserID,
+			Content:       msg.Content,
+			FromAssistant: msg.FromUserID == groupAssistant.UserID,
+			CreatedAt:     msg.CreatedAt,
+		}
+		if msg.FromUser != nil {
+			history.SenderName = msg.FromUser.Username
+		}
+		if msg.TargetGroup != nil {
+			req.GroupName = msg.TargetGroup.Name
+		}
+		req.History = append(req.History, history)
+	}
+	if len(req.History) > groupAssistant.ContextSize {
+		req.History = req.History[len(req.History)-groupAssistant.ContextSize:]
+	}
+
+	user, err := messageService.getUserInfo(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	req.Username = user.Username
+
+	if req.GroupName == "" {
+		group, err := messageService.getGroupInfo(ctx, groupID)
+		if err != nil {
+			return nil, err
+		}
+		req.GroupName = group.Name
+	}
+
+	return &AssistantInvocation{
+		Assistant: groupAssistant,
+		Responder: responder,
+		Request:   req,
+	}, nil
+}
+
+func (s *AssistantService) toGroupAssistantResponse(groupAssistant *model.GroupAssistant) *dto.GroupAssistantResponse {
+	response := &dto.GroupAssistantResponse{
+		GroupID:     groupAssistant.GroupID,
+		UserID:      groupAssistant.UserID,
+		Name:        groupAssistant.Name,
+		Avatar:      NewMessageService(s.db).generateAvatarUrl(groupAssistant.UserID, groupAssistant.Name),
+		Responder:   groupAssistant.Responder,
+		ContextSize: groupAssistant.ContextSize,
+		Enabled:     groupAssistant.Enabled,
+		CreatorID:   groupAssistant.CreatorID,
+		CreatedAt:   groupAssistant.CreatedAt,
+		UpdatedAt:   groupAssistant.UpdatedAt,
+	}
+	if groupAssistant.Config != "" {
+		response.Config = json.RawMessage(groupAssistant.Config)
+	}
+	return response
+}
+
+// validateGroupAssistant 校验助手配置，回复器配置在保存前试创建一次以提前发现错误
+func validateGroupAssistant(groupAssistant *model.GroupAssistant) error {
+	name := groupAssistant.Name
+	if name == "" || utf8.RuneCountInString(name) > maxAssistantNameLength || strings.IndexFunc(name, unicode.IsSpace) >= 0 {
+		return fmt.Errorf(errInvalidAssistantName)
+	}
+	if groupAssistant.ContextSize < 0 || groupAssistant.ContextSize > maxAssistantContextSize {
+		return fmt.Errorf(errInvalidAssistantContext)
+	}
+	if len(groupAssistant.Config) > maxAssistantConfigLength {
+		return fmt.Errorf(errInvalidAssistantConfig)
+	}
+
+	if _, err := assistant.New(groupAssistant.Responder, json.RawMessage(groupAssistant.Config)); err != nil {
+		if errors.Is(err, assistant.ErrUnknownResponder) {
+			return fmt.Errorf(errInvalidAssistantResponder)
+		}
+		return fmt.Errorf(errInvalidAssistantConfig)
+	}
+
+	return nil
+}
+
+// stripMention 查找消息中的 @名称（忽略大小写），返回去掉提及后的内容。
+// 名称后紧跟字母或数字时不算提及，避免 @bot 匹配到 @bottle
+func stripMention(content string, name string) (string, bool) {
+	mention := "@" + strings.ToLower(name)
+	lower := strings.ToLower(content)
+	if len(lower) != len(content) {
+		// 大小写转换改变了字节长度时无法按下标截取原文，只匹配原样的提及
+		mention = "@" + name
+		lower = content
+	}
+
+	for offset := 0; ; {
+		i := strings.Index(lower[offset:], mention)
+		if i < 0 {
+			return "", false
+		}
+		start := offset + i
+		end := start + len(mention)
+		if next, _ := utf8.DecodeRuneInString(content[end:]); end < len(content) && (unicode.IsLetter(next) || unicode.IsDigit(next)) {
+			offset = end
+			continue
+		}
+		return strings.TrimSpace(content[:start] + content[end:]), true
+	}
+}
@@ -0,0 +1,345 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const (
+	AutoReplyMatchKeyword = "keyword"
+	AutoReplyMatchRegex   = "regex"
+
+	// WelcomePlaceholderUsername 欢迎语中替换为新成员用户名的占位符
+	WelcomePlaceholderUsername = "{username}"
+	// WelcomePlaceholderGroup 欢迎语中替换为群组名称的占位符
+	WelcomePlaceholderGroup = "{group}"
+
+	defaultAutoReplyCooldown  = 60
+	minAutoReplyCooldown      = 5
+	maxAutoReplyCooldown      = 24 * 60 * 60
+	maxAutoRepliesPerGroup    = 50
+	maxAutoReplyPatternLength = 200
+	maxAutoReplyLength        = 1000
+	maxWelcomeMessageLength   = 1000
+
+	// 单个群组每分钟最多触发的自动回复数，防止多条规则被轮流刷屏
+	maxAutoRepliesPerGroupMinute = 10
+
+	autoReplyCooldownKeyPrefix  = "auto_reply:cooldown:"
+	autoReplyGroupRateKeyPrefix = "auto_reply:rate:"
+)
+
+const (
+	errAutoReplyNotFound         = "auto reply not found"
+	errInvalidAutoReplyMatchType = "invalid auto reply match type"
+	errInvalidAutoReplyPattern   = "invalid auto reply pattern"
+	errInvalidAutoReplyContent   = "invalid auto reply content"
+	errInvalidAutoReplyCooldown  = "invalid auto reply cooldown"
+	errTooManyAutoReplies        = "too many auto replies"
+	errInvalidWelcomeMessage     = "invalid welcome message"
+)
+
+// autoReplyRegexCache 缓存编译后的正则表达式，键为规则的 pattern
+var autoReplyRegexCache sync.Map
+
+type AutoReplyService struct {
+	db  *gorm.DB
+	rdb *redis.Client
+}
+
+func NewAutoReplyService(db *gorm.DB, rdb *redis.Client) *AutoReplyService {
+	return &AutoReplyService{
+		db:  db,
+		rdb: rdb,
+	}
+}
+
+// SetWelcomeMessage 设置群组欢迎语（需要修改群资料的权限），空字符串表示关闭
+func (s *AutoReplyService) SetWelcomeMessage(ctx context.Context, userID string, groupID string, welcomeMessage string) error {
+	welcomeMessage = strings.TrimSpace(welcomeMessage)
+	if utf8.RuneCountInString(welcomeMessage) > maxWelcomeMessageLength {
+		return fmt.Errorf(errInvalidWelcomeMessage)
+	}
+
+	if _, err := requireGroupPermission(ctx, s.db, userID, groupID, PermissionEditInfo); err != nil {
+		return err
+	}
+
+	gq := dao.Use(s.db).Group
+	_, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).Update(gq.WelcomeMessage, welcomeMessage)
+	return err
+}
+
+// RenderWelcomeMessage 生成发送给新成员的欢迎语，群组未设置欢迎语时返回空字符串
+func (s *AutoReplyService) RenderWelcomeMessage(ctx context.Context, groupID string, userID string) (string, error) {
+	gq := dao.Use(s.db).Group
+	group, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).First()
+	if err != nil {
+		return "", fmt.Errorf(errGroupNotFound)
+	}
+	if group.WelcomeMessage == "" {
+		return "", nil
+	}
+
+	uq := dao.Use(s.db).User
+	user, err := uq.WithContext(ctx).Where(uq.ID.Eq(userID)).First()
+	if err != nil {
+		return "", fmt.Errorf(errUserNotFound)
+	}
+
+	replacer := strings.NewReplacer(WelcomePlaceholderUsername, user.Username, WelcomePlaceholderGroup, group.Name)
+	return replacer.Replace(group.WelcomeMessage), nil
+}
+
+// ListAutoReplies 获取群组的自动回复规则（仅群主）
+func (s *AutoReplyService) ListAutoReplies(ctx context.Context, userID string, groupID string) ([]*dto.AutoReplyResponse, error) {
+	if _, err := requireGroupOwner(ctx, s.db, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	aq := dao.Use(s.db).GroupAutoReply
+	rules, err := aq.WithContext(ctx).Where(aq.GroupID.Eq(groupID)).Order(aq.CreatedAt).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.AutoReplyResponse, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, toAutoReplyResponse(rule))
+	}
+
+	return result, nil
+}
+
+// CreateAutoReply 为群组创建自动回复规则（仅群主）
+func (s *AutoReplyService) CreateAutoReply(ctx context.Context, userID string, groupID string, req dto.CreateAutoReplyRequest) (*dto.AutoReplyResponse, error) {
+	if req.CooldownSeconds == 0 {
+		req.CooldownSeconds = defaultAutoReplyCooldown
+	}
+
+	rule := &model.GroupAutoReply{
+		GroupID:         groupID,
+		CreatorID:       userID,
+		MatchType:       req.MatchType,
+		Pattern:         req.Pattern,
+		Reply:           strings.TrimSpace(req.Reply),
+		CooldownSeconds: req.CooldownSeconds,
+		Enabled:         true,
+	}
+	if err := validateAutoReply(rule); err != nil {
+		return nil, err
+	}
+
+	if _, err := requireGroupOwner(ctx, s.db, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	aq := dao.Use(s.db).GroupAutoReply
+	count, err := aq.WithContext(ctx).Where(aq.GroupID.Eq(groupID)).Count()
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxAutoRepliesPerGroup {
+		return nil, fmt.Errorf(errTooManyAutoReplies)
+	}
+
+	if err := aq.WithContext(ctx).Create(rule); err != nil {
+		return nil, err
+	}
+
+	return toAutoReplyResponse(rule), nil
+}
+
+// UpdateAutoReply 更新自动回复规则（仅群主）
+func (s *AutoReplyService) UpdateAutoReply(ctx context.Context, userID string, groupID string, ruleID string, req dto.UpdateAutoReplyRequest) (*dto.AutoReplyResponse, error) {
+	rule, err := s.getGroupAutoReply(ctx, userID, groupID, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.MatchType != nil {
+		rule.MatchType = *req.MatchType
+	}
+	if req.Pattern != nil {
+		rule.Pattern = *req.Pattern
+	}
+	if req.Reply != nil {
+		rule.Reply = strings.TrimSpace(*req.Reply)
+	}
+	if req.CooldownSeconds != nil {
+		rule.CooldownSeconds = *req.CooldownSeconds
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	if err := validateAutoReply(rule); err != nil {
+		return nil, err
+	}
+
+	aq := dao.Use(s.db).GroupAutoReply
+	_, err = aq.WithContext(ctx).Where(aq.ID.Eq(rule.ID)).Updates(map[string]interface{}{
+		"match_type":       rule.MatchType,
+		"pattern":          rule.Pattern,
+		"reply":            rule.Reply,
+		"cooldown_seconds": rule.CooldownSeconds,
+		"enabled":          rule.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rule.UpdatedAt = time.Now()
+
+	return toAutoReplyResponse(rule), nil
+}
+
+// DeleteAutoReply 删除自动回复规则（仅群主）
+func (s *AutoReplyService) DeleteAutoReply(ctx context.Context, userID string, groupID string, ruleID string) error {
+	rule, err := s.getGroupAutoReply(ctx, userID, groupID, ruleID)
+	if err != nil {
+		return err
+	}
+
+	aq := dao.Use(s.db).GroupAutoReply
+	_, err = aq.WithContext(ctx).Where(aq.ID.Eq(rule.ID)).Delete()
+	return err
+}
+
+// MatchAutoReply 按创建顺序查找第一条命中消息内容的规则。
+// 命中的规则处于冷却期或群组触发次数超出限制时返回 nil，避免同一问题被反复回复
+func (s *AutoReplyService) MatchAutoReply(ctx context.Context, groupID string, content string) (*model.GroupAutoReply, error) {
+	aq := dao.Use(s.db).GroupAutoReply
+	rules, err := aq.WithContext(ctx).Where(aq.GroupID.Eq(groupID), aq.Enabled.Is(true)).Order(aq.CreatedAt).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range rules {
+		if !matchAutoReply(rule, content) {
+			continue
+		}
+
+		// 冷却期内的规则直接忽略，不再尝试后续规则，否则同一条消息可能在冷却期间改由其他规则回复
+		acquired, err := s.rdb.SetNX(ctx, autoReplyCooldownKeyPrefix+rule.ID, 1, time.Duration(rule.CooldownSeconds)*time.Second).Result()
+		if err != nil || !acquired {
+			return nil, err
+		}
+
+		rateKey := autoReplyGroupRateKeyPrefix + groupID
+		count, err := s.rdb.Incr(ctx, rateKey).Result()
+		if err != nil {
+			return nil, err
+		}
+		if count == 1 {
+			s.rdb.Expire(ctx, rateKey, time.Minute)
+		}
+		if count > maxAutoRepliesPerGroupMinute {
+			return nil, nil
+		}
+
+		return rule, nil
+	}
+
+	return nil, nil
+}
+
+// getGroupAutoReply 获取群主可以管理的自动回复规则
+func (s *AutoReplyService) getGroupAutoReply(ctx context.Context, userID string, groupID string, ruleID string) (*model.GroupAutoReply, error) {
+	if _, err := requireGroupOwner(ctx, s.db, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	aq := dao.Use(s.db).GroupAutoReply
+	rule, err := aq.WithContext(ctx).Where(aq.ID.Eq(ruleID), aq.GroupID.Eq(groupID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(errAutoReplyNotFound)
+		}
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// validateAutoReply 校验规则内容，正则表达式在保存前编译一次以提前发现语法错误
+func validateAutoReply(rule *model.GroupAutoReply) error {
+	if rule.Pattern == "" || utf8.RuneCountInString(rule.Pattern) > maxAutoReplyPatternLength {
+		return fmt.Errorf(errInvalidAutoReplyPattern)
+	}
+
+	switch rule.MatchType {
+	case AutoReplyMatchKeyword:
+		if strings.TrimSpace(rule.Pattern) == "" {
+			return fmt.Errorf(errInvalidAutoReplyPattern)
+		}
+	case AutoReplyMatchRegex:
+		if _, err := compileAutoReplyRegex(rule.Pattern); err != nil {
+			return fmt.Errorf(errInvalidAutoReplyPattern)
+		}
+	default:
+		return fmt.Errorf(errInvalidAutoReplyMatchType)
+	}
+
+	if rule.Reply == "" || utf8.RuneCountInString(rule.Reply) > maxAutoReplyLength {
+		return fmt.Errorf(errInvalidAutoReplyContent)
+	}
+	if rule.CooldownSeconds < minAutoReplyCooldown || rule.CooldownSeconds > maxAutoReplyCooldown {
+		return fmt.Errorf(errInvalidAutoReplyCooldown)
+	}
+
+	return nil
+}
+
+// matchAutoReply 判断消息内容是否命中规则，关键词匹配忽略大小写
+func matchAutoReply(rule *model.GroupAutoReply, content string) bool {
+	switch rule.MatchType {
+	case AutoReplyMatchKeyword:
+		return strings.Contains(strings.ToLower(content), strings.ToLower(rule.Pattern))
+	case AutoReplyMatchRegex:
+		re, err := compileAutoReplyRegex(rule.Pattern)
+		if err != nil {
+			return false
+		}
+		return re.MatchString(content)
+	default:
+		return false
+	}
+}
+
+func compileAutoReplyRegex(pattern string) (*regexp.Regexp, error) {
+	if cached, ok := autoReplyRegexCache.Load(pattern); ok {
+		return cached.(*regexp.Regexp), nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	autoReplyRegexCache.Store(pattern, re)
+	return re, nil
+}
+
+func toAutoReplyResponse(rule *model.GroupAutoReply) *dto.AutoReplyResponse {
+	return &dto.AutoReplyResponse{
+		RuleID:          rule.ID,
+		GroupID:         rule.GroupID,
+		MatchType:       rule.MatchType,
+		Pattern:         rule.Pattern,
+		Reply:           rule.Reply,
+		CooldownSeconds: rule.CooldownSeconds,
+		Enabled:         rule.Enabled,
+		CreatedAt:       rule.CreatedAt,
+		UpdatedAt:       rule.UpdatedAt,
+	}
+}
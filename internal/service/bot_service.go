@@ -0,0 +1,391 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// BotTokenPrefix 机器人令牌的固定前缀
+	BotTokenPrefix = "bot_"
+
+	botTokenBytes       = 32
+	botTokenPrefixLen   = 12
+	botTokenTouchPeriod = 5 * time.Minute
+	maxBotsPerOwner     = 20
+
+	BotGroupStatusJoined  = "joined"
+	BotGroupStatusPending = "pending"
+)
+
+const (
+	errBotNotFound       = "bot not found"
+	errInvalidBotToken   = "invalid bot token"
+	errTooManyBots       = "too many bots"
+	errBotAlreadyInGroup = "bot already in group"
+)
+
+type BotService struct {
+	db *gorm.DB
+}
+
+func NewBotService(db *gorm.DB) *BotService {
+	return &BotService{
+		db: db,
+	}
+}
+
+// CreateBot 创建机器人账号并生成访问令牌
+func (s *BotService) CreateBot(ctx context.Context, ownerID string, username string) (*dto.BotTokenResponse, error) {
+	uq := dao.Use(s.db).User
+	udo := uq.WithContext(ctx)
+
+	if _, err := udo.Where(uq.Username.Eq(username)).First(); err == nil {
+		return nil, ErrUsernameAlreadyExists
+	}
+
+	count, err := udo.Where(uq.BotOwnerID.Eq(ownerID), uq.Type.Eq(model.UserTypeBot)).Count()
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxBotsPerOwner {
+		return nil, fmt.Errorf(errTooManyBots)
+	}
+
+	var bot *model.User
+	var token string
+	var botToken *model.BotToken
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		bot = &model.User{
+			Username: username,
+			// 机器人只能使用令牌认证，无法通过密码登录
+			PasswordHash: unusablePasswordHash,
+			Type:         model.UserTypeBot,
+			BotOwnerID:   &ownerID,
+		}
+		if err := dao.Use(tx).User.WithContext(ctx).Create(bot); err != nil {
+			return fmt.Errorf("%s: %w", errFailedToCreateUser, err)
+		}
+
+		var err error
+		token, botToken, err = s.issueBotToken(ctx, tx, bot.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.BotTokenResponse{
+		Bot:   s.toBotResponse(bot, botToken),
+		Token: token,
+	}, nil
+}
+
+// ListBots 获取用户创建的机器人列表
+func (s *BotService) ListBots(ctx context.Context, ownerID string) ([]*dto.BotResponse, error) {
+	uq := dao.Use(s.db).User
+	bots, err := uq.WithContext(ctx).Where(uq.BotOwnerID.Eq(ownerID), uq.Type.Eq(model.UserTypeBot)).Order(uq.CreatedAt).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	botIDs := make([]string, 0, len(bots))
+	for _, bot := range bots {
+		botIDs = append(botIDs, bot.ID)
+	}
+
+	tokens := make(map[string]*model.BotToken, len(bots))
+	if len(botIDs) > 0 {
+		tq := dao.Use(s.db).BotToken
+		activeTokens, err := tq.WithContext(ctx).Where(tq.BotID.In(botIDs...), tq.RevokedAt.IsNull()).Find()
+		if err != nil {
+			return nil, err
+		}
+		for _, token := range activeTokens {
+			tokens[token.BotID] = token
+		}
+	}
+
+	result := make([]*dto.BotResponse, 0, len(bots))
+	for _, bot := range bots {
+		response := s.toBotResponse(bot, tokens[bot.ID])
+		result = append(result, &response)
+	}
+
+	return result, nil
+}
+
+// RegenerateBotToken 重新生成机器人令牌，旧令牌立即失效
+func (s *BotService) RegenerateBotToken(ctx context.Context, ownerID string, botID string) (*dto.BotTokenResponse, error) {
+	bot, err := s.getOwnedBot(ctx, ownerID, botID)
+	if err != nil {
+		return nil, err
+	}
+
+	var token string
+	var botToken *model.BotToken
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := revokeBotTokens(ctx, tx, bot.ID); err != nil {
+			return err
+		}
+
+		var err error
+		token, botToken, err = s.issueBotToken(ctx, tx, bot.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.BotTokenResponse{
+		Bot:   s.toBotResponse(bot, botToken),
+		Token: token,
+	}, nil
+}
+
+// DeleteBot 删除机器人：吊销令牌、退出所有群组并删除账号，返回机器人退出各群组的成员变化事件
+func (s *BotService) DeleteBot(ctx context.Context, ownerID string, botID string) ([]*dto.GroupMemberEvent, error) {
+	bot, err := s.getOwnedBot(ctx, ownerID, botID)
+	if err != nil {
+		return nil, err
+	}
+
+	var memberEvents []*dto.GroupMemberEvent
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := revokeBotTokens(ctx, tx, bot.ID); err != nil {
+			return err
+		}
+
+		mq := dao.Use(tx).GroupMember
+		memberships, err := mq.WithContext(ctx).Where(mq.UserID.Eq(bot.ID)).Find()
+		if err != nil {
+			return err
+		}
+		for _, membership := range memberships {
+			if _, err := mq.WithContext(ctx).Where(mq.GroupID.Eq(membership.GroupID), mq.UserID.Eq(bot.ID)).Delete(); err != nil {
+				return err
+			}
+			if err := decrementGroupMemberCount(ctx, tx, membership.GroupID); err != nil {
+				return err
+			}
+			if err := enqueueWebhookEvent(ctx, tx, newMemberWebhookEvent(WebhookEventGroupMemberLeft, membership.GroupID, bot.ID, ownerID)); err != nil {
+				return err
+			}
+			if err := recordGroupAudit(ctx, tx, membership.GroupID, ownerID, GroupAuditMemberLeft, bot.ID, map[string]interface{}{
+				"bot_deleted": true,
+			}); err != nil {
+				return err
+			}
+			memberEvent, err := recordGroupMemberEvent(ctx, tx, membership.GroupID, GroupMemberLeft, bot.ID, ownerID)
+			if err != nil {
+				return err
+			}
+			memberEvents = append(memberEvents, memberEvent)
+		}
+
+		uq := dao.Use(tx).User
+		_, err = uq.WithContext(ctx).Where(uq.ID.Eq(bot.ID)).Delete()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return memberEvents, nil
+}
+
+// AddBotToGroup 将机器人加入群组：有邀请权限的成员直接加入，其他群成员提交入群申请，等待审批
+func (s *BotService) AddBotToGroup(ctx context.Context, ownerID string, botID string, groupID string) (*dto.AddBotToGroupResponse, error) {
+	bot, err := s.getOwnedBot(ctx, ownerID, botID)
+	if err != nil {
+		return nil, err
+	}
+
+	gq := dao.Use(s.db).Group
+	if _, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).First(); err != nil {
+		return nil, fmt.Errorf(errGroupNotFound)
+	}
+
+	if err := checkGroupBan(ctx, s.db, groupID, bot.ID); err != nil {
+		return nil, err
+	}
+
+	groupService := NewGroupService(s.db)
+	isMember, err := groupService.IsGroupMember(ctx, groupID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, fmt.Errorf(errNotInGroup)
+	}
+
+	isBotMember, err := groupService.IsGroupMember(ctx, groupID, bot.ID)
+	if err != nil {
+		return nil, err
+	}
+	if isBotMember {
+		return nil, fmt.Errorf(errBotAlreadyInGroup)
+	}
+
+	response := &dto.AddBotToGroupResponse{
+		BotID:   bot.ID,
+		GroupID: groupID,
+	}
+
+	canInvite, err := groupService.HasPermission(ctx, groupID, ownerID, PermissionInvite)
+	if err != nil {
+		return nil, err
+	}
+	if !canInvite {
+		// 没有邀请权限时由群主或管理员决定机器人是否可以加入，符合自动审批规则时直接加入
+		result, err := groupService.RequestJoinGroup(ctx, bot.ID, groupID, "机器人 "+bot.Username+" 申请加入群组")
+		if err != nil {
+			return nil, err
+		}
+		response.Status = BotGroupStatusPending
+		if result.Status == StatusJoined {
+			response.Status = BotGroupStatusJoined
+			response.MemberEvent = result.MemberEvent
+		}
+		return response, nil
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		member := &model.GroupMember{
+			GroupID: groupID,
+			UserID:  bot.ID,
+			Role:    RoleMember,
+		}
+		if err := dao.Use(tx).GroupMember.WithContext(ctx).Create(member); err != nil {
+			return err
+		}
+
+		if err := incrementGroupMemberCount(ctx, tx, groupID); err != nil {
+			return err
+		}
+
+		if err := enqueueWebhookEvent(ctx, tx, newMemberWebhookEvent(WebhookEventGroupMemberJoined, groupID, bot.ID, ownerID)); err != nil {
+			return err
+		}
+
+		err := recordGroupAudit(ctx, tx, groupID, ownerID, GroupAuditMemberJoined, bot.ID, map[string]interface{}{
+			"bot": true,
+		})
+		if err != nil {
+			return err
+		}
+
+		response.MemberEvent, err = recordGroupMemberEvent(ctx, tx, groupID, GroupMemberJoined, bot.ID, ownerID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response.Status = BotGroupStatusJoined
+	return response, nil
+}
+
+// AuthenticateBotToken 校验机器人令牌，返回机器人的用户ID和用户名
+func (s *BotService) AuthenticateBotToken(ctx context.Context, token string) (string, string, error) {
+	if !strings.HasPrefix(token, BotTokenPrefix) {
+		return "", "", fmt.Errorf(errInvalidBotToken)
+	}
+
+	tq := dao.Use(s.db).BotToken
+	botToken, err := tq.WithContext(ctx).Where(tq.TokenHash.Eq(hashToken(token)), tq.RevokedAt.IsNull()).First()
+	if err != nil {
+		return "", "", fmt.Errorf(errInvalidBotToken)
+	}
+
+	uq := dao.Use(s.db).User
+	bot, err := uq.WithContext(ctx).Where(uq.ID.Eq(botToken.BotID), uq.Type.Eq(model.UserTypeBot)).First()
+	if err != nil {
+		return "", "", fmt.Errorf(errInvalidBotToken)
+	}
+
+	// 限制最后使用时间的更新频率，避免每个请求都写数据库
+	now := time.Now()
+	if botToken.LastUsedAt == nil || now.Sub(*botToken.LastUsedAt) > botTokenTouchPeriod {
+		if _, err := tq.WithContext(ctx).Where(tq.ID.Eq(botToken.ID)).Update(tq.LastUsedAt, now); err != nil {
+			return "", "", err
+		}
+	}
+
+	return bot.ID, bot.Username, nil
+}
+
+// getOwnedBot 获取用户创建的机器人
+func (s *BotService) getOwnedBot(ctx context.Context, ownerID string, botID string) (*model.User, error) {
+	uq := dao.Use(s.db).User
+	bot, err := uq.WithContext(ctx).Where(
+		uq.ID.Eq(botID),
+		uq.Type.Eq(model.UserTypeBot),
+		uq.BotOwnerID.Eq(ownerID),
+	).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(errBotNotFound)
+		}
+		return nil, err
+	}
+
+	return bot, nil
+}
+
+// issueBotToken 生成新的机器人令牌，返回令牌明文
+func (s *BotService) issueBotToken(ctx context.Context, tx *gorm.DB, botID string) (string, *model.BotToken, error) {
+	buf := make([]byte, botTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", nil, err
+	}
+	token := BotTokenPrefix + hex.EncodeToString(buf)
+
+	botToken := &model.BotToken{
+		BotID:     botID,
+		TokenHash: hashToken(token),
+		Prefix:    token[:botTokenPrefixLen],
+	}
+	if err := dao.Use(tx).BotToken.WithContext(ctx).Create(botToken); err != nil {
+		return "", nil, err
+	}
+
+	return token, botToken, nil
+}
+
+func (s *BotService) toBotResponse(bot *model.User, token *model.BotToken) dto.BotResponse {
+	response := dto.BotResponse{
+		BotID:     bot.ID,
+		Username:  bot.Username,
+		Avatar:    NewMessageService(s.db).generateAvatarUrl(bot.ID, bot.Username),
+		CreatedAt: bot.CreatedAt,
+	}
+	if token != nil {
+		response.TokenPrefix = token.Prefix
+		response.LastUsedAt = token.LastUsedAt
+	}
+	return response
+}
+
+// revokeBotTokens 吊销机器人的所有有效令牌
+func revokeBotTokens(ctx context.Context, tx *gorm.DB, botID string) error {
+	tq := dao.Use(tx).BotToken
+	_, err := tq.WithContext(ctx).Where(tq.BotID.Eq(botID), tq.RevokedAt.IsNull()).Update(tq.RevokedAt, time.Now())
+	return err
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+	"fmt"
+	"sync"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// CardHandlerApproval 内置的审批处理器：按钮ID为 approve / reject
+	CardHandlerApproval = "approval"
+
+	CardActionApprove = "approve"
+	CardActionReject  = "reject"
+)
+
+// CardActionContext 卡片按钮点击的上下文
+type CardActionContext struct {
+	Tx       *gorm.DB           // 记录点击的事务，处理器返回错误时回滚
+	Card     *model.CardMessage // 卡片记录
+	Content  *dto.Card          // 当前卡片内容
+	Button   dto.CardButton     // 被点击的按钮
+	UserID   string
+	Username string
+}
+
+// CardActionHandler 处理卡片按钮点击，返回更新后的卡片内容，返回 nil 表示卡片不变
+type CardActionHandler func(ctx context.Context, action *CardActionContext) (*dto.Card, error)
+
+var (
+	cardHandlersMu sync.RWMutex
+	cardHandlers   = map[string]CardActionHandler{
+		CardHandlerApproval: approvalCardHandler,
+	}
+)
+
+// RegisterCardActionHandler 注册卡片按钮点击处理器，同名处理器会被覆盖
+func RegisterCardActionHandler(name string, handler CardActionHandler) {
+	cardHandlersMu.Lock()
+	defer cardHandlersMu.Unlock()
+	cardHandlers[name] = handler
+}
+
+func getCardActionHandler(name string) (CardActionHandler, bool) {
+	cardHandlersMu.RLock()
+	defer cardHandlersMu.RUnlock()
+	handler, ok := cardHandlers[name]
+	return handler, ok
+}
+
+// approvalCardHandler 审批卡片：群聊中由拥有审批卡片权限的成员审批，私聊中由接收方审批
+// 审批后移除按钮并在卡片底部显示结果
+func approvalCardHandler(ctx context.Context, action *CardActionContext) (*dto.Card, error) {
+	if action.Button.ID != CardActionApprove && action.Button.ID != CardActionReject {
+		return nil, fmt.Errorf(errInvalidCardAction)
+	}
+
+	switch action.Card.Type {
+	case model.MessageTypeGroup:
+		if _, err := requireGroupPermission(ctx, action.Tx, action.UserID, action.Card.TargetID, PermissionApproveCard); err != nil {
+			return nil, err
+		}
+	case model.MessageTypePrivate:
+		if action.UserID == action.Card.CreatorID {
+			return nil, fmt.Errorf(errPermissionDenied)
+		}
+	}
+
+	card := *action.Content
+	card.Actions = nil
+	if action.Button.ID == CardActionApprove {
+		card.Footer = "已批准：" + action.Username
+	} else {
+		card.Footer = "已拒绝：" + action.Username
+	}
+
+	return &card, nil
+}
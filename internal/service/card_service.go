@@ -0,0 +1,512 @@
+package service
+
+import (
+	"bytes"
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	maxCardTitleLength  = 200
+	maxCardFields       = 20
+	maxCardImages       = 10
+	maxCardActions      = 10
+	maxCardCallbackBody = 1 << 20
+	cardCallbackTimeout = 5 * time.Second
+)
+
+const (
+	errCardNotFound              = "card not found"
+	errInvalidCard               = "invalid card"
+	errUnknownCardHandler        = "unknown card handler"
+	errInvalidCallbackURL        = "invalid callback url"
+	errInvalidCardAction         = "invalid card action"
+	errCardActionAlreadyRecorded = "card action already recorded"
+	errCardCallbackFailed        = "card callback failed"
+	errCardAlreadyResolved       = "card already resolved"
+)
+
+var cardCallbackClient = newOutboundHTTPClient(cardCallbackTimeout)
+
+type CardService struct {
+	db  *gorm.DB
+	rdb *redis.Client
+}
+
+func NewCardService(db *gorm.DB, rdb *redis.Client) *CardService {
+	return &CardService{
+		db:  db,
+		rdb: rdb,
+	}
+}
+
+// SendCard 发送交互式卡片消息
+// recipientIDs、onlineUserIDs 与 MessageService.SendGroupMessage 相同，用于创建离线回执；
+// 群聊中发送卡片与发送消息一样受禁言、慢速模式和频道限制
+func (s *CardService) SendCard(ctx context.Context, userID string, req dto.SendCardRequest, recipientIDs []string, onlineUserIDs map[string]bool) (*model.Message, *dto.SendCardResponse, error) {
+	if err := s.checkConversationAccess(ctx, userID, req.ChatType, req.TargetID); err != nil {
+		return nil, nil, err
+	}
+	if err := validateCard(&req.Card); err != nil {
+		return nil, nil, err
+	}
+	if req.Handler != "" {
+		if _, ok := getCardActionHandler(req.Handler); !ok {
+			return nil, nil, fmt.Errorf(errUnknownCardHandler)
+		}
+	}
+	callbackSecret := ""
+	if req.CallbackURL != "" {
+		if err := validatePublicHTTPURL(ctx, req.CallbackURL); err != nil {
+			return nil, nil, fmt.Errorf(errInvalidCallbackURL)
+		}
+		secret, err := generateWebhookSecret()
+		if err != nil {
+			return nil, nil, err
+		}
+		callbackSecret = secret
+	}
+
+	content, err := json.Marshal(req.Card)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 发言检查放在最后，慢速模式检查通过即开始计算下一次发言的间隔
+	if model.MessageType(req.ChatType) == model.MessageTypeGroup {
+		if err := NewGroupModerationService(s.db, s.rdb).EnsureCanPost(ctx, req.TargetID, userID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var message *model.Message
+	var card *model.CardMessage
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		message = &model.Message{
+			FromUserID: userID,
+			TargetID:   req.TargetID,
+			Type:       model.MessageType(req.ChatType),
+			Kind:       model.MessageKindCard,
+			Content:    req.Card.Title,
+		}
+		if err := createMessageWithReceipts(ctx, tx, message, recipientIDs, onlineUserIDs); err != nil {
+			return err
+		}
+
+		card = &model.CardMessage{
+			MessageID:      message.ID,
+			Type:           message.Type,
+			TargetID:       req.TargetID,
+			CreatorID:      userID,
+			Content:        string(content),
+			Handler:        req.Handler,
+			CallbackURL:    req.CallbackURL,
+			CallbackSecret: callbackSecret,
+		}
+		return dao.Use(tx).CardMessage.WithContext(ctx).Create(card)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response, err := toCardResponse(card, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return message, &dto.SendCardResponse{CardResponse: *response, CallbackSecret: callbackSecret}, nil
+}
+
+// GetCard 获取卡片详情及当前用户点击过的按钮
+func (s *CardService) GetCard(ctx context.Context, userID string, cardID string) (*dto.CardResponse, error) {
+	card, err := s.getAccessibleCard(ctx, userID, cardID)
+	if err != nil {
+		return nil, err
+	}
+
+	aq := dao.Use(s.db).CardAction
+	myActionID := ""
+	if action, err := aq.WithContext(ctx).Where(aq.CardID.Eq(card.ID), aq.UserID.Eq(userID)).First(); err == nil {
+		myActionID = action.ActionID
+	}
+
+	return toCardResponse(card, myActionID)
+}
+
+// HandleCardAction 处理卡片按钮点击：校验按钮、记录点击（每个用户一次），
+// 并交给注册的处理器或回调地址处理；返回的 updated 表示卡片内容是否发生变化。
+// 回调请求在记录点击的事务提交后发出，回调返回的卡片在单独的事务中保存。
+// 事务中锁定卡片并重新读取内容，同时点击的请求依次处理，按钮已被之前的点击移除时拒绝
+func (s *CardService) HandleCardAction(ctx context.Context, userID string, cardID string, actionID string) (*dto.CardResponse, bool, error) {
+	card, err := s.getAccessibleCard(ctx, userID, cardID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var content dto.Card
+	if err := json.Unmarshal([]byte(card.Content), &content); err != nil {
+		return nil, false, err
+	}
+	if findCardButton(&content, actionID) == nil {
+		return nil, false, fmt.Errorf(errInvalidCardAction)
+	}
+
+	username, err := NewUserService(s.db).GetUsernameByUserID(ctx, userID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var button *dto.CardButton
+	var updated *dto.Card
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		locked, err := lockCard(ctx, tx, card.ID)
+		if err != nil {
+			return err
+		}
+		*card = *locked
+
+		content = dto.Card{}
+		if err := json.Unmarshal([]byte(card.Content), &content); err != nil {
+			return err
+		}
+		button = findCardButton(&content, actionID)
+		if button == nil {
+			return fmt.Errorf(errCardAlreadyResolved)
+		}
+
+		aq := dao.Use(tx).CardAction
+		ado := aq.WithContext(ctx)
+
+		count, err := ado.Where(aq.CardID.Eq(card.ID), aq.UserID.Eq(userID)).Count()
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return fmt.Errorf(errCardActionAlreadyRecorded)
+		}
+		if err := ado.Create(&model.CardAction{
+			CardID:   card.ID,
+			UserID:   userID,
+			ActionID: actionID,
+		}); err != nil {
+			return err
+		}
+
+		if card.Handler == "" {
+			return nil
+		}
+		handler, ok := getCardActionHandler(card.Handler)
+		if !ok {
+			return fmt.Errorf(errUnknownCardHandler)
+		}
+		updated, err = handler(ctx, &CardActionContext{
+			Tx:       tx,
+			Card:     card,
+			Content:  &content,
+			Button:   *button,
+			UserID:   userID,
+			Username: username,
+		})
+		if err != nil {
+			return err
+		}
+		if updated == nil {
+			return nil
+		}
+		return saveCardContent(ctx, tx, card, updated)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if card.CallbackURL != "" {
+		callbackCard, err := s.postCardCallback(ctx, card, *button, userID, username)
+		if err != nil {
+			// 没有处理器时点击只被记录，撤销点击以便用户重试；有处理器时处理结果已提交，保留点击记录
+			if card.Handler == "" {
+				aq := dao.Use(s.db).CardAction
+				if _, deleteErr := aq.WithContext(ctx).Where(aq.CardID.Eq(card.ID), aq.UserID.Eq(userID)).Delete(); deleteErr != nil {
+					return nil, false, deleteErr
+				}
+			}
+			return nil, false, err
+		}
+		if callbackCard != nil {
+			// 回调期间按钮已被其他点击移除时，保留已经生效的结果，不用这次回调的卡片覆盖
+			saved := false
+			if err := s.db.Transaction(func(tx *gorm.DB) error {
+				locked, err := lockCard(ctx, tx, card.ID)
+				if err != nil {
+					return err
+				}
+				*card = *locked
+
+				var current dto.Card
+				if err := json.Unmarshal([]byte(card.Content), &current); err != nil {
+					return err
+				}
+				if findCardButton(&current, actionID) == nil {
+					return nil
+				}
+				saved = true
+				return saveCardContent(ctx, tx, card, callbackCard)
+			}); err != nil {
+				return nil, false, err
+			}
+			if saved {
+				updated = callbackCard
+			}
+		}
+	}
+
+	response, err := toCardResponse(card, actionID)
+	if err != nil {
+		return nil, false, err
+	}
+	if updated != nil {
+		response.UpdatedAt = time.Now()
+	}
+
+	return response, updated != nil, nil
+}
+
+// lockCard 在事务中锁定并读取卡片，同一张卡片的点击依次处理
+func lockCard(ctx context.Context, tx *gorm.DB, cardID string) (*model.CardMessage, error) {
+	cq := dao.Use(tx).CardMessage
+	card, err := cq.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where(cq.ID.Eq(cardID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(errCardNotFound)
+		}
+		return nil, err
+	}
+	return card, nil
+}
+
+// findCardButton 按ID查找卡片中的按钮，不存在时返回 nil
+func findCardButton(content *dto.Card, actionID string) *dto.CardButton {
+	for i := range content.Actions {
+		if content.Actions[i].ID == actionID {
+			return &content.Actions[i]
+		}
+	}
+	return nil
+}
+
+// saveCardContent 校验并保存更新后的卡片内容
+func saveCardContent(ctx context.Context, tx *gorm.DB, card *model.CardMessage, updated *dto.Card) error {
+	if err := validateCard(updated); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(updated)
+	if err != nil {
+		return err
+	}
+
+	cq := dao.Use(tx).CardMessage
+	if _, err := cq.WithContext(ctx).Where(cq.ID.Eq(card.ID)).Update(cq.Content, string(data)); err != nil {
+		return err
+	}
+	card.Content = string(data)
+	return nil
+}
+
+// postCardCallback 将按钮点击事件发送到卡片的回调地址，响应中可以携带更新后的卡片。
+// 请求与 Webhook 投递一样使用卡片的密钥签名，接收方据此确认请求来自本服务
+func (s *CardService) postCardCallback(ctx context.Context, card *model.CardMessage, button dto.CardButton, userID string, username string) (*dto.Card, error) {
+	event := dto.CardCallbackEvent{
+		CardID:    card.ID,
+		MessageID: card.MessageID,
+		ChatType:  string(card.Type),
+		TargetID:  card.TargetID,
+		ActionID:  button.ID,
+		Value:     button.Value,
+		UserID:    userID,
+		Username:  username,
+		Timestamp: time.Now().UnixMilli(),
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, card.CallbackURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	setSignatureHeaders(req, card.CallbackSecret, body)
+
+	resp, err := cardCallbackClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errCardCallbackFailed, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("%s: status %d", errCardCallbackFailed, resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxCardCallbackBody))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errCardCallbackFailed, err)
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+
+	var callbackResp dto.CardCallbackResponse
+	if err := json.Unmarshal(data, &callbackResp); err != nil {
+		return nil, fmt.Errorf("%s: %w", errCardCallbackFailed, err)
+	}
+
+	return callbackResp.Card, nil
+}
+
+// GetCardsByMessageIDs 批量获取卡片消息对应的卡片内容，key 为消息ID
+func (s *CardService) GetCardsByMessageIDs(ctx context.Context, viewerID string, messageIDs []string) (map[string]*dto.CardResponse, error) {
+	result := make(map[string]*dto.CardResponse)
+	if len(messageIDs) == 0 {
+		return result, nil
+	}
+
+	cq := dao.Use(s.db).CardMessage
+	cards, err := cq.WithContext(ctx).Where(cq.MessageID.In(messageIDs...)).Find()
+	if err != nil {
+		return nil, err
+	}
+	if len(cards) == 0 {
+		return result, nil
+	}
+
+	cardIDs := make([]string, 0, len(cards))
+	for _, card := range cards {
+		cardIDs = append(cardIDs, card.ID)
+	}
+
+	myActions := make(map[string]string)
+	aq := dao.Use(s.db).CardAction
+	actions, err := aq.WithContext(ctx).Where(aq.CardID.In(cardIDs...), aq.UserID.Eq(viewerID)).Find()
+	if err != nil {
+		return nil, err
+	}
+	for _, action := range actions {
+		myActions[action.CardID] = action.ActionID
+	}
+
+	for _, card := range cards {
+		response, err := toCardResponse(card, myActions[card.ID])
+		if err != nil {
+			return nil, err
+		}
+		result[card.MessageID] = response
+	}
+
+	return result, nil
+}
+
+// getAccessibleCard 获取卡片，并校验用户是卡片所在会话的参与者
+func (s *CardService) getAccessibleCard(ctx context.Context, userID string, cardID string) (*model.CardMessage, error) {
+	cq := dao.Use(s.db).CardMessage
+	card, err := cq.WithContext(ctx).Where(cq.ID.Eq(cardID)).First()
+	if err != nil {
+		return nil, fmt.Errorf(errCardNotFound)
+	}
+
+	switch card.Type {
+	case model.MessageTypePrivate:
+		if userID != card.CreatorID && userID != card.TargetID {
+			return nil, fmt.Errorf(errPermissionDenied)
+		}
+	case model.MessageTypeGroup:
+		isMember, err := NewGroupService(s.db).IsGroupMember(ctx, card.TargetID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if !isMember {
+			return nil, fmt.Errorf(errNotInGroup)
+		}
+	}
+
+	return card, nil
+}
+
+// checkConversationAccess 校验用户可以向会话发送消息：私聊与私聊消息相同，需为好友或机器人与其创建者，群聊需为群成员
+func (s *CardService) checkConversationAccess(ctx context.Context, userID string, chatType string, targetID string) error {
+	switch model.MessageType(chatType) {
+	case model.MessageTypePrivate:
+		canChat, err := NewUserService(s.db).CanChatPrivately(ctx, userID, targetID)
+		if err != nil {
+			return err
+		}
+		if !canChat {
+			return fmt.Errorf(errNotFriends)
+		}
+	case model.MessageTypeGroup:
+		isMember, err := NewGroupService(s.db).IsGroupMember(ctx, targetID, userID)
+		if err != nil {
+			return err
+		}
+		if !isMember {
+			return fmt.Errorf(errNotInGroup)
+		}
+	default:
+		return fmt.Errorf(errInvalidConversationType)
+	}
+
+	return nil
+}
+
+// validateCard 校验卡片内容
+func validateCard(card *dto.Card) error {
+	card.Title = strings.TrimSpace(card.Title)
+	if card.Title == "" || utf8.RuneCountInString(card.Title) > maxCardTitleLength {
+		return fmt.Errorf(errInvalidCard)
+	}
+	if len(card.Fields) > maxCardFields || len(card.Images) > maxCardImages || len(card.Actions) > maxCardActions {
+		return fmt.Errorf(errInvalidCard)
+	}
+
+	buttonIDs := make(map[string]bool, len(card.Actions))
+	for _, button := range card.Actions {
+		if button.ID == "" || button.Label == "" || buttonIDs[button.ID] {
+			return fmt.Errorf(errInvalidCard)
+		}
+		buttonIDs[button.ID] = true
+	}
+
+	return nil
+}
+
+func toCardResponse(card *model.CardMessage, myActionID string) (*dto.CardResponse, error) {
+	var content dto.Card
+	if err := json.Unmarshal([]byte(card.Content), &content); err != nil {
+		return nil, err
+	}
+
+	return &dto.CardResponse{
+		CardID:     card.ID,
+		MessageID:  card.MessageID,
+		ChatType:   string(card.Type),
+		TargetID:   card.TargetID,
+		CreatorID:  card.CreatorID,
+		Card:       content,
+		MyActionID: myActionID,
+		UpdatedAt:  card.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"chat_backend/internal/dto"
+	"testing"
+)
+
+func TestFindCardButton(t *testing.T) {
+	content := &dto.Card{
+		Title: "请假审批",
+		Actions: []dto.CardButton{
+			{ID: "approve", Label: "同意"},
+			{ID: "reject", Label: "拒绝"},
+		},
+	}
+
+	button := findCardButton(content, "reject")
+	if button == nil || button.Label != "拒绝" {
+		t.Fatalf("findCardButton(reject) = %+v", button)
+	}
+
+	// 审批完成后按钮被移除，再次点击时找不到按钮
+	content.Actions = nil
+	if button := findCardButton(content, "approve"); button != nil {
+		t.Errorf("findCardButton on resolved card = %+v, want nil", button)
+	}
+}
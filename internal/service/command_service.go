@@ -0,0 +1,448 @@
+package service
+
+import (
+	"bytes"
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// CommandVisibilityEphemeral 命令回复仅调用者可见
+	CommandVisibilityEphemeral = "ephemeral"
+	// CommandVisibilityGroup 命令回复发送到群组，所有成员可见
+	CommandVisibilityGroup = "group"
+
+	commandCallbackTimeout = 5 * time.Second
+	maxCommandCallbackBody = 64 * 1024
+	maxCommandsPerGroup    = 50
+	maxTopicLength         = 200
+	maxReminderTextLength  = 500
+	reminderBatchSize      = 100
+)
+
+const (
+	errInvalidCommandName    = "invalid command name"
+	errCommandNameTaken      = "command name already taken"
+	errCommandNotFound       = "command not found"
+	errTooManyCommands       = "too many commands"
+	errCommandCallbackFailed = "command callback failed"
+	errCommandCallbackNeeded = "callback url is required for incoming webhook commands"
+	errInvalidTopic          = "invalid topic"
+	errInvalidReminderText   = "invalid reminder text"
+)
+
+var (
+	commandNamePattern    = regexp.MustCompile(`^[a-z0-9_-]{1,32}$`)
+	commandCallbackClient = newOutboundHTTPClient(commandCallbackTimeout)
+)
+
+type CommandService struct {
+	db *gorm.DB
+}
+
+func NewCommandService(db *gorm.DB) *CommandService {
+	return &CommandService{
+		db: db,
+	}
+}
+
+// IsValidCommandName 判断命令名是否合法
+func IsValidCommandName(name string) bool {
+	return commandNamePattern.MatchString(name)
+}
+
+// RegisterGroupCommand 注册群组命令：机器人为自己注册，群主为群内的传入 Webhook 注册
+// 同名命令由同一个机器人重复注册时更新描述和回调地址，已有的签名密钥保持不变
+func (s *CommandService) RegisterGroupCommand(ctx context.Context, callerID string, groupID string, req dto.RegisterCommandRequest) (*dto.RegisterCommandResponse, error) {
+	if !IsValidCommandName(req.Name) {
+		return nil, fmt.Errorf(errInvalidCommandName)
+	}
+	if req.CallbackURL != "" {
+		if err := validatePublicHTTPURL(ctx, req.CallbackURL); err != nil {
+			return nil, fmt.Errorf(errInvalidCallbackURL)
+		}
+	}
+
+	gq := dao.Use(s.db).Group
+	group, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).First()
+	if err != nil {
+		return nil, fmt.Errorf(errGroupNotFound)
+	}
+
+	var botID string
+	if req.WebhookID != "" {
+		if group.OwnerID != callerID {
+			return nil, fmt.Errorf(errPermissionDenied)
+		}
+		iq := dao.Use(s.db).IncomingWebhook
+		webhook, err := iq.WithContext(ctx).Where(iq.ID.Eq(req.WebhookID), iq.GroupID.Eq(groupID)).First()
+		if err != nil {
+			return nil, fmt.Errorf(errIncomingWebhookNotFound)
+		}
+		// 集成账号没有 WebSocket 连接，只能通过回调地址处理命令
+		if req.CallbackURL == "" {
+			return nil, fmt.Errorf(errCommandCallbackNeeded)
+		}
+		botID = webhook.UserID
+	} else {
+		uq := dao.Use(s.db).User
+		count, err := uq.WithContext(ctx).Where(uq.ID.Eq(callerID), uq.Type.Eq(model.UserTypeBot)).Count()
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			return nil, fmt.Errorf(errPermissionDenied)
+		}
+		isMember, err := NewGroupService(s.db).IsGroupMember(ctx, groupID, callerID)
+		if err != nil {
+			return nil, err
+		}
+		if !isMember {
+			return nil, fmt.Errorf(errNotInGroup)
+		}
+		botID = callerID
+	}
+
+	cq := dao.Use(s.db).GroupCommand
+	command, err := cq.WithContext(ctx).Where(cq.GroupID.Eq(groupID), cq.Name.Eq(req.Name)).First()
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if command != nil {
+		if command.BotID != botID {
+			return nil, fmt.Errorf(errCommandNameTaken)
+		}
+		command.Description = req.Description
+		command.Usage = req.Usage
+		command.CallbackURL = req.CallbackURL
+		if err := ensureCommandCallbackSecret(command); err != nil {
+			return nil, err
+		}
+		if _, err := cq.WithContext(ctx).Where(cq.ID.Eq(command.ID)).Select(
+			cq.Description, cq.Usage, cq.CallbackURL, cq.CallbackSecret,
+		).Updates(command); err != nil {
+			return nil, err
+		}
+	} else {
+		count, err := cq.WithContext(ctx).Where(cq.GroupID.Eq(groupID)).Count()
+		if err != nil {
+			return nil, err
+		}
+		if count >= maxCommandsPerGroup {
+			return nil, fmt.Errorf(errTooManyCommands)
+		}
+
+		command = &model.GroupCommand{
+			GroupID:     groupID,
+			Name:        req.Name,
+			Description: req.Description,
+			Usage:       req.Usage,
+			BotID:       botID,
+			CallbackURL: req.CallbackURL,
+		}
+		if err := ensureCommandCallbackSecret(command); err != nil {
+			return nil, err
+		}
+		if err := cq.WithContext(ctx).Create(command); err != nil {
+			return nil, err
+		}
+	}
+
+	infos, err := s.toCommandInfos(ctx, []*model.GroupCommand{command})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.RegisterCommandResponse{CommandInfo: *infos[0]}
+	if command.CallbackURL != "" {
+		response.CallbackSecret = command.CallbackSecret
+	}
+	return response, nil
+}
+
+// ensureCommandCallbackSecret 命令有回调地址但还没有签名密钥时生成密钥
+func ensureCommandCallbackSecret(command *model.GroupCommand) error {
+	if command.CallbackURL == "" || command.CallbackSecret != "" {
+		return nil
+	}
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return err
+	}
+	command.CallbackSecret = secret
+	return nil
+}
+
+// ListGroupCommands 获取群组中注册的命令（不含内置命令），仅群成员可查看
+func (s *CommandService) ListGroupCommands(ctx context.Context, userID string, groupID string) ([]*dto.CommandInfo, error) {
+	isMember, err := NewGroupService(s.db).IsGroupMember(ctx, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, fmt.Errorf(errNotInGroup)
+	}
+
+	cq := dao.Use(s.db).GroupCommand
+	commands, err := cq.WithContext(ctx).Where(cq.GroupID.Eq(groupID)).Order(cq.Name).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toCommandInfos(ctx, commands)
+}
+
+// GetGroupCommand 获取群组中注册的命令
+func (s *CommandService) GetGroupCommand(ctx context.Context, groupID string, name string) (*model.GroupCommand, error) {
+	cq := dao.Use(s.db).GroupCommand
+	command, err := cq.WithContext(ctx).Where(cq.GroupID.Eq(groupID), cq.Name.Eq(name)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(errCommandNotFound)
+		}
+		return nil, err
+	}
+
+	return command, nil
+}
+
+// DeleteGroupCommand 删除群组命令（群主或注册该命令的机器人）
+func (s *CommandService) DeleteGroupCommand(ctx context.Context, userID string, groupID string, name string) error {
+	command, err := s.GetGroupCommand(ctx, groupID, name)
+	if err != nil {
+		return err
+	}
+
+	if command.BotID != userID {
+		gq := dao.Use(s.db).Group
+		group, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).First()
+		if err != nil {
+			return fmt.Errorf(errGroupNotFound)
+		}
+		if group.OwnerID != userID {
+			return fmt.Errorf(errPermissionDenied)
+		}
+	}
+
+	cq := dao.Use(s.db).GroupCommand
+	_, err = cq.WithContext(ctx).Where(cq.ID.Eq(command.ID)).Delete()
+	return err
+}
+
+// InvokeCommandCallback 将命令调用发送到命令的回调地址，返回机器人的回复。
+// 请求与 Webhook 投递一样使用命令的密钥签名，机器人据此确认调用来自本服务
+func (s *CommandService) InvokeCommandCallback(ctx context.Context, command *model.GroupCommand, invocation dto.CommandInvocation) (*dto.CommandCallbackResponse, error) {
+	body, err := json.Marshal(invocation)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, command.CallbackURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	setSignatureHeaders(req, command.CallbackSecret, body)
+
+	resp, err := commandCallbackClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errCommandCallbackFailed, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("%s: status %d", errCommandCallbackFailed, resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxCommandCallbackBody))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errCommandCallbackFailed, err)
+	}
+
+	var callbackResp dto.CommandCallbackResponse
+	if len(bytes.TrimSpace(data)) == 0 {
+		return &callbackResp, nil
+	}
+	if err := json.Unmarshal(data, &callbackResp); err != nil {
+		return nil, fmt.Errorf("%s: %w", errCommandCallbackFailed, err)
+	}
+	if callbackResp.Visibility != CommandVisibilityGroup {
+		callbackResp.Visibility = CommandVisibilityEphemeral
+	}
+
+	return &callbackResp, nil
+}
+
+// SetGroupTopic 设置群组话题（需要修改群资料的权限），空字符串表示清除话题
+func (s *CommandService) SetGroupTopic(ctx context.Context, userID string, groupID string, topic string) error {
+	topic = strings.TrimSpace(topic)
+	if utf8.RuneCountInString(topic) > maxTopicLength {
+		return fmt.Errorf(errInvalidTopic)
+	}
+
+	if _, err := requireGroupPermission(ctx, s.db, userID, groupID, PermissionEditInfo); err != nil {
+		return err
+	}
+
+	gq := dao.Use(s.db).Group
+	_, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).Update(gq.Topic, topic)
+	return err
+}
+
+// GetGroupTopic 获取群组话题
+func (s *CommandService) GetGroupTopic(ctx context.Context, groupID string) (string, error) {
+	gq := dao.Use(s.db).Group
+	group, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).First()
+	if err != nil {
+		return "", fmt.Errorf(errGroupNotFound)
+	}
+
+	return group.Topic, nil
+}
+
+// CreateReminder 创建提醒，到期后推送给用户
+func (s *CommandService) CreateReminder(ctx context.Context, userID string, chatType model.MessageType, targetID string, text string, remindAt time.Time) (*dto.ReminderResponse, error) {
+	text = strings.TrimSpace(text)
+	if text == "" || utf8.RuneCountInString(text) > maxReminderTextLength {
+		return nil, fmt.Errorf(errInvalidReminderText)
+	}
+
+	reminder := &model.Reminder{
+		UserID:   userID,
+		ChatType: chatType,
+		TargetID: targetID,
+		Text:     text,
+		RemindAt: remindAt,
+	}
+	if err := dao.Use(s.db).Reminder.WithContext(ctx).Create(reminder); err != nil {
+		return nil, err
+	}
+
+	return toReminderResponse(reminder), nil
+}
+
+// FireDueReminders 将到期的提醒标记为已触发并返回，多实例部署时不会重复触发
+func (s *CommandService) FireDueReminders(ctx context.Context) ([]*dto.ReminderResponse, error) {
+	var reminders []*model.Reminder
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		rq := dao.Use(tx).Reminder
+		now := time.Now()
+
+		var err error
+		reminders, err = rq.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).Where(
+			rq.FiredAt.IsNull(),
+			rq.RemindAt.Lte(now),
+		).Order(rq.RemindAt).Limit(reminderBatchSize).Find()
+		if err != nil || len(reminders) == 0 {
+			return err
+		}
+
+		ids := make([]string, 0, len(reminders))
+		for _, reminder := range reminders {
+			ids = append(ids, reminder.ID)
+		}
+		_, err = rq.WithContext(ctx).Where(rq.ID.In(ids...)).Update(rq.FiredAt, now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.ReminderResponse, 0, len(reminders))
+	for _, reminder := range reminders {
+		result = append(result, toReminderResponse(reminder))
+	}
+
+	return result, nil
+}
+
+// GetUndeliveredReminders 获取已触发但用户离线未收到的提醒
+func (s *CommandService) GetUndeliveredReminders(ctx context.Context, userID string) ([]*dto.ReminderResponse, error) {
+	rq := dao.Use(s.db).Reminder
+	reminders, err := rq.WithContext(ctx).Where(
+		rq.UserID.Eq(userID),
+		rq.FiredAt.IsNotNull(),
+		rq.DeliveredAt.IsNull(),
+	).Order(rq.RemindAt).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.ReminderResponse, 0, len(reminders))
+	for _, reminder := range reminders {
+		result = append(result, toReminderResponse(reminder))
+	}
+
+	return result, nil
+}
+
+// MarkRemindersDelivered 标记提醒已推送
+func (s *CommandService) MarkRemindersDelivered(ctx context.Context, reminderIDs []string) error {
+	if len(reminderIDs) == 0 {
+		return nil
+	}
+
+	rq := dao.Use(s.db).Reminder
+	_, err := rq.WithContext(ctx).Where(rq.ID.In(reminderIDs...), rq.DeliveredAt.IsNull()).Update(rq.DeliveredAt, time.Now())
+	return err
+}
+
+// toCommandInfos 转换为命令信息，附带处理命令的机器人名称
+func (s *CommandService) toCommandInfos(ctx context.Context, commands []*model.GroupCommand) ([]*dto.CommandInfo, error) {
+	botIDs := make([]string, 0, len(commands))
+	for _, command := range commands {
+		botIDs = append(botIDs, command.BotID)
+	}
+
+	botNames := make(map[string]string, len(botIDs))
+	if len(botIDs) > 0 {
+		uq := dao.Use(s.db).User
+		bots, err := uq.WithContext(ctx).Where(uq.ID.In(botIDs...)).Find()
+		if err != nil {
+			return nil, err
+		}
+		for _, bot := range bots {
+			botNames[bot.ID] = bot.Username
+		}
+	}
+
+	result := make([]*dto.CommandInfo, 0, len(commands))
+	for _, command := range commands {
+		result = append(result, &dto.CommandInfo{
+			Name:        command.Name,
+			Description: command.Description,
+			Usage:       command.Usage,
+			BotID:       command.BotID,
+			BotName:     botNames[command.BotID],
+		})
+	}
+
+	return result, nil
+}
+
+func toReminderResponse(reminder *model.Reminder) *dto.ReminderResponse {
+	return &dto.ReminderResponse{
+		ReminderID: reminder.ID,
+		UserID:     reminder.UserID,
+		ChatType:   string(reminder.ChatType),
+		TargetID:   reminder.TargetID,
+		Text:       reminder.Text,
+		RemindAt:   reminder.RemindAt,
+		CreatedAt:  reminder.CreatedAt,
+	}
+}
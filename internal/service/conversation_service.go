@@ -0,0 +1,197 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	errInvalidConversationType = "invalid conversation type"
+	errInvalidMutedUntil       = "invalid muted_until"
+	errOnlyPrivateCanBeHidden  = "only private conversations can be hidden"
+)
+
+type ConversationService struct {
+	db *gorm.DB
+}
+
+func NewConversationService(db *gorm.DB) *ConversationService {
+	return &ConversationService{
+		db: db,
+	}
+}
+
+// UpdateConversationSetting 更新会话设置（置顶、归档、免打扰、隐藏）
+func (s *ConversationService) UpdateConversationSetting(ctx context.Context, userID string, chatType string, targetID string, req dto.UpdateConversationSettingRequest) (*dto.ConversationSettingResponse, error) {
+	switch model.MessageType(chatType) {
+	case model.MessageTypePrivate:
+		uq := dao.Use(s.db).User
+		if _, err := uq.WithContext(ctx).Where(uq.ID.Eq(targetID)).First(); err != nil {
+			return nil, fmt.Errorf(errUserNotFound)
+		}
+	case model.MessageTypeGroup:
+		isMember, err := NewGroupService(s.db).IsGroupMember(ctx, targetID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if !isMember {
+			return nil, fmt.Errorf(errNotInGroup)
+		}
+		if req.Hidden != nil && *req.Hidden {
+			return nil, fmt.Errorf(errOnlyPrivateCanBeHidden)
+		}
+	default:
+		return nil, fmt.Errorf(errInvalidConversationType)
+	}
+
+	q := dao.Use(s.db).ConversationSetting
+	do := q.WithContext(ctx)
+
+	setting, err := do.Where(
+		q.UserID.Eq(userID),
+		q.TargetID.Eq(targetID),
+		q.Type.Eq(chatType),
+	).First()
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		setting = &model.ConversationSetting{
+			UserID:   userID,
+			TargetID: targetID,
+			Type:     model.MessageType(chatType),
+		}
+	}
+
+	now := time.Now()
+	if req.Pinned != nil {
+		setting.IsPinned = *req.Pinned
+		setting.PinnedAt = nil
+		if *req.Pinned {
+			setting.PinnedAt = &now
+		}
+	}
+	if req.Archived != nil {
+		setting.IsArchived = *req.Archived
+	}
+	if req.MutedUntil != nil {
+		setting.MutedUntil = nil
+		if *req.MutedUntil != "" {
+			mutedUntil, err := time.Parse(time.RFC3339, *req.MutedUntil)
+			if err != nil {
+				return nil, fmt.Errorf(errInvalidMutedUntil)
+			}
+			setting.MutedUntil = &mutedUntil
+		}
+	}
+	if req.Hidden != nil {
+		setting.IsHidden = *req.Hidden
+		setting.HiddenAt = nil
+		if *req.Hidden {
+			setting.HiddenAt = &now
+		}
+	}
+
+	if err := do.Clauses(clause.OnConflict{UpdateAll: true}).Create(setting); err != nil {
+		return nil, err
+	}
+
+	return toConversationSettingResponse(setting), nil
+}
+
+// GetConversationSettings 获取用户的所有会话设置，key 为 会话类型:目标ID
+func (s *ConversationService) GetConversationSettings(ctx context.Context, userID string) (map[string]*model.ConversationSetting, error) {
+	q := dao.Use(s.db).ConversationSetting
+	do := q.WithContext(ctx)
+
+	settings, err := do.Where(q.UserID.Eq(userID)).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	settingMap := make(map[string]*model.ConversationSetting, len(settings))
+	for _, setting := range settings {
+		settingMap[conversationKey(setting.Type, setting.TargetID)] = setting
+	}
+
+	return settingMap, nil
+}
+
+func conversationKey(chatType model.MessageType, targetID string) string {
+	return string(chatType) + ":" + targetID
+}
+
+func toConversationSettingResponse(setting *model.ConversationSetting) *dto.ConversationSettingResponse {
+	return &dto.ConversationSettingResponse{
+		ChatType:   string(setting.Type),
+		TargetID:   setting.TargetID,
+		IsPinned:   setting.IsPinned,
+		IsArchived: setting.IsArchived,
+		IsMuted:    isMuted(setting),
+		MutedUntil: setting.MutedUntil,
+		IsHidden:   setting.IsHidden,
+	}
+}
+
+// isMuted 判断会话当前是否处于免打扰状态
+func isMuted(setting *model.ConversationSetting) bool {
+	return setting != nil && setting.MutedUntil != nil && setting.MutedUntil.After(time.Now())
+}
+
+// isHiddenConversation 判断私聊会话是否被隐藏：隐藏之后没有新消息时才保持隐藏
+func isHiddenConversation(setting *model.ConversationSetting, lastTime time.Time) bool {
+	return setting != nil && setting.IsHidden && setting.HiddenAt != nil && !lastTime.After(*setting.HiddenAt)
+}
+
+// conversationOrder 会话排序依据：置顶会话按置顶时间倒序排在最前，其余按最后消息时间倒序
+type conversationOrder struct {
+	pinned   bool
+	pinnedAt time.Time
+	lastTime time.Time
+}
+
+func newConversationOrder(setting *model.ConversationSetting, lastTime time.Time) conversationOrder {
+	order := conversationOrder{lastTime: lastTime}
+	if setting != nil && setting.IsPinned {
+		order.pinned = true
+		if setting.PinnedAt != nil {
+			order.pinnedAt = *setting.PinnedAt
+		}
+	}
+	return order
+}
+
+func (a conversationOrder) before(b conversationOrder) bool {
+	if a.pinned != b.pinned {
+		return a.pinned
+	}
+	if a.pinned && !a.pinnedAt.Equal(b.pinnedAt) {
+		return a.pinnedAt.After(b.pinnedAt)
+	}
+	return a.lastTime.After(b.lastTime)
+}
+
+func sortConversations[T any](conversations []T, orders []conversationOrder) {
+	indexes := make([]int, len(conversations))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return orders[indexes[i]].before(orders[indexes[j]])
+	})
+
+	sorted := make([]T, len(conversations))
+	for i, index := range indexes {
+		sorted[i] = conversations[index]
+	}
+	copy(conversations, sorted)
+}
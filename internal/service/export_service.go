@@ -0,0 +1,616 @@
+package service
+
+import (
+	"archive/zip"
+	"chat_backend/internal/config"
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"chat_backend/pkg/logger"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	ExportStatusPending   = "pending"
+	ExportStatusRunning   = "running"
+	ExportStatusCompleted = "completed"
+	ExportStatusFailed    = "failed"
+	ExportStatusExpired   = "expired"
+
+	ExportFormatJSON = "json"
+	ExportFormatHTML = "html"
+	ExportFormatText = "txt"
+
+	exportBatchSize = 500
+	exportDirName   = "exports"
+
+	exportPollInterval       = 5 * time.Second
+	exportClaimBatchSize     = 2                // 每个实例同时执行的导出任务数
+	exportJobLease           = 30 * time.Minute // 执行中的任务超过租期未完成时视为中断，重新排队
+	exportRetention          = 7 * 24 * time.Hour
+	maxActiveExportsPerUser  = 3
+	maxExportCleanupPerSweep = 100
+)
+
+const (
+	errInvalidExportFormat = "invalid export format"
+	errExportJobNotFound   = "export job not found"
+	errExportNotReady      = "export is not ready"
+	errNotFriends          = "you are not friends"
+	errTooManyExportJobs   = "too many export jobs in progress"
+	errExportExpired       = "export has expired"
+)
+
+type ExportService struct {
+	db *gorm.DB
+}
+
+func NewExportService(db *gorm.DB) *ExportService {
+	return &ExportService{
+		db: db,
+	}
+}
+
+// exportHeader 导出文件头部信息
+type exportHeader struct {
+	ChatType   string    `json:"chat_type"`
+	TargetID   string    `json:"target_id"`
+	TargetName string    `json:"target_name"`
+	ExportedAt time.Time `json:"exported_at"`
+}
+
+// CreateExportJob 创建聊天记录导出任务，任务由后台导出任务领取执行；
+// 每个用户最多同时有 3 个排队或执行中的任务
+func (s *ExportService) CreateExportJob(ctx context.Context, userID string, chatType string, targetID string, formats []string) (*dto.ExportJobResponse, error) {
+	if len(formats) == 0 {
+		formats = []string{ExportFormatJSON, ExportFormatHTML, ExportFormatText}
+	}
+	seen := make(map[string]bool, len(formats))
+	normalized := make([]string, 0, len(formats))
+	for _, format := range formats {
+		switch format {
+		case ExportFormatJSON, ExportFormatHTML, ExportFormatText:
+		default:
+			return nil, fmt.Errorf(errInvalidExportFormat)
+		}
+		if !seen[format] {
+			seen[format] = true
+			normalized = append(normalized, format)
+		}
+	}
+
+	// 创建任务前先校验一次权限，任务执行时还会再次校验
+	if _, _, err := s.resolveExportScope(ctx, userID, model.MessageType(chatType), targetID); err != nil {
+		return nil, err
+	}
+
+	q := dao.Use(s.db).ExportJob
+	do := q.WithContext(ctx)
+
+	active, err := do.Where(q.UserID.Eq(userID), q.Status.In(ExportStatusPending, ExportStatusRunning)).Count()
+	if err != nil {
+		return nil, err
+	}
+	if active >= maxActiveExportsPerUser {
+		return nil, fmt.Errorf(errTooManyExportJobs)
+	}
+
+	job := &model.ExportJob{
+		UserID:   userID,
+		Type:     model.MessageType(chatType),
+		TargetID: targetID,
+		Formats:  strings.Join(normalized, ","),
+		Status:   ExportStatusPending,
+	}
+	if err := do.Create(job); err != nil {
+		return nil, err
+	}
+
+	return toExportJobResponse(job), nil
+}
+
+// GetExportJob 获取导出任务状态
+func (s *ExportService) GetExportJob(ctx context.Context, userID string, jobID string) (*dto.ExportJobResponse, error) {
+	q := dao.Use(s.db).ExportJob
+	do := q.WithContext(ctx)
+
+	job, err := do.Where(q.ID.Eq(jobID), q.UserID.Eq(userID)).First()
+	if err != nil {
+		return nil, fmt.Errorf(errExportJobNotFound)
+	}
+
+	return toExportJobResponse(job), nil
+}
+
+// ListExportJobs 获取用户的导出任务列表
+func (s *ExportService) ListExportJobs(ctx context.Context, userID string) ([]*dto.ExportJobResponse, error) {
+	q := dao.Use(s.db).ExportJob
+	do := q.WithContext(ctx)
+
+	jobs, err := do.Where(q.UserID.Eq(userID)).Order(q.CreatedAt.Desc()).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.ExportJobResponse, 0, len(jobs))
+	for _, job := range jobs {
+		responses = append(responses, toExportJobResponse(job))
+	}
+
+	return responses, nil
+}
+
+// GetExportFile 获取已完成导出任务的压缩包路径和下载文件名
+func (s *ExportService) GetExportFile(ctx context.Context, userID string, jobID string) (string, string, error) {
+	q := dao.Use(s.db).ExportJob
+	do := q.WithContext(ctx)
+
+	job, err := do.Where(q.ID.Eq(jobID), q.UserID.Eq(userID)).First()
+	if err != nil {
+		return "", "", fmt.Errorf(errExportJobNotFound)
+	}
+
+	if job.Status == ExportStatusExpired {
+		return "", "", fmt.Errorf(errExportExpired)
+	}
+	if job.Status != ExportStatusCompleted || job.FilePath == "" {
+		return "", "", fmt.Errorf(errExportNotReady)
+	}
+
+	return job.FilePath, fmt.Sprintf("chat_export_%s.zip", job.ID), nil
+}
+
+// resolveExportScope 校验导出权限，返回可导出消息的起始时间和会话名称
+// 私聊需要双方是好友；群聊需要是群成员，群主可以导出全部历史，普通成员只能导出入群之后的消息
+func (s *ExportService) resolveExportScope(ctx context.Context, userID string, chatType model.MessageType, targetID string) (time.Time, string, error) {
+	switch chatType {
+	case model.MessageTypePrivate:
+		isFriend, err := NewUserService(s.db).IsFriend(ctx, userID, targetID)
+		if err != nil {
+			return time.Time{}, "", err
+		}
+		if !isFriend {
+			return time.Time{}, "", fmt.Errorf(errNotFriends)
+		}
+
+		username, err := NewUserService(s.db).GetUsernameByUserID(ctx, targetID)
+		if err != nil {
+			return time.Time{}, "", fmt.Errorf(errUserNotFound)
+		}
+
+		return time.Time{}, username, nil
+	case model.MessageTypeGroup:
+		isMember, err := NewGroupService(s.db).IsGroupMember(ctx, targetID, userID)
+		if err != nil {
+			return time.Time{}, "", err
+		}
+		if !isMember {
+			return time.Time{}, "", fmt.Errorf(errNotInGroup)
+		}
+
+		gq := dao.Use(s.db).Group
+		group, err := gq.WithContext(ctx).Where(gq.ID.Eq(targetID)).First()
+		if err != nil {
+			return time.Time{}, "", fmt.Errorf(errGroupNotFound)
+		}
+		if group.OwnerID == userID {
+			return time.Time{}, group.Name, nil
+		}
+
+		mq := dao.Use(s.db).GroupMember
+		member, err := mq.WithContext(ctx).Where(mq.GroupID.Eq(targetID), mq.UserID.Eq(userID)).First()
+		if err != nil {
+			return time.Time{}, "", fmt.Errorf(errNotInGroup)
+		}
+
+		return member.CreatedAt, group.Name, nil
+	}
+
+	return time.Time{}, "", fmt.Errorf(errInvalidConversationType)
+}
+
+// StartExportWorker 启动导出任务，定期领取排队中的任务执行并清理过期的导出文件，直到 ctx 取消。
+// 返回的通道在正在执行的任务结束、任务退出后关闭，用于关闭服务时等待任务放回队列
+func (s *ExportService) StartExportWorker(ctx context.Context) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(exportPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.processPendingJobs(ctx)
+				s.cleanupExpiredExports(ctx)
+			}
+		}
+	}()
+	return done
+}
+
+// requeueInterruptedJobs 将超过租期仍处于执行中的任务重新排队，通常是执行任务的实例在执行期间崩溃
+func (s *ExportService) requeueInterruptedJobs(ctx context.Context) error {
+	q := dao.Use(s.db).ExportJob
+	_, err := q.WithContext(ctx).Where(
+		q.Status.Eq(ExportStatusRunning),
+		q.StartedAt.Lt(time.Now().Add(-exportJobLease)),
+	).Update(q.Status, ExportStatusPending)
+	return err
+}
+
+// processPendingJobs 将租期已过的任务重新排队，再领取并执行一批排队中的任务，执行完成后才会领取下一批，限制同时执行的任务数。
+// 每次领取前都检查租期，其他实例中断的任务不需要等到服务重启才会重新执行
+func (s *ExportService) processPendingJobs(ctx context.Context) {
+	if err := s.requeueInterruptedJobs(ctx); err != nil {
+		logger.GetLogger().Errorw("Failed to requeue interrupted export jobs", "error", err)
+	}
+
+	jobs, err := s.claimPendingJobs(ctx)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to claim export jobs", "error", err)
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, job := range jobs {
+		wg.Add(1)
+		go func(job *model.ExportJob) {
+			defer wg.Done()
+			s.runExportJob(ctx, job)
+		}(job)
+	}
+	wg.Wait()
+}
+
+// claimPendingJobs 锁定排队中的任务并标记为执行中，多实例部署时不会重复领取
+func (s *ExportService) claimPendingJobs(ctx context.Context) ([]*model.ExportJob, error) {
+	var jobs []*model.ExportJob
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		q := dao.Use(tx).ExportJob
+
+		var err error
+		jobs, err = q.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where(q.Status.Eq(ExportStatusPending)).
+			Order(q.CreatedAt).
+			Limit(exportClaimBatchSize).
+			Find()
+		if err != nil || len(jobs) == 0 {
+			return err
+		}
+
+		ids := make([]string, 0, len(jobs))
+		for _, job := range jobs {
+			ids = append(ids, job.ID)
+		}
+		_, err = q.WithContext(ctx).Where(q.ID.In(ids...)).UpdateSimple(
+			q.Status.Value(ExportStatusRunning),
+			q.StartedAt.Value(time.Now()),
+		)
+		return err
+	})
+
+	return jobs, err
+}
+
+// runExportJob 执行已领取的导出任务并记录结果
+func (s *ExportService) runExportJob(ctx context.Context, job *model.ExportJob) {
+	q := dao.Use(s.db).ExportJob
+	do := q.WithContext(ctx)
+
+	filePath, err := s.buildExportArchive(ctx, job)
+	if err != nil && ctx.Err() != nil {
+		// 服务正在关闭，任务被中断，放回队列由下次启动或其他实例重新执行
+		if _, err := q.WithContext(context.WithoutCancel(ctx)).Where(q.ID.Eq(job.ID)).Update(q.Status, ExportStatusPending); err != nil {
+			logger.GetLogger().Errorw("Failed to requeue export job", "job_id", job.ID, "error", err)
+		}
+		logger.GetLogger().Infow("Export job interrupted by shutdown", "job_id", job.ID)
+		return
+	}
+	if err != nil {
+		logger.GetLogger().Errorw("Export job failed", "job_id", job.ID, "error", err)
+		if _, err := do.Where(q.ID.Eq(job.ID)).UpdateSimple(
+			q.Status.Value(ExportStatusFailed),
+			q.Error.Value(err.Error()),
+		); err != nil {
+			logger.GetLogger().Errorw("Failed to update export job status", "job_id", job.ID, "error", err)
+		}
+		return
+	}
+
+	now := time.Now()
+	if _, err := do.Where(q.ID.Eq(job.ID)).UpdateSimple(
+		q.Status.Value(ExportStatusCompleted),
+		q.FilePath.Value(filePath),
+		q.CompletedAt.Value(now),
+		q.ExpiresAt.Value(now.Add(exportRetention)),
+	); err != nil {
+		logger.GetLogger().Errorw("Failed to update export job status", "job_id", job.ID, "error", err)
+		return
+	}
+
+	logger.GetLogger().Infow("Export job completed", "job_id", job.ID, "file", filePath)
+}
+
+// cleanupExpiredExports 删除过期的导出文件，并将任务标记为已过期
+func (s *ExportService) cleanupExpiredExports(ctx context.Context) {
+	q := dao.Use(s.db).ExportJob
+	jobs, err := q.WithContext(ctx).Where(
+		q.Status.Eq(ExportStatusCompleted),
+		q.ExpiresAt.Lte(time.Now()),
+	).Limit(maxExportCleanupPerSweep).Find()
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to find expired export jobs", "error", err)
+		return
+	}
+
+	for _, job := range jobs {
+		if job.FilePath != "" {
+			if err := os.Remove(job.FilePath); err != nil && !os.IsNotExist(err) {
+				logger.GetLogger().Errorw("Failed to remove export file", "job_id", job.ID, "file", job.FilePath, "error", err)
+				continue
+			}
+		}
+		if _, err := q.WithContext(ctx).Where(q.ID.Eq(job.ID)).UpdateSimple(
+			q.Status.Value(ExportStatusExpired),
+			q.FilePath.Value(""),
+		); err != nil {
+			logger.GetLogger().Errorw("Failed to update export job status", "job_id", job.ID, "error", err)
+		}
+	}
+}
+
+// buildExportArchive 生成包含所选格式的压缩包，返回文件路径
+func (s *ExportService) buildExportArchive(ctx context.Context, job *model.ExportJob) (string, error) {
+	// 导出时再次校验权限，避免任务排队期间用户已退群或解除好友关系
+	since, targetName, err := s.resolveExportScope(ctx, job.UserID, job.Type, job.TargetID)
+	if err != nil {
+		return "", err
+	}
+
+	dir := filepath.Join(config.GetStorageDir(), exportDirName)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	filePath := filepath.Join(dir, job.ID+".zip")
+	file, err := os.Create(filePath)
+	if err != nil {
+		return "", err
+	}
+
+	header := exportHeader{
+		ChatType:   string(job.Type),
+		TargetID:   job.TargetID,
+		TargetName: targetName,
+		ExportedAt: time.Now(),
+	}
+	iterate := func(fn func(dto.ExportMessage) error) error {
+		return s.forEachExportMessage(ctx, job, since, fn)
+	}
+
+	zw := zip.NewWriter(file)
+	err = func() error {
+		for _, format := range strings.Split(job.Formats, ",") {
+			w, err := zw.Create("chat." + format)
+			if err != nil {
+				return err
+			}
+
+			switch format {
+			case ExportFormatJSON:
+				err = writeJSONExport(w, header, iterate)
+			case ExportFormatHTML:
+				err = writeHTMLExport(w, header, iterate)
+			case ExportFormatText:
+				err = writeTextExport(w, header, iterate)
+			default:
+				err = fmt.Errorf(errInvalidExportFormat)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return zw.Close()
+	}()
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(filePath)
+		return "", err
+	}
+
+	return filePath, nil
+}
+
+// forEachExportMessage 按时间顺序分批遍历可导出的消息
+func (s *ExportService) forEachExportMessage(ctx context.Context, job *model.ExportJob, since time.Time, fn func(dto.ExportMessage) error) error {
+	q := dao.Use(s.db).Message
+	uq := dao.Use(s.db).User
+
+	senderNames := make(map[string]string)
+	lastTime := since
+	lastID := ""
+
+	for {
+		do := q.WithContext(ctx)
+		query := do.Where(q.Type.Eq(string(job.Type)))
+		if job.Type == model.MessageTypePrivate {
+			query = query.Where(
+				do.Where(q.FromUserID.Eq(job.UserID), q.TargetID.Eq(job.TargetID)).
+					Or(q.FromUserID.Eq(job.TargetID), q.TargetID.Eq(job.UserID)),
+			)
+		} else {
+			query = query.Where(q.TargetID.Eq(job.TargetID))
+		}
+
+		// 使用 (created_at, id) 作为游标分页，避免大群导出时 OFFSET 越来越慢
+		if lastID == "" {
+			query = query.Where(q.CreatedAt.Gte(lastTime))
+		} else {
+			query = query.Where(
+				do.Where(q.CreatedAt.Gt(lastTime)).Or(q.CreatedAt.Eq(lastTime), q.ID.Gt(lastID)),
+			)
+		}
+
+		messages, err := query.Order(q.CreatedAt.Asc(), q.ID.Asc()).Limit(exportBatchSize).Find()
+		if err != nil {
+			return err
+		}
+
+		for _, msg := range messages {
+			senderName, ok := senderNames[msg.FromUserID]
+			if !ok {
+				senderName = errUnknownUser
+				if user, err := uq.WithContext(ctx).Where(uq.ID.Eq(msg.FromUserID)).First(); err == nil {
+					senderName = user.Username
+				}
+				senderNames[msg.FromUserID] = senderName
+			}
+
+			exportMsg := dto.ExportMessage{
+				MessageID:  msg.ID,
+				SenderID:   msg.FromUserID,
+				SenderName: senderName,
+				Kind:       string(msg.Kind),
+				Content:    msg.Content,
+				CreatedAt:  msg.CreatedAt,
+			}
+			if msg.Kind == model.MessageKindImage || msg.Kind == model.MessageKindFile {
+				exportMsg.Attachment = msg.Content
+			}
+
+			if err := fn(exportMsg); err != nil {
+				return err
+			}
+		}
+
+		if len(messages) < exportBatchSize {
+			return nil
+		}
+		lastTime = messages[len(messages)-1].CreatedAt
+		lastID = messages[len(messages)-1].ID
+	}
+}
+
+// writeJSONExport 以JSON格式流式写出聊天记录
+func writeJSONExport(w io.Writer, header exportHeader, iterate func(func(dto.ExportMessage) error) error) error {
+	headerData, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+	// 去掉头部对象的结尾 '}'，在同一个对象中追加 messages 数组
+	if _, err := w.Write(headerData[:len(headerData)-1]); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, `,"messages":[`); err != nil {
+		return err
+	}
+
+	first := true
+	err = iterate(func(msg dto.ExportMessage) error {
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+
+		data, err := json.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "]}")
+	return err
+}
+
+// writeHTMLExport 以HTML格式流式写出聊天记录
+func writeHTMLExport(w io.Writer, header exportHeader, iterate func(func(dto.ExportMessage) error) error) error {
+	title := html.EscapeString(header.TargetName)
+	if _, err := fmt.Fprintf(w, `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>%s</title>
+<style>
+body { font-family: sans-serif; margin: 24px; }
+.message { margin: 6px 0; }
+.time { color: #888; }
+.sender { font-weight: bold; }
+</style>
+</head>
+<body>
+<h1>%s</h1>
+<p>导出时间：%s</p>
+`, title, title, header.ExportedAt.Format(time.RFC3339)); err != nil {
+		return err
+	}
+
+	err := iterate(func(msg dto.ExportMessage) error {
+		content := html.EscapeString(msg.Content)
+		if msg.Attachment != "" && (strings.HasPrefix(msg.Attachment, "http://") || strings.HasPrefix(msg.Attachment, "https://")) {
+			content = fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(msg.Attachment), content)
+		}
+		_, err := fmt.Fprintf(w, `<div class="message"><span class="time">[%s]</span> <span class="sender">%s</span>: <span class="content">%s</span></div>
+`, msg.CreatedAt.Format("2006-01-02 15:04:05"), html.EscapeString(msg.SenderName), content)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "</body>\n</html>\n")
+	return err
+}
+
+// writeTextExport 以纯文本格式流式写出聊天记录
+func writeTextExport(w io.Writer, header exportHeader, iterate func(func(dto.ExportMessage) error) error) error {
+	if _, err := fmt.Fprintf(w, "%s\n导出时间：%s\n\n", header.TargetName, header.ExportedAt.Format(time.RFC3339)); err != nil {
+		return err
+	}
+
+	return iterate(func(msg dto.ExportMessage) error {
+		line := fmt.Sprintf("[%s] %s: %s", msg.CreatedAt.Format("2006-01-02 15:04:05"), msg.SenderName, msg.Content)
+		if msg.Attachment != "" {
+			line += fmt.Sprintf(" [附件: %s]", msg.Attachment)
+		}
+		_, err := io.WriteString(w, line+"\n")
+		return err
+	})
+}
+
+func toExportJobResponse(job *model.ExportJob) *dto.ExportJobResponse {
+	return &dto.ExportJobResponse{
+		JobID:       job.ID,
+		ChatType:    string(job.Type),
+		TargetID:    job.TargetID,
+		Formats:     strings.Split(job.Formats, ","),
+		Status:      job.Status,
+		Error:       job.Error,
+		CreatedAt:   job.CreatedAt,
+		CompletedAt: job.CompletedAt,
+		ExpiresAt:   job.ExpiresAt,
+	}
+}
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// GroupVisibilityPublic 公开群组，可以被搜索、出现在群组目录中，任何人都可以预览
+	GroupVisibilityPublic = "public"
+	// GroupVisibilityLink 不会被搜索到，知道群组 ID 或邀请链接的用户可以预览
+	GroupVisibilityLink = "link"
+	// GroupVisibilityPrivate 私有群组，非成员无法搜索和预览，只能通过邀请链接加入
+	GroupVisibilityPrivate = "private"
+
+	// JoinPolicyOpen 无需审批，可以直接加入
+	JoinPolicyOpen = "open"
+	// JoinPolicyApproval 提交入群申请，审批通过后加入
+	JoinPolicyApproval = "approval"
+	// JoinPolicyInviteOnly 只能通过邀请链接或由成员直接添加
+	JoinPolicyInviteOnly = "invite_only"
+)
+
+const (
+	maxDirectoryLimit       = 50
+	directoryActivityWindow = 7 * 24 * time.Hour
+	maxGroupCapacity        = 100000
+)
+
+const (
+	errInvalidGroupVisibility = "invalid group visibility"
+	errInvalidJoinPolicy      = "invalid join policy"
+	errGroupInviteOnly        = "group is invite only"
+	errGroupNotOpen           = "group requires approval to join"
+	errInvalidMaxMembers      = "invalid max members"
+	errGroupFull              = "group is full"
+)
+
+// ValidGroupVisibility 判断是否为有效的群组可见性
+func ValidGroupVisibility(visibility string) bool {
+	return visibility == GroupVisibilityPublic || visibility == GroupVisibilityLink || visibility == GroupVisibilityPrivate
+}
+
+// ValidJoinPolicy 判断是否为有效的加入方式
+func ValidJoinPolicy(policy string) bool {
+	return policy == JoinPolicyOpen || policy == JoinPolicyApproval || policy == JoinPolicyInviteOnly
+}
+
+// UpdateGroupAccess 修改群组可见性、加入方式和成员数量上限（需要修改群资料的权限），
+// 成员数量上限不能低于当前的成员数
+func (s *GroupService) UpdateGroupAccess(ctx context.Context, userID string, groupID string, req dto.UpdateGroupAccessRequest) (*dto.GroupProfileEvent, error) {
+	if req.Visibility != "" && !ValidGroupVisibility(req.Visibility) {
+		return nil, fmt.Errorf(errInvalidGroupVisibility)
+	}
+	if req.JoinPolicy != "" && !ValidJoinPolicy(req.JoinPolicy) {
+		return nil, fmt.Errorf(errInvalidJoinPolicy)
+	}
+	if req.MaxMembers != nil && (*req.MaxMembers < 0 || *req.MaxMembers > maxGroupCapacity) {
+		return nil, fmt.Errorf(errInvalidMaxMembers)
+	}
+
+	if _, err := requireGroupPermission(ctx, s.db, userID, groupID, PermissionEditInfo); err != nil {
+		return nil, err
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		gq := dao.Use(tx).Group
+		gdo := gq.WithContext(ctx).Where(gq.ID.Eq(groupID))
+		if req.Visibility != "" {
+			if _, err := gdo.Update(gq.Visibility, req.Visibility); err != nil {
+				return err
+			}
+		}
+		if req.JoinPolicy != "" {
+			if _, err := gdo.Update(gq.JoinPolicy, req.JoinPolicy); err != nil {
+				return err
+			}
+		}
+		if req.MaxMembers != nil {
+			// 与成员数的增加互斥，避免上限低于并发加入后的成员数
+			group, err := gq.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where(gq.ID.Eq(groupID)).First()
+			if err != nil {
+				return fmt.Errorf(errGroupNotFound)
+			}
+			if *req.MaxMembers > 0 && *req.MaxMembers < group.MemberCount {
+				return fmt.Errorf(errInvalidMaxMembers)
+			}
+			if _, err := gdo.Update(gq.MaxMembers, *req.MaxMembers); err != nil {
+				return err
+			}
+		}
+
+		return recordGroupAudit(ctx, tx, groupID, userID, GroupAuditAccessUpdated, "", req)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getGroupProfileEvent(ctx, userID, groupID)
+}
+
+// GetGroupPreview 获取群组的公开信息，供非成员决定是否加入；私有群组只对成员可见
+func (s *GroupService) GetGroupPreview(ctx context.Context, userID string, groupID string) (*dto.SearchGroupResponse, error) {
+	gq := dao.Use(s.db).Group
+	group, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).First()
+	if err != nil {
+		return nil, fmt.Errorf(errGroupNotFound)
+	}
+
+	if group.Visibility == GroupVisibilityPrivate {
+		role, err := getGroupMemberRole(ctx, s.db, groupID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if role == "" {
+			return nil, fmt.Errorf(errGroupNotFound)
+		}
+	}
+
+	tags, err := loadGroupTags(ctx, s.db, []string{groupID})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toSearchGroupResponse(group, tags[groupID]), nil
+}
+
+// GetGroupDirectory 分页获取公开群组目录，按最近 7 天的消息数、成员数和创建时间排序。
+// 排名会随活跃度变化，游标为已返回的数量
+func (s *GroupService) GetGroupDirectory(ctx context.Context, tag string, limit int, cursor string) (*dto.GroupDirectoryResponse, error) {
+	if limit <= 0 || limit > maxDirectoryLimit {
+		limit = 20
+	}
+	offset := 0
+	if cursor != "" {
+		if n, err := strconv.Atoi(cursor); err == nil && n > 0 {
+			offset = n
+		}
+	}
+
+	type directoryRow struct {
+		ID             string
+		RecentMessages int64
+	}
+
+	query := `
+		SELECT g.id, COALESCE(a.recent_messages, 0) AS recent_messages
+		FROM groups g
+		LEFT JOIN (
+			SELECT target_id, COUNT(*) AS recent_messages
+			FROM messages
+			WHERE type = ? AND created_at >= ?
+			GROUP BY target_id
+		) a ON a.target_id = g.id
+		WHERE g.deleted_at IS NULL AND g.visibility = ?`
+	args := []interface{}{string(model.MessageTypeGroup), time.Now().Add(-directoryActivityWindow), GroupVisibilityPublic}
+	if tag != "" {
+		query += ` AND g.id IN (SELECT group_id FROM group_tags WHERE tag = ?)`
+		args = append(args, NormalizeGroupTag(tag))
+	}
+	// 多取一条用于判断是否还有下一页
+	query += `
+		ORDER BY recent_messages DESC, g.member_count DESC, g.created_at DESC, g.id
+		LIMIT ? OFFSET ?`
+	args = append(args, limit+1, offset)
+
+	var rows []directoryRow
+	if err := s.db.WithContext(ctx).Raw(query, args...).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	hasMore := len(rows) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+
+	groupIDs := make([]string, 0, len(rows))
+	for _, row := range rows {
+		groupIDs = append(groupIDs, row.ID)
+	}
+
+	groupsByID := make(map[string]*model.Group, len(rows))
+	if len(groupIDs) > 0 {
+		gq := dao.Use(s.db).Group
+		groups, err := gq.WithContext(ctx).Where(gq.ID.In(groupIDs...)).Find()
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range groups {
+			groupsByID[group.ID] = group
+		}
+	}
+
+	tags, err := loadGroupTags(ctx, s.db, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.GroupDirectoryResponse{
+		Groups:  make([]dto.GroupDirectoryItem, 0, len(rows)),
+		HasMore: hasMore,
+	}
+	for _, row := range rows {
+		group, ok := groupsByID[row.ID]
+		if !ok {
+			continue
+		}
+		result.Groups = append(result.Groups, dto.GroupDirectoryItem{
+			SearchGroupResponse: *s.toSearchGroupResponse(group, tags[row.ID]),
+			RecentMessages:      row.RecentMessages,
+		})
+	}
+	if hasMore {
+		result.NextCursor = strconv.Itoa(offset + len(rows))
+	}
+
+	return result, nil
+}
+
+// incrementGroupMemberCount 在事务中将群组成员数加一，成员数已达到上限时返回错误。
+// 条件更新保证并发加入时成员数不会超过上限
+func incrementGroupMemberCount(ctx context.Context, tx *gorm.DB, groupID string) error {
+	gq := dao.Use(tx).Group
+	result, err := gq.WithContext(ctx).Where(
+		gq.ID.Eq(groupID),
+		gq.WithContext(ctx).Where(gq.MaxMembers.Eq(0)).Or(gq.MemberCount.LtCol(gq.MaxMembers)),
+	).UpdateSimple(gq.MemberCount.Add(1))
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf(errGroupFull)
+	}
+	return nil
+}
+
+// decrementGroupMemberCount 在事务中将群组成员数减一，成员删除后调用
+func decrementGroupMemberCount(ctx context.Context, tx *gorm.DB, groupID string) error {
+	gq := dao.Use(tx).Group
+	_, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).UpdateSimple(gq.MemberCount.Sub(1))
+	return err
+}
+
+// checkJoinPolicy 检查用户能否不通过邀请链接加入群组：私有或仅限邀请的群组不能申请，
+// direct 为 true 时表示直接加入，只有开放加入的群组允许
+func checkJoinPolicy(group *model.Group, direct bool) error {
+	if group.Visibility == GroupVisibilityPrivate || group.JoinPolicy == JoinPolicyInviteOnly {
+		return fmt.Errorf(errGroupInviteOnly)
+	}
+	if direct && group.JoinPolicy != JoinPolicyOpen {
+		return fmt.Errorf(errGroupNotOpen)
+	}
+	return nil
+}
+
+func (s *GroupService) toSearchGroupResponse(group *model.Group, tags []string) *dto.SearchGroupResponse {
+	return &dto.SearchGroupResponse{
+		GroupID:     group.ID,
+		Name:        group.Name,
+		OwnerID:     group.OwnerID,
+		MemberCount: group.MemberCount,
+		MaxMembers:  group.MaxMembers,
+		Description: group.Description,
+		Avatar:      groupAvatarURL(s.db, group),
+		Tags:        tags,
+		Visibility:  group.Visibility,
+		JoinPolicy:  group.JoinPolicy,
+		Channel:     group.IsChannel,
+		Forum:       group.IsForum,
+		CreatedAt:   group.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+func TestIncrementGroupMemberCountAtMaxMembers(t *testing.T) {
+	f := newGroupFixture(t, 2)
+	ctx := context.Background()
+
+	err := f.db.Transaction(func(tx *gorm.DB) error {
+		return incrementGroupMemberCount(ctx, tx, f.group.ID)
+	})
+	if err != nil {
+		t.Fatalf("increment below limit: %v", err)
+	}
+
+	err = f.db.Transaction(func(tx *gorm.DB) error {
+		return incrementGroupMemberCount(ctx, tx, f.group.ID)
+	})
+	if err == nil || err.Error() != errGroupFull {
+		t.Fatalf("increment at limit: got %v, want %q", err, errGroupFull)
+	}
+
+	if got := getTestGroup(t, f.db, f.group.ID); got.MemberCount != 2 {
+		t.Errorf("member count = %d, want 2", got.MemberCount)
+	}
+}
+
+func TestIncrementGroupMemberCountUnlimited(t *testing.T) {
+	f := newGroupFixture(t, 0)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		err := f.db.Transaction(func(tx *gorm.DB) error {
+			return incrementGroupMemberCount(ctx, tx, f.group.ID)
+		})
+		if err != nil {
+			t.Fatalf("increment %d: %v", i, err)
+		}
+	}
+
+	if got := getTestGroup(t, f.db, f.group.ID); got.MemberCount != 4 {
+		t.Errorf("member count = %d, want 4", got.MemberCount)
+	}
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 审计日志记录的管理操作
+const (
+	GroupAuditGroupCreated         = "group_created"
+	GroupAuditGroupDisbanded       = "group_disbanded"
+	GroupAuditOwnershipTransferred = "ownership_transferred"
+	GroupAuditMemberJoined         = "member_joined"
+	GroupAuditMemberLeft           = "member_left"
+	GroupAuditMemberRemoved        = "member_removed"
+	GroupAuditJoinApproved         = "join_request_approved"
+	GroupAuditJoinRejected         = "join_request_rejected"
+	GroupAuditJoinAutoApproved     = "join_request_auto_approved"
+	GroupAuditJoinRulesUpdated     = "join_rules_updated"
+	GroupAuditRoleChanged          = "member_role_changed"
+	GroupAuditNicknameChanged      = "member_nickname_changed"
+	GroupAuditMemberMuted          = "member_muted"
+	GroupAuditMemberUnmuted        = "member_unmuted"
+	GroupAuditMemberBanned         = "member_banned"
+	GroupAuditMemberUnbanned       = "member_unbanned"
+	GroupAuditPermissionsUpdated   = "permissions_updated"
+	GroupAuditAccessUpdated        = "access_updated"
+	GroupAuditProfileUpdated       = "profile_updated"
+	GroupAuditAnnouncementUpdated  = "announcement_updated"
+	GroupAuditModerationUpdated    = "moderation_updated"
+	GroupAuditForumUpdated         = "forum_updated"
+	GroupAuditInviteCreated        = "invite_created"
+	GroupAuditInviteRevoked        = "invite_revoked"
+
+	maxAuditLogListLimit = 100
+)
+
+const (
+	errInvalidAuditCursor = "invalid audit log cursor"
+)
+
+// ListGroupAuditLogs 分页获取群组的审计日志（管理员及以上），按时间倒序排列，
+// 可以按操作类型、操作者和被操作的用户筛选。游标为上一页最后一条日志的时间和 ID
+func (s *GroupService) ListGroupAuditLogs(ctx context.Context, userID string, groupID string, filter dto.GroupAuditLogFilter, limit int, cursor string) (*dto.GroupAuditLogListResponse, error) {
+	if limit <= 0 || limit > maxAuditLogListLimit {
+		limit = 20
+	}
+
+	role, err := getGroupMemberRole(ctx, s.db, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if role == "" {
+		return nil, fmt.Errorf(errNotInGroup)
+	}
+	if roleRank(role) < roleRank(RoleAdmin) {
+		return nil, fmt.Errorf(errPermissionDenied)
+	}
+
+	aq := dao.Use(s.db).GroupAuditLog
+	query := aq.WithContext(ctx).Where(aq.GroupID.Eq(groupID))
+	if filter.Action != "" {
+		query = query.Where(aq.Action.Eq(filter.Action))
+	}
+	if filter.ActorID != "" {
+		query = query.Where(aq.ActorID.Eq(filter.ActorID))
+	}
+	if filter.TargetID != "" {
+		query = query.Where(aq.TargetID.Eq(filter.TargetID))
+	}
+	if cursor != "" {
+		createdAt, lastID, ok := parseTimeIDCursor(cursor)
+		if !ok {
+			return nil, fmt.Errorf(errInvalidAuditCursor)
+		}
+		query = query.Where(aq.WithContext(ctx).Where(aq.CreatedAt.Lt(createdAt)).Or(aq.CreatedAt.Eq(createdAt), aq.ID.Lt(lastID)))
+	}
+
+	// 多取一条用于判断是否还有下一页
+	logs, err := query.Order(aq.CreatedAt.Desc(), aq.ID.Desc()).Limit(limit + 1).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.GroupAuditLogListResponse{
+		Logs:    make([]dto.GroupAuditLogResponse, 0, len(logs)),
+		HasMore: len(logs) > limit,
+	}
+	if result.HasMore {
+		logs = logs[:limit]
+	}
+
+	userIDs := make([]string, 0, len(logs)*2)
+	for _, log := range logs {
+		userIDs = append(userIDs, log.ActorID)
+		if log.TargetID != nil {
+			userIDs = append(userIDs, *log.TargetID)
+		}
+	}
+	usernames, err := loadUsernames(ctx, s.db, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, log := range logs {
+		result.Logs = append(result.Logs, toGroupAuditLogResponse(log, usernames))
+	}
+	if result.HasMore {
+		last := logs[len(logs)-1]
+		result.NextCursor = last.CreatedAt.Format(time.RFC3339Nano) + "," + last.ID
+	}
+
+	return result, nil
+}
+
+// recordGroupAudit 在事务中追加一条审计日志，操作对象不是用户时 targetID 为空，payload 为 nil 时不记录详细内容
+func recordGroupAudit(ctx context.Context, tx *gorm.DB, groupID string, actorID string, action string, targetID string, payload interface{}) error {
+	log := &model.GroupAuditLog{
+		GroupID: groupID,
+		ActorID: actorID,
+		Action:  action,
+	}
+	if targetID != "" {
+		log.TargetID = &targetID
+	}
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		log.Payload = string(data)
+	}
+
+	return dao.Use(tx).GroupAuditLog.WithContext(ctx).Create(log)
+}
+
+// loadUsernames 批量获取用户名，包括已删除的用户
+func loadUsernames(ctx context.Context, db *gorm.DB, userIDs []string) (map[string]string, error) {
+	usernames := make(map[string]string)
+	if len(userIDs) == 0 {
+		return usernames, nil
+	}
+
+	uq := dao.Use(db).User
+	users, err := uq.WithContext(ctx).Unscoped().Select(uq.ID, uq.Username).Where(uq.ID.In(userIDs...)).Find()
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		usernames[user.ID] = user.Username
+	}
+	return usernames, nil
+}
+
+func toGroupAuditLogResponse(log *model.GroupAuditLog, usernames map[string]string) dto.GroupAuditLogResponse {
+	resp := dto.GroupAuditLogResponse{
+		LogID:         log.ID,
+		GroupID:       log.GroupID,
+		ActorID:       log.ActorID,
+		ActorUsername: usernames[log.ActorID],
+		Action:        log.Action,
+		CreatedAt:     log.CreatedAt,
+	}
+	if log.TargetID != nil {
+		resp.TargetID = *log.TargetID
+		resp.TargetUsername = usernames[*log.TargetID]
+	}
+	if log.Payload != "" {
+		resp.Payload = json.RawMessage(log.Payload)
+	}
+	return resp
+}
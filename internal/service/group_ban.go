@@ -0,0 +1,193 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	maxBanReasonLength = 200
+	maxBanDuration     = 365 * 24 * time.Hour
+)
+
+const (
+	errBannedFromGroup   = "banned from group"
+	errBanNotFound       = "ban not found"
+	errCannotBanYourself = "cannot ban yourself"
+	errInvalidBanRequest = "invalid ban request"
+)
+
+// BanMember 封禁用户，用户在群组中时同时将其移出，并拒绝其待审核的入群申请。
+// 需要移除成员的权限，封禁群成员时只能封禁角色低于自己的成员；重复封禁会覆盖原来的原因和期限
+func (s *GroupService) BanMember(ctx context.Context, userID string, groupID string, req dto.BanMemberRequest) (*dto.GroupBanResponse, error) {
+	reason := strings.TrimSpace(req.Reason)
+	duration := time.Duration(req.DurationSeconds) * time.Second
+	if req.UserID == "" || utf8.RuneCountInString(reason) > maxBanReasonLength || duration < 0 || duration > maxBanDuration {
+		return nil, fmt.Errorf(errInvalidBanRequest)
+	}
+	if req.UserID == userID {
+		return nil, fmt.Errorf(errCannotBanYourself)
+	}
+
+	uq := dao.Use(s.db).User
+	if _, err := uq.WithContext(ctx).Where(uq.ID.Eq(req.UserID)).First(); err != nil {
+		return nil, fmt.Errorf(errUserNotFound)
+	}
+
+	ban := &model.GroupBan{
+		GroupID:    groupID,
+		UserID:     req.UserID,
+		OperatorID: userID,
+		Reason:     reason,
+		CreatedAt:  time.Now(),
+	}
+	if duration > 0 {
+		expiresAt := ban.CreatedAt.Add(duration)
+		ban.ExpiresAt = &expiresAt
+	}
+
+	var memberEvent *dto.GroupMemberEvent
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := requireGroupPermission(ctx, tx, userID, groupID, PermissionRemoveMember); err != nil {
+			return err
+		}
+
+		// 先拒绝待审核的入群申请并锁定申请记录：正在审批的申请提交后再读取成员关系，
+		// 之后的审批不会再找到待处理的申请
+		rq := dao.Use(tx).GroupJoinRequest
+		_, err := rq.WithContext(ctx).Where(
+			rq.TargetGroupID.Eq(groupID),
+			rq.SenderID.Eq(req.UserID),
+			rq.Status.Eq(StatusPending),
+		).Updates(map[string]interface{}{
+			"status":          StatusRejected,
+			"decided_by":      userID,
+			"decision_reason": ban.Reason,
+			"decided_at":      time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		targetRole, err := getGroupMemberRole(ctx, tx, groupID, req.UserID)
+		if err != nil {
+			return err
+		}
+		if targetRole != "" {
+			if err := checkCanRemoveRole(ctx, tx, groupID, userID, targetRole); err != nil {
+				return err
+			}
+			memberEvent, err = removeGroupMember(ctx, tx, groupID, req.UserID, userID)
+			if err != nil {
+				return err
+			}
+		}
+
+		bq := dao.Use(tx).GroupBan
+		err = bq.WithContext(ctx).Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "group_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"operator_id", "reason", "expires_at", "created_at"}),
+		}).Create(ban)
+		if err != nil {
+			return err
+		}
+
+		return recordGroupAudit(ctx, tx, groupID, userID, GroupAuditMemberBanned, req.UserID, map[string]interface{}{
+			"reason":     ban.Reason,
+			"expires_at": ban.ExpiresAt,
+			"removed":    memberEvent != nil,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := s.toGroupBanResponse(ctx, ban)
+	resp.Removed = memberEvent != nil
+	resp.MemberEvent = memberEvent
+	return resp, nil
+}
+
+// ListBans 获取群组当前生效的封禁列表（需要移除成员的权限）
+func (s *GroupService) ListBans(ctx context.Context, userID string, groupID string) ([]*dto.GroupBanResponse, error) {
+	if _, err := requireGroupPermission(ctx, s.db, userID, groupID, PermissionRemoveMember); err != nil {
+		return nil, err
+	}
+
+	bq := dao.Use(s.db).GroupBan
+	bans, err := bq.WithContext(ctx).
+		Where(bq.GroupID.Eq(groupID)).
+		Where(bq.WithContext(ctx).Where(bq.ExpiresAt.IsNull()).Or(bq.ExpiresAt.Gt(time.Now()))).
+		Order(bq.CreatedAt.Desc()).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.GroupBanResponse, 0, len(bans))
+	for _, ban := range bans {
+		result = append(result, s.toGroupBanResponse(ctx, ban))
+	}
+
+	return result, nil
+}
+
+// UnbanMember 解除封禁（需要移除成员的权限），解除后用户可以重新申请加入
+func (s *GroupService) UnbanMember(ctx context.Context, userID string, groupID string, targetUserID string) error {
+	if _, err := requireGroupPermission(ctx, s.db, userID, groupID, PermissionRemoveMember); err != nil {
+		return err
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		bq := dao.Use(tx).GroupBan
+		info, err := bq.WithContext(ctx).Where(bq.GroupID.Eq(groupID), bq.UserID.Eq(targetUserID)).Delete()
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return fmt.Errorf(errBanNotFound)
+		}
+
+		return recordGroupAudit(ctx, tx, groupID, userID, GroupAuditMemberUnbanned, targetUserID, nil)
+	})
+}
+
+// checkGroupBan 用户在群组中有生效的封禁时返回错误，过期的封禁视为已解除
+func checkGroupBan(ctx context.Context, db *gorm.DB, groupID string, userID string) error {
+	bq := dao.Use(db).GroupBan
+	ban, err := bq.WithContext(ctx).Where(bq.GroupID.Eq(groupID), bq.UserID.Eq(userID)).Find()
+	if err != nil {
+		return err
+	}
+	if len(ban) > 0 && (ban[0].ExpiresAt == nil || ban[0].ExpiresAt.After(time.Now())) {
+		return fmt.Errorf(errBannedFromGroup)
+	}
+	return nil
+}
+
+func (s *GroupService) toGroupBanResponse(ctx context.Context, ban *model.GroupBan) *dto.GroupBanResponse {
+	response := &dto.GroupBanResponse{
+		GroupID:    ban.GroupID,
+		UserID:     ban.UserID,
+		OperatorID: ban.OperatorID,
+		Reason:     ban.Reason,
+		ExpiresAt:  ban.ExpiresAt,
+		CreatedAt:  ban.CreatedAt,
+	}
+
+	uq := dao.Use(s.db).User
+	if user, err := uq.WithContext(ctx).Unscoped().Where(uq.ID.Eq(ban.UserID)).First(); err == nil {
+		response.Username = user.Username
+	}
+
+	return response
+}
@@ -0,0 +1,334 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	inviteCodeBytes          = 9
+	maxActiveInvitesPerGroup = 20
+	maxInviteLinkUses        = 100000
+	maxInviteLinkDuration    = 365 * 24 * time.Hour
+)
+
+const (
+	errInviteLinkNotFound      = "invite link not found"
+	errInviteCodeExpired       = "invite code expired"
+	errInviteCodeUsedUp        = "invite code usage limit reached"
+	errInviteCodeRevoked       = "invite code revoked"
+	errInvalidInviteLinkParams = "invalid invite link settings"
+	errTooManyInviteLinks      = "too many invite links"
+)
+
+// CreateInviteLink 创建群组邀请链接（需要邀请成员的权限），可以限制使用次数、有效期以及是否需要审批
+func (s *GroupService) CreateInviteLink(ctx context.Context, userID string, groupID string, req dto.CreateInviteLinkRequest) (*dto.InviteLinkResponse, error) {
+	duration := time.Duration(req.ExpiresInSeconds) * time.Second
+	if req.MaxUses < 0 || req.MaxUses > maxInviteLinkUses || duration < 0 || duration > maxInviteLinkDuration {
+		return nil, fmt.Errorf(errInvalidInviteLinkParams)
+	}
+
+	group, err := requireGroupPermission(ctx, s.db, userID, groupID, PermissionInvite)
+	if err != nil {
+		return nil, err
+	}
+
+	icq := dao.Use(s.db).InvitationCode
+	count, err := icq.WithContext(ctx).Where(icq.UUID.Eq(groupID), icq.RevokedAt.IsNull()).Count()
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxActiveInvitesPerGroup {
+		return nil, fmt.Errorf(errTooManyInviteLinks)
+	}
+
+	code, err := generateInviteCode()
+	if err != nil {
+		return nil, err
+	}
+
+	invite := &model.InvitationCode{
+		UUID:             groupID,
+		Name:             group.Name,
+		Code:             code,
+		CreatorID:        &userID,
+		MaxUses:          req.MaxUses,
+		RequiresApproval: req.RequiresApproval,
+	}
+	if duration > 0 {
+		expiresAt := time.Now().Add(duration)
+		invite.ExpiresAt = &expiresAt
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := dao.Use(tx).InvitationCode.WithContext(ctx).Create(invite); err != nil {
+			return err
+		}
+
+		return recordGroupAudit(ctx, tx, groupID, userID, GroupAuditInviteCreated, "", map[string]interface{}{
+			"code":              invite.Code,
+			"max_uses":          invite.MaxUses,
+			"expires_at":        invite.ExpiresAt,
+			"requires_approval": invite.RequiresApproval,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toInviteLinkResponse(invite), nil
+}
+
+// ListInviteLinks 获取群组的邀请链接，包括已撤销和已失效的链接（需要邀请成员的权限）
+func (s *GroupService) ListInviteLinks(ctx context.Context, userID string, groupID string) ([]*dto.InviteLinkResponse, error) {
+	if _, err := requireGroupPermission(ctx, s.db, userID, groupID, PermissionInvite); err != nil {
+		return nil, err
+	}
+
+	icq := dao.Use(s.db).InvitationCode
+	invites, err := icq.WithContext(ctx).Where(icq.UUID.Eq(groupID)).Order(icq.CreatedAt.Desc()).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.InviteLinkResponse, 0, len(invites))
+	for _, invite := range invites {
+		result = append(result, toInviteLinkResponse(invite))
+	}
+
+	return result, nil
+}
+
+// RevokeInviteLink 撤销邀请链接（需要邀请成员的权限），撤销后链接立即失效，使用记录保留
+func (s *GroupService) RevokeInviteLink(ctx context.Context, userID string, groupID string, code string) error {
+	invite, err := s.getGroupInviteLink(ctx, userID, groupID, code)
+	if err != nil {
+		return err
+	}
+	if invite.RevokedAt != nil {
+		return nil
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		icq := dao.Use(tx).InvitationCode
+		if _, err := icq.WithContext(ctx).Where(icq.ID.Eq(invite.ID)).Update(icq.RevokedAt, time.Now()); err != nil {
+			return err
+		}
+
+		return recordGroupAudit(ctx, tx, groupID, userID, GroupAuditInviteRevoked, "", map[string]interface{}{
+			"code": invite.Code,
+		})
+	})
+}
+
+// ListInviteLinkUses 获取通过邀请链接加入或申请加入的用户（需要邀请成员的权限）
+func (s *GroupService) ListInviteLinkUses(ctx context.Context, userID string, groupID string, code string) ([]*dto.InviteLinkUseResponse, error) {
+	invite, err := s.getGroupInviteLink(ctx, userID, groupID, code)
+	if err != nil {
+		return nil, err
+	}
+
+	iuq := dao.Use(s.db).InvitationUse
+	uses, err := iuq.WithContext(ctx).Where(iuq.InvitationCodeID.Eq(invite.ID)).Order(iuq.CreatedAt.Desc()).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	uq := dao.Use(s.db).User
+	result := make([]*dto.InviteLinkUseResponse, 0, len(uses))
+	for _, use := range uses {
+		response := &dto.InviteLinkUseResponse{
+			UserID:    use.UserID,
+			Status:    use.Status,
+			CreatedAt: use.CreatedAt,
+		}
+		if user, err := uq.WithContext(ctx).Unscoped().Where(uq.ID.Eq(use.UserID)).First(); err == nil {
+			response.Username = user.Username
+		}
+		result = append(result, response)
+	}
+
+	return result, nil
+}
+
+// JoinGroupByCode 通过邀请码加入群组。链接需要审批时提交入群申请（符合自动审批规则时直接加入），否则直接加入；
+// 两种情况都会计入链接的使用次数并记录使用者
+func (s *GroupService) JoinGroupByCode(ctx context.Context, userID string, inviteCode string) (*dto.JoinGroupByCodeResponse, error) {
+	var group *model.Group
+	var memberEvent *dto.GroupMemberEvent
+	status := errStatusJoined
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		icq := dao.Use(tx).InvitationCode
+		icdo := icq.WithContext(ctx)
+
+		// 锁定邀请码，避免并发使用时超过次数限制
+		invite, err := icdo.Clauses(clause.Locking{Strength: "UPDATE"}).Where(icq.Code.Eq(inviteCode)).First()
+		if err != nil {
+			return fmt.Errorf(errInvalidInviteCode)
+		}
+		if err := checkInviteUsable(invite); err != nil {
+			return err
+		}
+
+		groupID := invite.UUID
+
+		gq := dao.Use(tx).Group
+		gdo := gq.WithContext(ctx)
+
+		group, err = gdo.Where(gq.ID.Eq(groupID)).First()
+		if err != nil {
+			return fmt.Errorf(errGroupNotFound)
+		}
+
+		if err := checkGroupBan(ctx, tx, groupID, userID); err != nil {
+			return err
+		}
+
+		mq := dao.Use(tx).GroupMember
+		mdo := mq.WithContext(ctx)
+
+		_, err = mdo.Where(mq.GroupID.Eq(groupID), mq.UserID.Eq(userID)).First()
+		if err == nil {
+			return fmt.Errorf(errAlreadyInGroup)
+		}
+
+		if invite.RequiresApproval {
+			status, memberEvent, err = createJoinRequest(ctx, tx, userID, groupID, "", invite.Code)
+			if err != nil {
+				return err
+			}
+		} else {
+			groupMember := model.GroupMember{
+				GroupID: groupID,
+				UserID:  userID,
+				Role:    RoleMember,
+			}
+
+			if err = mdo.Create(&groupMember); err != nil {
+				return err
+			}
+
+			if err := incrementGroupMemberCount(ctx, tx, groupID); err != nil {
+				return err
+			}
+
+			if err := enqueueWebhookEvent(ctx, tx, newMemberWebhookEvent(WebhookEventGroupMemberJoined, groupID, userID, "")); err != nil {
+				return err
+			}
+
+			err = recordGroupAudit(ctx, tx, groupID, userID, GroupAuditMemberJoined, userID, map[string]interface{}{
+				"invite_code": invite.Code,
+			})
+			if err != nil {
+				return err
+			}
+
+			memberEvent, err = recordGroupMemberEvent(ctx, tx, groupID, GroupMemberJoined, userID, "")
+			if err != nil {
+				return err
+			}
+		}
+
+		if _, err := icdo.Where(icq.ID.Eq(invite.ID)).UpdateSimple(icq.UseCount.Add(1)); err != nil {
+			return err
+		}
+
+		return dao.Use(tx).InvitationUse.WithContext(ctx).Create(&model.InvitationUse{
+			InvitationCodeID: invite.ID,
+			GroupID:          groupID,
+			UserID:           userID,
+			Status:           status,
+		})
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.JoinGroupByCodeResponse{
+		GroupID:     group.ID,
+		Name:        group.Name,
+		Status:      status,
+		MemberEvent: memberEvent,
+	}, nil
+}
+
+// checkInviteUsable 检查邀请码是否已撤销、过期或达到使用次数上限
+func checkInviteUsable(invite *model.InvitationCode) error {
+	if invite.RevokedAt != nil {
+		return fmt.Errorf(errInviteCodeRevoked)
+	}
+	if invite.ExpiresAt != nil && !invite.ExpiresAt.After(time.Now()) {
+		return fmt.Errorf(errInviteCodeExpired)
+	}
+	if invite.MaxUses > 0 && invite.UseCount >= invite.MaxUses {
+		return fmt.Errorf(errInviteCodeUsedUp)
+	}
+	return nil
+}
+
+// updateInvitationUseStatus 入群申请处理后同步更新通过邀请链接提交的使用记录
+func updateInvitationUseStatus(ctx context.Context, tx *gorm.DB, groupID string, userID string, status string) error {
+	iuq := dao.Use(tx).InvitationUse
+	_, err := iuq.WithContext(ctx).Where(
+		iuq.GroupID.Eq(groupID),
+		iuq.UserID.Eq(userID),
+		iuq.Status.Eq(errStatusPending),
+	).Update(iuq.Status, status)
+	return err
+}
+
+// getGroupInviteLink 获取有权限管理的群组邀请链接
+func (s *GroupService) getGroupInviteLink(ctx context.Context, userID string, groupID string, code string) (*model.InvitationCode, error) {
+	if _, err := requireGroupPermission(ctx, s.db, userID, groupID, PermissionInvite); err != nil {
+		return nil, err
+	}
+
+	icq := dao.Use(s.db).InvitationCode
+	invite, err := icq.WithContext(ctx).Where(icq.UUID.Eq(groupID), icq.Code.Eq(code)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(errInviteLinkNotFound)
+		}
+		return nil, err
+	}
+
+	return invite, nil
+}
+
+func toInviteLinkResponse(invite *model.InvitationCode) *dto.InviteLinkResponse {
+	response := &dto.InviteLinkResponse{
+		Code:             invite.Code,
+		GroupID:          invite.UUID,
+		GroupName:        invite.Name,
+		MaxUses:          invite.MaxUses,
+		UseCount:         invite.UseCount,
+		ExpiresAt:        invite.ExpiresAt,
+		RequiresApproval: invite.RequiresApproval,
+		RevokedAt:        invite.RevokedAt,
+		CreatedAt:        invite.CreatedAt,
+	}
+	if invite.CreatorID != nil {
+		response.CreatorID = *invite.CreatorID
+	}
+	return response
+}
+
+func generateInviteCode() (string, error) {
+	buf := make([]byte, inviteCodeBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"chat_backend/internal/config"
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+const (
+	maxDecisionReasonLength = 200
+	maxBulkReviewCount      = 100
+	maxJoinRequestExpiry    = 100
+
+	// joinRequestCooldown 申请被拒绝后需要等待的时间
+	joinRequestCooldown = 7 * 24 * time.Hour
+
+	// JoinRequestReviewFailed 批量审批中处理失败的申请状态
+	JoinRequestReviewFailed = "failed"
+)
+
+const (
+	errInvalidDecisionReason = "invalid decision reason"
+	errInvalidBulkReview     = "invalid bulk review request"
+)
+
+// joinRequestExpiredBefore 返回有效期的起点，在此之前创建的待处理申请视为已过期
+func joinRequestExpiredBefore() time.Time {
+	return time.Now().Add(-config.GetJoinRequestExpiry())
+}
+
+// joinRequestExpiresAt 返回入群申请的过期时间
+func joinRequestExpiresAt(req *model.GroupJoinRequest) time.Time {
+	return req.CreatedAt.Add(config.GetJoinRequestExpiry())
+}
+
+// CancelJoinRequest 撤回自己提交的待处理入群申请
+func (s *GroupService) CancelJoinRequest(ctx context.Context, userID string, groupID string) (*dto.JoinRequestEvent, error) {
+	rq := dao.Use(s.db).GroupJoinRequest
+	joinRequest, err := rq.WithContext(ctx).Where(
+		rq.TargetGroupID.Eq(groupID),
+		rq.SenderID.Eq(userID),
+		rq.Status.Eq(StatusPending),
+		rq.CreatedAt.Gt(joinRequestExpiredBefore()),
+	).First()
+	if err != nil {
+		return nil, fmt.Errorf(errJoinRequestNotFound)
+	}
+
+	now := time.Now()
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		trq := dao.Use(tx).GroupJoinRequest
+		result, err := trq.WithContext(ctx).Where(trq.ID.Eq(joinRequest.ID), trq.Status.Eq(StatusPending)).Updates(map[string]interface{}{
+			"status":     StatusCancelled,
+			"decided_at": now,
+		})
+		if err != nil {
+			return err
+		}
+		// 申请在查询之后已被审批或过期
+		if result.RowsAffected == 0 {
+			return fmt.Errorf(errJoinRequestNotFound)
+		}
+
+		return updateInvitationUseStatus(ctx, tx, groupID, userID, StatusCancelled)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	joinRequest.Status = StatusCancelled
+	joinRequest.DecidedAt = &now
+	return s.newJoinRequestEvent(ctx, joinRequest)
+}
+
+// ReviewJoinRequests 批量审批入群请求，逐个处理，单个申请失败不影响其他申请。
+// 返回每个申请的处理结果，以及处理成功的申请对应的事件
+func (s *GroupService) ReviewJoinRequests(ctx context.Context, userID string, groupID string, req dto.BulkReviewJoinRequestsRequest) ([]dto.JoinRequestReviewResult, []*dto.JoinRequestEvent, error) {
+	if len(req.UserIDs) == 0 || len(req.UserIDs) > maxBulkReviewCount {
+		return nil, nil, fmt.Errorf(errInvalidBulkReview)
+	}
+	if req.Action != errActionApprove && req.Action != errActionReject {
+		return nil, nil, fmt.Errorf(errInvalidAction)
+	}
+	if utf8.RuneCountInString(strings.TrimSpace(req.Reason)) > maxDecisionReasonLength {
+		return nil, nil, fmt.Errorf(errInvalidDecisionReason)
+	}
+
+	if _, err := requireGroupPermission(ctx, s.db, userID, groupID, PermissionApproveJoin); err != nil {
+		return nil, nil, err
+	}
+
+	results := make([]dto.JoinRequestReviewResult, 0, len(req.UserIDs))
+	events := make([]*dto.JoinRequestEvent, 0, len(req.UserIDs))
+	seen := make(map[string]bool, len(req.UserIDs))
+	for _, senderID := range req.UserIDs {
+		if seen[senderID] {
+			continue
+		}
+		seen[senderID] = true
+
+		event, err := s.ApproveJoinRequest(ctx, userID, groupID, senderID, req.Action, req.Reason)
+		if err != nil {
+			results = append(results, dto.JoinRequestReviewResult{
+				UserID: senderID,
+				Status: JoinRequestReviewFailed,
+				Error:  err.Error(),
+			})
+			continue
+		}
+
+		results = append(results, dto.JoinRequestReviewResult{
+			UserID: senderID,
+			Status: event.Status,
+		})
+		events = append(events, event)
+	}
+
+	return results, events, nil
+}
+
+// ExpireJoinRequests 将超过有效期仍未审批的入群申请标记为已过期，每次最多处理 100 条，返回过期申请的事件
+func (s *GroupService) ExpireJoinRequests(ctx context.Context) ([]*dto.JoinRequestEvent, error) {
+	rq := dao.Use(s.db).GroupJoinRequest
+	requests, err := rq.WithContext(ctx).Where(
+		rq.Status.Eq(StatusPending),
+		rq.CreatedAt.Lte(joinRequestExpiredBefore()),
+	).Order(rq.CreatedAt).Limit(maxJoinRequestExpiry).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*dto.JoinRequestEvent, 0, len(requests))
+	for _, joinRequest := range requests {
+		now := time.Now()
+		expired := false
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			trq := dao.Use(tx).GroupJoinRequest
+			result, err := trq.WithContext(ctx).Where(trq.ID.Eq(joinRequest.ID), trq.Status.Eq(StatusPending)).Updates(map[string]interface{}{
+				"status":     StatusExpired,
+				"decided_at": now,
+			})
+			if err != nil {
+				return err
+			}
+			// 申请在查询之后已被审批或撤回
+			if result.RowsAffected == 0 {
+				return nil
+			}
+			expired = true
+
+			return updateInvitationUseStatus(ctx, tx, joinRequest.TargetGroupID, joinRequest.SenderID, StatusExpired)
+		})
+		if err != nil {
+			return events, err
+		}
+		if !expired {
+			continue
+		}
+
+		joinRequest.Status = StatusExpired
+		joinRequest.DecidedAt = &now
+		event, err := s.newJoinRequestEvent(ctx, joinRequest)
+		if err != nil {
+			return events, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// GetPendingJoinRequestEvent 获取用户对群组的待处理入群申请，用于向审批人推送新申请
+func (s *GroupService) GetPendingJoinRequestEvent(ctx context.Context, groupID string, userID string) (*dto.JoinRequestEvent, error) {
+	rq := dao.Use(s.db).GroupJoinRequest
+	joinRequest, err := rq.WithContext(ctx).Where(
+		rq.TargetGroupID.Eq(groupID),
+		rq.SenderID.Eq(userID),
+		rq.Status.Eq(StatusPending),
+	).Order(rq.CreatedAt.Desc()).First()
+	if err != nil {
+		return nil, fmt.Errorf(errJoinRequestNotFound)
+	}
+
+	return s.newJoinRequestEvent(ctx, joinRequest)
+}
+
+// GetJoinApproverIDs 获取群组中有审批入群申请权限的成员ID列表
+func (s *GroupService) GetJoinApproverIDs(ctx context.Context, groupID string) ([]string, error) {
+	permissions, err := loadGroupPermissions(ctx, s.db, groupID)
+	if err != nil {
+		return nil, err
+	}
+	minRank := roleRank(permissions[PermissionApproveJoin])
+
+	mq := dao.Use(s.db).GroupMember
+	members, err := mq.WithContext(ctx).Select(mq.UserID, mq.Role).Where(mq.GroupID.Eq(groupID)).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	approverIDs := make([]string, 0)
+	for _, member := range members {
+		if roleRank(member.Role) >= minRank {
+			approverIDs = append(approverIDs, member.UserID)
+		}
+	}
+	return approverIDs, nil
+}
+
+func (s *GroupService) newJoinRequestEvent(ctx context.Context, req *model.GroupJoinRequest) (*dto.JoinRequestEvent, error) {
+	gq := dao.Use(s.db).Group
+	group, err := gq.WithContext(ctx).Select(gq.ID, gq.Name).Where(gq.ID.Eq(req.TargetGroupID)).First()
+	if err != nil {
+		return nil, fmt.Errorf(errGroupNotFound)
+	}
+
+	usernames, err := loadUsernames(ctx, s.db, []string{req.SenderID})
+	if err != nil {
+		return nil, err
+	}
+
+	event := &dto.JoinRequestEvent{
+		RequestID: req.ID,
+		GroupID:   req.TargetGroupID,
+		GroupName: group.Name,
+		UserID:    req.SenderID,
+		Username:  usernames[req.SenderID],
+		Message:   req.Message,
+		Status:    req.Status,
+		Reason:    req.DecisionReason,
+		CreatedAt: req.CreatedAt,
+		ExpiresAt: joinRequestExpiresAt(req),
+		DecidedAt: req.DecidedAt,
+	}
+	if req.DecidedBy != nil {
+		event.OperatorID = *req.DecidedBy
+	}
+	return event, nil
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/model"
+	"context"
+	"testing"
+	"time"
+)
+
+func TestApproveJoinRequest(t *testing.T) {
+	f := newGroupFixture(t, 0)
+	ctx := context.Background()
+	sender, _ := f.newPendingSender(t)
+
+	event, err := f.service.ApproveJoinRequest(ctx, f.owner.ID, f.group.ID, sender.ID, errActionApprove, "")
+	if err != nil {
+		t.Fatalf("approve: %v", err)
+	}
+	if event.Status != StatusApproved {
+		t.Errorf("status = %q, want %q", event.Status, StatusApproved)
+	}
+
+	isMember, err := f.service.IsGroupMember(ctx, f.group.ID, sender.ID)
+	if err != nil || !isMember {
+		t.Errorf("sender is member = %v, %v, want true", isMember, err)
+	}
+	if got := getTestGroup(t, f.db, f.group.ID); got.MemberCount != 2 {
+		t.Errorf("member count = %d, want 2", got.MemberCount)
+	}
+
+	// 系统消息与成员加入在同一事务中写入，发送者为审批人
+	if event.MemberEvent == nil || event.MemberEvent.MessageID == "" {
+		t.Fatalf("member event = %+v, want a stored system message", event.MemberEvent)
+	}
+	message, err := NewMessageService(f.db).GetMessage(ctx, event.MemberEvent.MessageID)
+	if err != nil {
+		t.Fatalf("get system message: %v", err)
+	}
+	if message.FromUserID != f.owner.ID || message.Kind != model.MessageKindSystem {
+		t.Errorf("system message from %q kind %q, want %q/%q", message.FromUserID, message.Kind, f.owner.ID, model.MessageKindSystem)
+	}
+}
+
+func TestApproveJoinRequestAfterCancel(t *testing.T) {
+	f := newGroupFixture(t, 0)
+	ctx := context.Background()
+	sender, _ := f.newPendingSender(t)
+
+	if _, err := f.service.CancelJoinRequest(ctx, sender.ID, f.group.ID); err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+
+	for _, action := range []string{errActionApprove, errActionReject} {
+		_, err := f.service.ApproveJoinRequest(ctx, f.owner.ID, f.group.ID, sender.ID, action, "")
+		if err == nil || err.Error() != errJoinRequestNotFound {
+			t.Errorf("%s cancelled request: got %v, want %q", action, err, errJoinRequestNotFound)
+		}
+	}
+
+	f.assertJoinRequestNotDecided(t, sender.ID, StatusCancelled)
+}
+
+func TestApproveJoinRequestAfterExpiry(t *testing.T) {
+	f := newGroupFixture(t, 0)
+	ctx := context.Background()
+	sender, joinRequest := f.newPendingSender(t)
+
+	rq := dao.Use(f.db).GroupJoinRequest
+	_, err := rq.WithContext(ctx).Where(rq.ID.Eq(joinRequest.ID)).
+		UpdateSimple(rq.CreatedAt.Value(joinRequestExpiredBefore().Add(-time.Hour)))
+	if err != nil {
+		t.Fatalf("backdate join request: %v", err)
+	}
+
+	for _, action := range []string{errActionApprove, errActionReject} {
+		_, err := f.service.ApproveJoinRequest(ctx, f.owner.ID, f.group.ID, sender.ID, action, "")
+		if err == nil || err.Error() != errJoinRequestNotFound {
+			t.Errorf("%s expired request: got %v, want %q", action, err, errJoinRequestNotFound)
+		}
+	}
+
+	f.assertJoinRequestNotDecided(t, sender.ID, StatusPending)
+}
+
+func TestApproveJoinRequestAfterBan(t *testing.T) {
+	f := newGroupFixture(t, 0)
+	ctx := context.Background()
+	sender, _ := f.newPendingSender(t)
+
+	// 直接写入封禁记录，模拟封禁在审批读取申请之后才提交
+	err := dao.Use(f.db).GroupBan.WithContext(ctx).Create(&model.GroupBan{
+		GroupID:    f.group.ID,
+		UserID:     sender.ID,
+		OperatorID: f.owner.ID,
+		CreatedAt:  time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("create ban: %v", err)
+	}
+
+	_, err = f.service.ApproveJoinRequest(ctx, f.owner.ID, f.group.ID, sender.ID, errActionApprove, "")
+	if err == nil || err.Error() != errBannedFromGroup {
+		t.Fatalf("approve banned sender: got %v, want %q", err, errBannedFromGroup)
+	}
+
+	f.assertJoinRequestNotDecided(t, sender.ID, StatusPending)
+}
+
+// assertJoinRequestNotDecided 检查申请没有被审批：状态保持不变，申请者没有加入群组，成员数没有变化
+func (f *groupFixture) assertJoinRequestNotDecided(t *testing.T, senderID string, status string) {
+	t.Helper()
+
+	ctx := context.Background()
+	rq := dao.Use(f.db).GroupJoinRequest
+	joinRequest, err := rq.WithContext(ctx).Where(rq.TargetGroupID.Eq(f.group.ID), rq.SenderID.Eq(senderID)).First()
+	if err != nil {
+		t.Fatalf("get join request: %v", err)
+	}
+	if joinRequest.Status != status {
+		t.Errorf("join request status = %q, want %q", joinRequest.Status, status)
+	}
+	if joinRequest.DecidedBy != nil {
+		t.Errorf("join request decided by %q, want nil", *joinRequest.DecidedBy)
+	}
+
+	isMember, err := f.service.IsGroupMember(ctx, f.group.ID, senderID)
+	if err != nil || isMember {
+		t.Errorf("sender is member = %v, %v, want false", isMember, err)
+	}
+	if got := getTestGroup(t, f.db, f.group.ID); got.MemberCount != 1 {
+		t.Errorf("member count = %d, want 1", got.MemberCount)
+	}
+}
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+// 入群自动审批规则的类型
+const (
+	// JoinRuleFriendOfMember 申请者是任一群成员的好友
+	JoinRuleFriendOfMember = "friend_of_member"
+	// JoinRuleOrganization 申请者属于指定的组织
+	JoinRuleOrganization = "organization"
+	// JoinRuleInviteLink 申请通过指定的邀请链接提交
+	JoinRuleInviteLink = "invite_link"
+)
+
+const (
+	maxGroupJoinRules     = 20
+	maxOrganizationLength = 64
+)
+
+const (
+	errInvalidJoinRule     = "invalid join rule"
+	errInvalidOrganization = "invalid organization"
+)
+
+// GetGroupJoinRules 获取群组的自动审批规则（需要审批入群的权限）
+func (s *GroupService) GetGroupJoinRules(ctx context.Context, userID string, groupID string) (*dto.GroupJoinRulesResponse, error) {
+	if _, err := requireGroupPermission(ctx, s.db, userID, groupID, PermissionApproveJoin); err != nil {
+		return nil, err
+	}
+
+	return s.getGroupJoinRules(ctx, groupID)
+}
+
+// UpdateGroupJoinRules 设置群组的自动审批规则（需要审批入群的权限），覆盖已有的规则。
+// 邀请链接规则只能使用本群组的邀请码
+func (s *GroupService) UpdateGroupJoinRules(ctx context.Context, userID string, groupID string, req dto.GroupJoinRulesRequest) (*dto.GroupJoinRulesResponse, error) {
+	if len(req.Rules) > maxGroupJoinRules {
+		return nil, fmt.Errorf(errInvalidJoinRule)
+	}
+
+	rules := make([]*model.GroupJoinRule, 0, len(req.Rules))
+	seen := make(map[string]bool, len(req.Rules))
+	for _, rule := range req.Rules {
+		value := strings.TrimSpace(rule.Value)
+		switch rule.Type {
+		case JoinRuleFriendOfMember:
+			value = ""
+		case JoinRuleOrganization:
+			value = NormalizeOrganization(value)
+			if value == "" || utf8.RuneCountInString(value) > maxOrganizationLength {
+				return nil, fmt.Errorf(errInvalidJoinRule)
+			}
+		case JoinRuleInviteLink:
+			if value == "" {
+				return nil, fmt.Errorf(errInvalidJoinRule)
+			}
+		default:
+			return nil, fmt.Errorf(errInvalidJoinRule)
+		}
+
+		key := rule.Type + ":" + value
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		rules = append(rules, &model.GroupJoinRule{
+			GroupID:   groupID,
+			Type:      rule.Type,
+			Value:     value,
+			CreatedBy: userID,
+		})
+	}
+
+	if _, err := requireGroupPermission(ctx, s.db, userID, groupID, PermissionApproveJoin); err != nil {
+		return nil, err
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		icq := dao.Use(tx).InvitationCode
+		for _, rule := range rules {
+			if rule.Type != JoinRuleInviteLink {
+				continue
+			}
+			count, err := icq.WithContext(ctx).Where(icq.UUID.Eq(groupID), icq.Code.Eq(rule.Value)).Count()
+			if err != nil {
+				return err
+			}
+			if count == 0 {
+				return fmt.Errorf(errInviteLinkNotFound)
+			}
+		}
+
+		jq := dao.Use(tx).GroupJoinRule
+		if _, err := jq.WithContext(ctx).Where(jq.GroupID.Eq(groupID)).Delete(); err != nil {
+			return err
+		}
+		if len(rules) > 0 {
+			if err := jq.WithContext(ctx).Create(rules...); err != nil {
+				return err
+			}
+		}
+
+		return recordGroupAudit(ctx, tx, groupID, userID, GroupAuditJoinRulesUpdated, "", req)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getGroupJoinRules(ctx, groupID)
+}
+
+func (s *GroupService) getGroupJoinRules(ctx context.Context, groupID string) (*dto.GroupJoinRulesResponse, error) {
+	jq := dao.Use(s.db).GroupJoinRule
+	rules, err := jq.WithContext(ctx).Where(jq.GroupID.Eq(groupID)).Order(jq.CreatedAt, jq.ID).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.GroupJoinRulesResponse{
+		GroupID: groupID,
+		Rules:   make([]dto.GroupJoinRule, 0, len(rules)),
+	}
+	for _, rule := range rules {
+		resp.Rules = append(resp.Rules, dto.GroupJoinRule{
+			Type:  rule.Type,
+			Value: rule.Value,
+		})
+	}
+	return resp, nil
+}
+
+// NormalizeOrganization 规范化组织名称：去掉首尾空白并转为小写，匹配规则时不区分大小写
+func NormalizeOrganization(organization string) string {
+	return strings.ToLower(strings.TrimSpace(organization))
+}
+
+// matchJoinRule 按创建顺序查找入群申请符合的第一条自动审批规则，都不符合时返回 nil。
+// inviteCode 为提交申请时使用的邀请码，不是通过邀请链接申请时为空
+func matchJoinRule(ctx context.Context, tx *gorm.DB, groupID string, userID string, inviteCode string) (*model.GroupJoinRule, error) {
+	jq := dao.Use(tx).GroupJoinRule
+	rules, err := jq.WithContext(ctx).Where(jq.GroupID.Eq(groupID)).Order(jq.CreatedAt, jq.ID).Find()
+	if err != nil || len(rules) == 0 {
+		return nil, err
+	}
+
+	for _, rule := range rules {
+		var matched bool
+		switch rule.Type {
+		case JoinRuleFriendOfMember:
+			matched, err = isFriendOfGroupMember(ctx, tx, groupID, userID)
+		case JoinRuleOrganization:
+			matched, err = isOrganizationMember(ctx, tx, userID, rule.Value)
+		case JoinRuleInviteLink:
+			matched = inviteCode != "" && rule.Value == inviteCode
+		}
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			return rule, nil
+		}
+	}
+	return nil, nil
+}
+
+// isFriendOfGroupMember 判断用户是否与任一群成员是正常的好友关系
+func isFriendOfGroupMember(ctx context.Context, tx *gorm.DB, groupID string, userID string) (bool, error) {
+	fq := dao.Use(tx).Friend
+	friends, err := fq.WithContext(ctx).Where(
+		fq.WithContext(ctx).Where(fq.UserA.Eq(userID)).Or(fq.UserB.Eq(userID)),
+		fq.Status.Eq(FriendStatusNormal),
+	).Find()
+	if err != nil || len(friends) == 0 {
+		return false, err
+	}
+
+	friendIDs := make([]string, 0, len(friends))
+	for _, friend := range friends {
+		if friend.UserA == userID {
+			friendIDs = append(friendIDs, friend.UserB)
+		} else {
+			friendIDs = append(friendIDs, friend.UserA)
+		}
+	}
+
+	mq := dao.Use(tx).GroupMember
+	count, err := mq.WithContext(ctx).Where(mq.GroupID.Eq(groupID), mq.UserID.In(friendIDs...)).Count()
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// isOrganizationMember 判断用户是否属于指定的组织，organization 已规范化
+func isOrganizationMember(ctx context.Context, tx *gorm.DB, userID string, organization string) (bool, error) {
+	uq := dao.Use(tx).User
+	count, err := uq.WithContext(ctx).Where(uq.ID.Eq(userID), uq.Organization.Eq(organization)).Count()
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// autoApproveJoinRequest 按自动审批规则直接通过入群申请：申请记录为已通过，申请者加入群组，返回成员加入事件
+func autoApproveJoinRequest(ctx context.Context, tx *gorm.DB, joinRequest *model.GroupJoinRequest, rule *model.GroupJoinRule) (*dto.GroupMemberEvent, error) {
+	groupID := joinRequest.TargetGroupID
+	userID := joinRequest.SenderID
+
+	now := time.Now()
+	joinRequest.Status = StatusApproved
+	joinRequest.DecisionReason = rule.Type
+	joinRequest.DecidedAt = &now
+	if err := dao.Use(tx).GroupJoinRequest.WithContext(ctx).Create(joinRequest); err != nil {
+		return nil, err
+	}
+
+	if err := incrementGroupMemberCount(ctx, tx, groupID); err != nil {
+		return nil, err
+	}
+
+	err := dao.Use(tx).GroupMember.WithContext(ctx).Create(&model.GroupMember{
+		GroupID: groupID,
+		UserID:  userID,
+		Role:    RoleMember,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := enqueueWebhookEvent(ctx, tx, newMemberWebhookEvent(WebhookEventGroupMemberJoined, groupID, userID, "")); err != nil {
+		return nil, err
+	}
+
+	err = recordGroupAudit(ctx, tx, groupID, userID, GroupAuditJoinAutoApproved, userID, map[string]interface{}{
+		"request_id": joinRequest.ID,
+		"rule_type":  rule.Type,
+		"rule_value": rule.Value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return recordGroupMemberEvent(ctx, tx, groupID, GroupMemberJoined, userID, "")
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/model"
+	"context"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+func TestNormalizeOrganization(t *testing.T) {
+	tests := []struct {
+		organization string
+		want         string
+	}{
+		{"Acme", "acme"},
+		{"  Acme Inc ", "acme inc"},
+		{"", ""},
+		{"   ", ""},
+	}
+	for _, tt := range tests {
+		if got := NormalizeOrganization(tt.organization); got != tt.want {
+			t.Errorf("NormalizeOrganization(%q) = %q, want %q", tt.organization, got, tt.want)
+		}
+	}
+}
+
+func TestMatchJoinRule(t *testing.T) {
+	f := newGroupFixture(t, 0)
+	ctx := context.Background()
+	db, owner, group := f.db, f.owner, f.group
+
+	for _, rule := range []*model.GroupJoinRule{
+		{GroupID: group.ID, Type: JoinRuleOrganization, Value: NormalizeOrganization(" Acme ")},
+		{GroupID: group.ID, Type: JoinRuleInviteLink, Value: "invite-code"},
+		{GroupID: group.ID, Type: JoinRuleFriendOfMember},
+	} {
+		rule.CreatedBy = owner.ID
+		if err := dao.Use(db).GroupJoinRule.WithContext(ctx).Create(rule); err != nil {
+			t.Fatalf("create rule: %v", err)
+		}
+	}
+
+	orgUser := createTestUser(t, db, "acme")
+	friend := createTestUser(t, db, "")
+	removedFriend := createTestUser(t, db, "")
+	stranger := createTestUser(t, db, "other")
+	fq := dao.Use(db).Friend
+	err := fq.WithContext(ctx).Create(
+		&model.Friend{UserA: owner.ID, UserB: friend.ID, Status: FriendStatusNormal},
+		&model.Friend{UserA: removedFriend.ID, UserB: owner.ID, Status: FriendStatusRemoved},
+	)
+	if err != nil {
+		t.Fatalf("create friends: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		userID     string
+		inviteCode string
+		want       string
+	}{
+		{"organization", orgUser.ID, "", JoinRuleOrganization},
+		{"invite link", stranger.ID, "invite-code", JoinRuleInviteLink},
+		{"wrong invite code", stranger.ID, "other-code", ""},
+		{"friend of member", friend.ID, "", JoinRuleFriendOfMember},
+		{"removed friend", removedFriend.ID, "", ""},
+		{"stranger", stranger.ID, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := matchJoinRule(ctx, db, group.ID, tt.userID, tt.inviteCode)
+			if err != nil {
+				t.Fatalf("match: %v", err)
+			}
+			got := ""
+			if rule != nil {
+				got = rule.Type
+			}
+			if got != tt.want {
+				t.Errorf("matched rule = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAutoApproveJoinRequest(t *testing.T) {
+	f := newGroupFixture(t, 2)
+	ctx := context.Background()
+	db, s, owner, group := f.db, f.service, f.owner, f.group
+
+	sender := createTestUser(t, db, "acme")
+	rule := &model.GroupJoinRule{GroupID: group.ID, Type: JoinRuleOrganization, Value: "acme", CreatedBy: owner.ID}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		_, err := autoApproveJoinRequest(ctx, tx, &model.GroupJoinRequest{SenderID: sender.ID, TargetGroupID: group.ID}, rule)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("auto approve: %v", err)
+	}
+
+	rq := dao.Use(db).GroupJoinRequest
+	joinRequest, err := rq.WithContext(ctx).Where(rq.TargetGroupID.Eq(group.ID), rq.SenderID.Eq(sender.ID)).First()
+	if err != nil {
+		t.Fatalf("get join request: %v", err)
+	}
+	if joinRequest.Status != StatusApproved || joinRequest.DecisionReason != JoinRuleOrganization {
+		t.Errorf("join request = %q/%q, want %q/%q", joinRequest.Status, joinRequest.DecisionReason, StatusApproved, JoinRuleOrganization)
+	}
+	if isMember, err := s.IsGroupMember(ctx, group.ID, sender.ID); err != nil || !isMember {
+		t.Errorf("sender is member = %v, %v, want true", isMember, err)
+	}
+
+	// 群组已满时整个事务回滚，不留下已通过的申请
+	late := createTestUser(t, db, "acme")
+	err = db.Transaction(func(tx *gorm.DB) error {
+		_, err := autoApproveJoinRequest(ctx, tx, &model.GroupJoinRequest{SenderID: late.ID, TargetGroupID: group.ID}, rule)
+		return err
+	})
+	if err == nil || err.Error() != errGroupFull {
+		t.Fatalf("auto approve into full group: got %v, want %q", err, errGroupFull)
+	}
+	count, err := rq.WithContext(ctx).Where(rq.TargetGroupID.Eq(group.ID), rq.SenderID.Eq(late.ID)).Count()
+	if err != nil || count != 0 {
+		t.Errorf("join requests of late user = %d, %v, want 0", count, err)
+	}
+	if isMember, err := s.IsGroupMember(ctx, group.ID, late.ID); err != nil || isMember {
+		t.Errorf("late user is member = %v, %v, want false", isMember, err)
+	}
+}
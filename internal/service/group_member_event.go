@@ -0,0 +1,102 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+const (
+	GroupMemberJoined           = "joined"
+	GroupMemberLeft             = "left"
+	GroupMemberRemoved          = "removed"
+	GroupMemberOwnerTransferred = "owner_transferred"
+	GroupMemberDisbanded        = "disbanded"
+)
+
+// NewGroupMemberEvent 构建成员变化事件并填充成员和操作者的用户名，操作者与成员相同时不记录操作者
+func (s *GroupService) NewGroupMemberEvent(ctx context.Context, groupID string, action string, userID string, operatorID string) (*dto.GroupMemberEvent, error) {
+	return newGroupMemberEvent(ctx, s.db, groupID, action, userID, operatorID)
+}
+
+func newGroupMemberEvent(ctx context.Context, db *gorm.DB, groupID string, action string, userID string, operatorID string) (*dto.GroupMemberEvent, error) {
+	if operatorID == userID {
+		operatorID = ""
+	}
+
+	userIDs := make([]string, 0, 2)
+	for _, id := range []string{userID, operatorID} {
+		if id != "" {
+			userIDs = append(userIDs, id)
+		}
+	}
+	usernames, err := loadUsernames(ctx, db, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GroupMemberEvent{
+		GroupID:          groupID,
+		Action:           action,
+		UserID:           userID,
+		Username:         usernames[userID],
+		OperatorID:       operatorID,
+		OperatorUsername: usernames[operatorID],
+	}, nil
+}
+
+// recordGroupMemberEvent 在成员变化的事务中存储群组时间线中的系统消息，与成员变化一起提交或回滚。
+// 系统消息的发送者为操作者，成员自己加入或退出时为该成员；变化后的群成员都会收到未送达回执，
+// 提交后由推送方将在线成员标记为已送达
+func recordGroupMemberEvent(ctx context.Context, tx *gorm.DB, groupID string, action string, userID string, operatorID string) (*dto.GroupMemberEvent, error) {
+	event, err := newGroupMemberEvent(ctx, tx, groupID, action, userID, operatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	senderID := event.OperatorID
+	if senderID == "" {
+		senderID = userID
+	}
+
+	mq := dao.Use(tx).GroupMember
+	var memberIDs []string
+	if err := mq.WithContext(ctx).Where(mq.GroupID.Eq(groupID)).Pluck(mq.UserID, &memberIDs); err != nil {
+		return nil, err
+	}
+
+	message := &model.Message{
+		FromUserID: senderID,
+		TargetID:   groupID,
+		Type:       model.MessageTypeGroup,
+		Kind:       model.MessageKindSystem,
+		Content:    GroupMemberEventText(event),
+	}
+	if err := createMessageWithReceipts(ctx, tx, message, memberIDs, nil); err != nil {
+		return nil, err
+	}
+
+	event.MessageID = message.ID
+	return event, nil
+}
+
+// GroupMemberEventText 成员变化在群组时间线中显示的系统消息
+func GroupMemberEventText(event *dto.GroupMemberEvent) string {
+	switch event.Action {
+	case GroupMemberJoined:
+		return event.Username + " 加入了群组"
+	case GroupMemberLeft:
+		return event.Username + " 退出了群组"
+	case GroupMemberRemoved:
+		return event.Username + " 被 " + event.OperatorUsername + " 移出了群组"
+	case GroupMemberOwnerTransferred:
+		return event.OperatorUsername + " 将群主转让给了 " + event.Username
+	case GroupMemberDisbanded:
+		return event.OperatorUsername + " 解散了群组"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"chat_backend/internal/dto"
+	"testing"
+)
+
+func TestGroupMemberEventText(t *testing.T) {
+	tests := []struct {
+		event dto.GroupMemberEvent
+		want  string
+	}{
+		{dto.GroupMemberEvent{Action: GroupMemberJoined, Username: "bob"}, "bob 加入了群组"},
+		{dto.GroupMemberEvent{Action: GroupMemberLeft, Username: "bob"}, "bob 退出了群组"},
+		{dto.GroupMemberEvent{Action: GroupMemberRemoved, Username: "bob", OperatorUsername: "alice"}, "bob 被 alice 移出了群组"},
+		{dto.GroupMemberEvent{Action: GroupMemberOwnerTransferred, Username: "bob", OperatorUsername: "alice"}, "alice 将群主转让给了 bob"},
+		{dto.GroupMemberEvent{Action: "unknown", Username: "bob"}, ""},
+	}
+	for _, tt := range tests {
+		if got := GroupMemberEventText(&tt.event); got != tt.want {
+			t.Errorf("GroupMemberEventText(%s) = %q, want %q", tt.event.Action, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const (
+	groupMembersKeyPrefix = "group_members:"
+	// groupMemberVersionKeyPrefix 群组成员版本的键，成员变化的事务提交后递增，成员集合按版本缓存
+	groupMemberVersionKeyPrefix = "group_member_version:"
+	// groupMembersCacheTTL 成员集合的缓存时间，成员变化后旧版本的缓存不再被读取，过期后自动清理
+	groupMembersCacheTTL = 10 * time.Minute
+
+	maxMemberListLimit = 100
+	// maxDetailMembers 群组详情中直接返回的成员数量
+	maxDetailMembers = 100
+)
+
+const (
+	errInvalidMemberCursor = "invalid member cursor"
+)
+
+type GroupMemberService struct {
+	db  *gorm.DB
+	rdb *redis.Client
+}
+
+func NewGroupMemberService(db *gorm.DB, rdb *redis.Client) *GroupMemberService {
+	return &GroupMemberService{
+		db:  db,
+		rdb: rdb,
+	}
+}
+
+// ListMembers 分页获取群成员（群成员可用），按加入时间排序，可以按角色筛选、按用户名或群昵称搜索。
+// 游标为上一页最后一个成员的加入时间和用户 ID
+func (s *GroupMemberService) ListMembers(ctx context.Context, userID string, groupID string, role string, keyword string, limit int, cursor string) (*dto.GroupMemberListResponse, error) {
+	if limit <= 0 || limit > maxMemberListLimit {
+		limit = 20
+	}
+
+	isMember, err := s.IsMember(ctx, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, fmt.Errorf(errNotInGroup)
+	}
+
+	return listGroupMembers(ctx, s.db, groupID, role, keyword, limit, cursor)
+}
+
+// GetMemberIDs 获取群组所有成员的 ID，用于推送消息和事件。成员集合按 Redis 中的成员版本缓存，
+// 命中缓存时不查询数据库；成员变化后由 InvalidateMemberCache 递增版本，之后的读取不再使用旧版本的缓存。
+// Redis 不可用时直接读取数据库
+func (s *GroupMemberService) GetMemberIDs(ctx context.Context, groupID string) ([]string, error) {
+	versionKey := groupMemberVersionKey(groupID)
+	version, err := getGroupMemberVersion(ctx, s.rdb, versionKey)
+	if err != nil {
+		return NewGroupService(s.db).GetGroupMemberIDs(ctx, groupID)
+	}
+
+	key := groupMembersKey(groupID, version)
+	// 群组至少有群主一个成员，集合为空说明没有缓存
+	if memberIDs, err := s.rdb.SMembers(ctx, key).Result(); err == nil && len(memberIDs) > 0 {
+		return memberIDs, nil
+	}
+
+	memberIDs, err := NewGroupService(s.db).GetGroupMemberIDs(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if len(memberIDs) == 0 {
+		return memberIDs, nil
+	}
+
+	members := make([]interface{}, 0, len(memberIDs))
+	for _, memberID := range memberIDs {
+		members = append(members, memberID)
+	}
+	// 只有读取数据库期间版本没有变化时才写入缓存，否则读到的可能是变化前的成员；
+	// 写入失败时仍然返回数据库中的结果，下次再尝试
+	_ = s.rdb.Watch(ctx, func(tx *redis.Tx) error {
+		current, err := getGroupMemberVersion(ctx, tx, versionKey)
+		if err != nil || current != version {
+			return err
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.SAdd(ctx, key, members...)
+			pipe.Expire(ctx, key, groupMembersCacheTTL)
+			return nil
+		})
+		return err
+	}, versionKey)
+
+	return memberIDs, nil
+}
+
+// InvalidateMemberCache 递增群组的成员版本，使已缓存的成员集合失效。
+// 需要在成员变化的事务提交之后调用，包括群组解散
+func (s *GroupMemberService) InvalidateMemberCache(ctx context.Context, groupID string) error {
+	return s.rdb.Incr(ctx, groupMemberVersionKey(groupID)).Err()
+}
+
+func groupMemberVersionKey(groupID string) string {
+	return groupMemberVersionKeyPrefix + groupID
+}
+
+func groupMembersKey(groupID string, version string) string {
+	return groupMembersKeyPrefix + groupID + ":" + version
+}
+
+// getGroupMemberVersion 读取群组的成员版本，成员从未变化过时为 "0"
+func getGroupMemberVersion(ctx context.Context, rdb redis.Cmdable, versionKey string) (string, error) {
+	version, err := rdb.Get(ctx, versionKey).Result()
+	if errors.Is(err, redis.Nil) {
+		return "0", nil
+	}
+	return version, err
+}
+
+// IsMember 检查用户是否是群组成员，用于权限校验，始终以数据库为准
+func (s *GroupMemberService) IsMember(ctx context.Context, groupID string, userID string) (bool, error) {
+	return NewGroupService(s.db).IsGroupMember(ctx, groupID, userID)
+}
+
+// likeEscaper 转义 LIKE 中的通配符，PostgreSQL 的 LIKE 默认以反斜杠作为转义字符
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// containsPattern 构造按子串匹配的 LIKE 模式，关键字中的 % 和 _ 按字面匹配
+func containsPattern(keyword string) string {
+	return "%" + likeEscaper.Replace(keyword) + "%"
+}
+
+// listGroupMembers 按加入时间分页查询群成员及其用户名，游标无效时返回错误
+func listGroupMembers(ctx context.Context, db *gorm.DB, groupID string, role string, keyword string, limit int, cursor string) (*dto.GroupMemberListResponse, error) {
+	mq := dao.Use(db).GroupMember
+	uq := dao.Use(db).User
+	query := mq.WithContext(ctx).
+		Select(mq.ALL, uq.Username).
+		Join(uq, uq.ID.EqCol(mq.UserID)).
+		Where(mq.GroupID.Eq(groupID))
+
+	if role != "" {
+		query = query.Where(mq.Role.Eq(role))
+	}
+	if keyword = strings.TrimSpace(keyword); keyword != "" {
+		pattern := containsPattern(strings.ToLower(keyword))
+		query = query.Where(mq.WithContext(ctx).Where(uq.Username.Lower().Like(pattern)).Or(mq.Nickname.Lower().Like(pattern)))
+	}
+	if cursor != "" {
+		joinedAt, lastUserID, ok := parseTimeIDCursor(cursor)
+		if !ok {
+			return nil, fmt.Errorf(errInvalidMemberCursor)
+		}
+		query = query.Where(mq.WithContext(ctx).Where(mq.CreatedAt.Gt(joinedAt)).Or(mq.CreatedAt.Eq(joinedAt), mq.UserID.Gt(lastUserID)))
+	}
+
+	var rows []struct {
+		model.GroupMember
+		Username string
+	}
+	// 多取一条用于判断是否还有下一页
+	if err := query.Order(mq.CreatedAt, mq.UserID).Limit(limit + 1).Scan(&rows); err != nil {
+		return nil, err
+	}
+
+	result := &dto.GroupMemberListResponse{
+		Members: make([]dto.GroupMemberInfo, 0, len(rows)),
+		HasMore: len(rows) > limit,
+	}
+	if result.HasMore {
+		rows = rows[:limit]
+	}
+	for _, row := range rows {
+		result.Members = append(result.Members, toGroupMemberInfo(&row.GroupMember, row.Username))
+	}
+	if result.HasMore {
+		last := rows[len(rows)-1]
+		result.NextCursor = last.CreatedAt.Format(time.RFC3339Nano) + "," + last.UserID
+	}
+
+	return result, nil
+}
+
+// parseTimeIDCursor 解析由时间和 ID 组成的分页游标
+func parseTimeIDCursor(cursor string) (time.Time, string, bool) {
+	timeStr, id, ok := strings.Cut(cursor, ",")
+	if !ok || id == "" {
+		return time.Time{}, "", false
+	}
+	t, err := time.Parse(time.RFC3339Nano, timeStr)
+	if err != nil {
+		return time.Time{}, "", false
+	}
+	return t, id, true
+}
+
+func toGroupMemberInfo(member *model.GroupMember, username string) dto.GroupMemberInfo {
+	info := dto.GroupMemberInfo{
+		UserID:   member.UserID,
+		Username: username,
+		Nickname: member.Nickname,
+		Role:     member.Role,
+		JoinedAt: member.CreatedAt.Format(time.RFC3339),
+	}
+	if member.MutedUntil != nil && member.MutedUntil.After(time.Now()) {
+		info.MutedUntil = member.MutedUntil.Format(time.RFC3339)
+	}
+	return info
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestGetMemberIDsAfterLeave(t *testing.T) {
+	f := newGroupFixture(t, 0)
+	rdb := newTestRedis(t)
+	ctx := context.Background()
+	db, owner, group := f.db, f.owner, f.group
+	s := NewGroupMemberService(db, rdb)
+
+	member := createTestUser(t, db, "")
+	addTestMember(t, db, group.ID, member.ID, RoleMember)
+
+	// 先读取一次，让成员集合写入缓存
+	memberIDs, err := s.GetMemberIDs(ctx, group.ID)
+	if err != nil {
+		t.Fatalf("get member ids: %v", err)
+	}
+	if !containsString(memberIDs, member.ID) {
+		t.Fatalf("member ids = %v, want to contain %s", memberIDs, member.ID)
+	}
+
+	if _, err := f.service.LeaveGroup(ctx, member.ID, group.ID); err != nil {
+		t.Fatalf("leave group: %v", err)
+	}
+	if err := s.InvalidateMemberCache(ctx, group.ID); err != nil {
+		t.Fatalf("invalidate member cache: %v", err)
+	}
+
+	memberIDs, err = s.GetMemberIDs(ctx, group.ID)
+	if err != nil {
+		t.Fatalf("get member ids after leave: %v", err)
+	}
+	if containsString(memberIDs, member.ID) {
+		t.Errorf("member ids after leave = %v, should not contain %s", memberIDs, member.ID)
+	}
+	if !containsString(memberIDs, owner.ID) {
+		t.Errorf("member ids after leave = %v, want to contain owner %s", memberIDs, owner.ID)
+	}
+
+	isMember, err := s.IsMember(ctx, group.ID, member.ID)
+	if err != nil || isMember {
+		t.Errorf("is member after leave = %v, %v, want false", isMember, err)
+	}
+}
+
+func TestGroupMemberCacheKeys(t *testing.T) {
+	if got := groupMemberVersionKey("g1"); got != "group_member_version:g1" {
+		t.Errorf("version key = %q", got)
+	}
+	// 成员集合按版本缓存，版本递增后读取新的键，旧集合等待过期
+	if groupMembersKey("g1", "1") == groupMembersKey("g1", "2") {
+		t.Errorf("member set keys of different versions should differ")
+	}
+	if groupMembersKey("g1", "1") == groupMembersKey("g2", "1") {
+		t.Errorf("member set keys of different groups should differ")
+	}
+}
+
+func TestParseTimeIDCursor(t *testing.T) {
+	joinedAt := time.Date(2024, 5, 1, 8, 30, 0, 123456789, time.UTC)
+	cursor := joinedAt.Format(time.RFC3339Nano) + "," + "user-1"
+
+	gotTime, gotID, ok := parseTimeIDCursor(cursor)
+	if !ok || !gotTime.Equal(joinedAt) || gotID != "user-1" {
+		t.Errorf("parseTimeIDCursor(%q) = %v, %q, %v", cursor, gotTime, gotID, ok)
+	}
+
+	for _, invalid := range []string{"", "user-1", "not-a-time,user-1", joinedAt.Format(time.RFC3339Nano) + ","} {
+		if _, _, ok := parseTimeIDCursor(invalid); ok {
+			t.Errorf("parseTimeIDCursor(%q) ok = true, want false", invalid)
+		}
+	}
+}
+
+func TestContainsPattern(t *testing.T) {
+	tests := []struct {
+		keyword string
+		want    string
+	}{
+		{"alice", `%alice%`},
+		{"100%", `%100\%%`},
+		{"a_b", `%a\_b%`},
+		{`a\b`, `%a\\b%`},
+	}
+	for _, tt := range tests {
+		if got := containsPattern(tt.keyword); got != tt.want {
+			t.Errorf("containsPattern(%q) = %q, want %q", tt.keyword, got, tt.want)
+		}
+	}
+}
+
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
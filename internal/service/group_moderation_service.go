@@ -0,0 +1,291 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const (
+	GroupModerationMemberMuted     = "member_muted"
+	GroupModerationMemberUnmuted   = "member_unmuted"
+	GroupModerationSettingsUpdated = "settings_updated"
+
+	// PostingRestrictionMuted 成员被单独禁言
+	PostingRestrictionMuted = "muted"
+	// PostingRestrictionMuteAll 群组开启了全员禁言
+	PostingRestrictionMuteAll = "mute_all"
+	// PostingRestrictionSlowMode 慢速模式下发言间隔未到
+	PostingRestrictionSlowMode = "slow_mode"
+	// PostingRestrictionChannel 群组是频道，只有群主和管理员可以发言
+	PostingRestrictionChannel = "channel"
+
+	maxMuteDuration    = 30 * 24 * time.Hour
+	maxSlowModeSeconds = 60 * 60
+
+	slowModeKeyPrefix = "slow_mode:"
+)
+
+const (
+	errInvalidMuteDuration = "invalid mute duration"
+	errInvalidSlowMode     = "invalid slow mode interval"
+	errCannotMuteYourself  = "cannot mute yourself"
+	errPostingRestricted   = "posting is restricted"
+)
+
+// PostingRestriction 成员当前不能在群组中发言的原因
+type PostingRestriction struct {
+	Reason string
+	Until  time.Time // 限制解除的时间
+}
+
+// PostingRestrictedError EnsureCanPost 在成员不能发言时返回的错误
+type PostingRestrictedError struct {
+	Restriction *PostingRestriction
+}
+
+func (e *PostingRestrictedError) Error() string {
+	return errPostingRestricted
+}
+
+// postingCheckedKey 标记请求已在群组中通过发言检查的 context key
+type postingCheckedKey struct {
+	groupID string
+	userID  string
+}
+
+type GroupModerationService struct {
+	db  *gorm.DB
+	rdb *redis.Client
+}
+
+func NewGroupModerationService(db *gorm.DB, rdb *redis.Client) *GroupModerationService {
+	return &GroupModerationService{
+		db:  db,
+		rdb: rdb,
+	}
+}
+
+// MuteMember 禁言群成员一段时间，只能禁言角色低于自己的成员
+func (s *GroupModerationService) MuteMember(ctx context.Context, userID string, groupID string, targetUserID string, duration time.Duration) (*dto.GroupModerationEvent, error) {
+	if duration <= 0 || duration > maxMuteDuration {
+		return nil, fmt.Errorf(errInvalidMuteDuration)
+	}
+
+	if err := s.checkCanModerateMember(ctx, userID, groupID, targetUserID); err != nil {
+		return nil, err
+	}
+
+	mutedUntil := time.Now().Add(duration)
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		mq := dao.Use(tx).GroupMember
+		if _, err := mq.WithContext(ctx).Where(mq.GroupID.Eq(groupID), mq.UserID.Eq(targetUserID)).Update(mq.MutedUntil, mutedUntil); err != nil {
+			return err
+		}
+
+		return recordGroupAudit(ctx, tx, groupID, userID, GroupAuditMemberMuted, targetUserID, map[string]interface{}{
+			"muted_until": mutedUntil,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := s.newModerationEvent(ctx, groupID, GroupModerationMemberMuted, userID)
+	if err != nil {
+		return nil, err
+	}
+	event.UserID = targetUserID
+	event.MutedUntil = &mutedUntil
+	return event, nil
+}
+
+// UnmuteMember 解除群成员的禁言
+func (s *GroupModerationService) UnmuteMember(ctx context.Context, userID string, groupID string, targetUserID string) (*dto.GroupModerationEvent, error) {
+	if err := s.checkCanModerateMember(ctx, userID, groupID, targetUserID); err != nil {
+		return nil, err
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		mq := dao.Use(tx).GroupMember
+		if _, err := mq.WithContext(ctx).Where(mq.GroupID.Eq(groupID), mq.UserID.Eq(targetUserID)).Update(mq.MutedUntil, nil); err != nil {
+			return err
+		}
+
+		return recordGroupAudit(ctx, tx, groupID, userID, GroupAuditMemberUnmuted, targetUserID, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := s.newModerationEvent(ctx, groupID, GroupModerationMemberUnmuted, userID)
+	if err != nil {
+		return nil, err
+	}
+	event.UserID = targetUserID
+	return event, nil
+}
+
+// UpdateGroupModeration 开启或关闭全员禁言、设置慢速模式的发言间隔；切换频道模式只有群主可以操作
+func (s *GroupModerationService) UpdateGroupModeration(ctx context.Context, userID string, groupID string, req dto.UpdateGroupModerationRequest) (*dto.GroupModerationEvent, error) {
+	if req.SlowModeSeconds != nil && (*req.SlowModeSeconds < 0 || *req.SlowModeSeconds > maxSlowModeSeconds) {
+		return nil, fmt.Errorf(errInvalidSlowMode)
+	}
+
+	group, err := requireGroupPermission(ctx, s.db, userID, groupID, PermissionMute)
+	if err != nil {
+		return nil, err
+	}
+	if req.Channel != nil && group.OwnerID != userID {
+		return nil, fmt.Errorf(errPermissionDenied)
+	}
+
+	updates := make(map[string]interface{})
+	if req.MuteAll != nil {
+		updates["mute_all"] = *req.MuteAll
+	}
+	if req.SlowModeSeconds != nil {
+		updates["slow_mode_seconds"] = *req.SlowModeSeconds
+	}
+	if req.Channel != nil {
+		updates["is_channel"] = *req.Channel
+	}
+	if len(updates) > 0 {
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			gq := dao.Use(tx).Group
+			if _, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).Updates(updates); err != nil {
+				return err
+			}
+
+			return recordGroupAudit(ctx, tx, groupID, userID, GroupAuditModerationUpdated, "", updates)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.newModerationEvent(ctx, groupID, GroupModerationSettingsUpdated, userID)
+}
+
+// EnsureCanPost 检查群成员当前能否在群组中发布内容，不能发言时返回 *PostingRestrictedError。
+// 普通消息、斜杠命令、投票和卡片等所有在群组中发布内容的入口都要调用；
+// ctx 已通过 WithPostingChecked 标记时直接通过，避免同一请求重复计算慢速模式的发言间隔
+func (s *GroupModerationService) EnsureCanPost(ctx context.Context, groupID string, userID string) error {
+	if checked, _ := ctx.Value(postingCheckedKey{groupID: groupID, userID: userID}).(bool); checked {
+		return nil
+	}
+
+	restriction, err := s.CheckPostingRestriction(ctx, groupID, userID)
+	if err != nil {
+		return err
+	}
+	if restriction != nil {
+		return &PostingRestrictedError{Restriction: restriction}
+	}
+	return nil
+}
+
+// WithPostingChecked 标记请求已在群组中通过发言检查，如斜杠命令在执行前检查后，命令中发起投票时不再重复检查
+func WithPostingChecked(ctx context.Context, groupID string, userID string) context.Context {
+	return context.WithValue(ctx, postingCheckedKey{groupID: groupID, userID: userID}, true)
+}
+
+// CheckPostingRestriction 检查群成员当前能否发言，可以发言时返回 nil。
+// 群主和管理员不受频道、全员禁言和慢速模式限制；慢速模式下检查通过即视为本次发言，开始计算下一次发言的间隔
+func (s *GroupModerationService) CheckPostingRestriction(ctx context.Context, groupID string, userID string) (*PostingRestriction, error) {
+	mq := dao.Use(s.db).GroupMember
+	member, err := mq.WithContext(ctx).Where(mq.GroupID.Eq(groupID), mq.UserID.Eq(userID)).First()
+	if err != nil {
+		return nil, fmt.Errorf(errNotInGroup)
+	}
+
+	now := time.Now()
+	if member.MutedUntil != nil && member.MutedUntil.After(now) {
+		return &PostingRestriction{Reason: PostingRestrictionMuted, Until: *member.MutedUntil}, nil
+	}
+	if roleRank(member.Role) >= roleRank(RoleAdmin) {
+		return nil, nil
+	}
+
+	gq := dao.Use(s.db).Group
+	group, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).First()
+	if err != nil {
+		return nil, fmt.Errorf(errGroupNotFound)
+	}
+	if group.IsChannel {
+		return &PostingRestriction{Reason: PostingRestrictionChannel}, nil
+	}
+	if group.MuteAll {
+		return &PostingRestriction{Reason: PostingRestrictionMuteAll}, nil
+	}
+
+	if group.SlowModeSeconds > 0 {
+		interval := time.Duration(group.SlowModeSeconds) * time.Second
+		key := slowModeKeyPrefix + groupID + ":" + userID
+		acquired, err := s.rdb.SetNX(ctx, key, now.UnixMilli(), interval).Result()
+		if err != nil {
+			return nil, err
+		}
+		if !acquired {
+			ttl, err := s.rdb.PTTL(ctx, key).Result()
+			if err != nil {
+				return nil, err
+			}
+			return &PostingRestriction{Reason: PostingRestrictionSlowMode, Until: now.Add(ttl)}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// checkCanModerateMember 确认操作者拥有禁言权限，且目标成员的角色低于操作者
+func (s *GroupModerationService) checkCanModerateMember(ctx context.Context, userID string, groupID string, targetUserID string) error {
+	if targetUserID == userID {
+		return fmt.Errorf(errCannotMuteYourself)
+	}
+
+	if _, err := requireGroupPermission(ctx, s.db, userID, groupID, PermissionMute); err != nil {
+		return err
+	}
+
+	targetRole, err := getGroupMemberRole(ctx, s.db, groupID, targetUserID)
+	if err != nil {
+		return err
+	}
+	if targetRole == "" {
+		return fmt.Errorf(errTargetUserNotInGroup)
+	}
+
+	operatorRole, err := getGroupMemberRole(ctx, s.db, groupID, userID)
+	if err != nil {
+		return err
+	}
+	if roleRank(operatorRole) <= roleRank(targetRole) {
+		return fmt.Errorf(errPermissionDenied)
+	}
+
+	return nil
+}
+
+// newModerationEvent 构建带有群组当前全员禁言和慢速模式设置的事件
+func (s *GroupModerationService) newModerationEvent(ctx context.Context, groupID string, action string, operatorID string) (*dto.GroupModerationEvent, error) {
+	gq := dao.Use(s.db).Group
+	group, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).First()
+	if err != nil {
+		return nil, fmt.Errorf(errGroupNotFound)
+	}
+
+	return &dto.GroupModerationEvent{
+		GroupID:         groupID,
+		Action:          action,
+		OperatorID:      operatorID,
+		MuteAll:         group.MuteAll,
+		SlowModeSeconds: group.SlowModeSeconds,
+		Channel:         group.IsChannel,
+	}, nil
+}
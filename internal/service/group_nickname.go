@@ -0,0 +1,108 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+const (
+	maxNicknameLength = 32
+)
+
+const (
+	errInvalidNickname = "invalid nickname"
+)
+
+// SetMemberNickname 设置成员在群组中的昵称，昵称为空时清除。
+// 成员可以修改自己的昵称；修改他人的昵称需要管理员及以上角色，且只能修改角色低于自己的成员
+func (s *GroupService) SetMemberNickname(ctx context.Context, userID string, groupID string, targetUserID string, nickname string) (*dto.GroupNicknameEvent, error) {
+	nickname = strings.TrimSpace(nickname)
+	if utf8.RuneCountInString(nickname) > maxNicknameLength || strings.ContainsAny(nickname, "\r\n") {
+		return nil, fmt.Errorf(errInvalidNickname)
+	}
+
+	if targetUserID == userID {
+		if _, err := s.getGroupMember(ctx, userID, groupID); err != nil {
+			return nil, err
+		}
+	} else {
+		operatorRole, err := getGroupMemberRole(ctx, s.db, groupID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if operatorRole == "" {
+			return nil, fmt.Errorf(errNotInGroup)
+		}
+		targetRole, err := getGroupMemberRole(ctx, s.db, groupID, targetUserID)
+		if err != nil {
+			return nil, err
+		}
+		if targetRole == "" {
+			return nil, fmt.Errorf(errTargetUserNotInGroup)
+		}
+		if roleRank(operatorRole) < roleRank(RoleAdmin) || roleRank(operatorRole) <= roleRank(targetRole) {
+			return nil, fmt.Errorf(errPermissionDenied)
+		}
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		mq := dao.Use(tx).GroupMember
+		if _, err := mq.WithContext(ctx).Where(mq.GroupID.Eq(groupID), mq.UserID.Eq(targetUserID)).Update(mq.Nickname, nickname); err != nil {
+			return err
+		}
+
+		// 成员修改自己的昵称不是管理操作，不记录审计日志
+		if targetUserID == userID {
+			return nil
+		}
+		return recordGroupAudit(ctx, tx, groupID, userID, GroupAuditNicknameChanged, targetUserID, map[string]interface{}{
+			"nickname": nickname,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GroupNicknameEvent{
+		GroupID:    groupID,
+		UserID:     targetUserID,
+		Nickname:   nickname,
+		OperatorID: userID,
+	}, nil
+}
+
+// GetMemberNickname 获取成员在群组中的昵称，未设置或不是成员时返回空字符串
+func (s *GroupService) GetMemberNickname(ctx context.Context, groupID string, userID string) (string, error) {
+	nicknames, err := loadGroupNicknames(ctx, s.db, groupID, []string{userID})
+	if err != nil {
+		return "", err
+	}
+	return nicknames[userID], nil
+}
+
+// loadGroupNicknames 批量获取成员在群组中的昵称，只返回设置了昵称的成员
+func loadGroupNicknames(ctx context.Context, db *gorm.DB, groupID string, userIDs []string) (map[string]string, error) {
+	nicknames := make(map[string]string)
+	if len(userIDs) == 0 {
+		return nicknames, nil
+	}
+
+	mq := dao.Use(db).GroupMember
+	members, err := mq.WithContext(ctx).
+		Select(mq.UserID, mq.Nickname).
+		Where(mq.GroupID.Eq(groupID), mq.UserID.In(userIDs...), mq.Nickname.Neq("")).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		nicknames[member.UserID] = member.Nickname
+	}
+	return nicknames, nil
+}
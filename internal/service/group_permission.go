@@ -0,0 +1,333 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// PermissionInvite 邀请成员（包括将机器人直接加入群组）
+	PermissionInvite = "invite"
+	// PermissionApproveJoin 查看和审批入群申请
+	PermissionApproveJoin = "approve_join"
+	// PermissionRemoveMember 移除群成员，只能移除角色低于自己的成员
+	PermissionRemoveMember = "remove_member"
+	// PermissionPinMessage 置顶群消息和论坛话题
+	PermissionPinMessage = "pin_message"
+	// PermissionEditInfo 修改群组资料，如话题和欢迎语
+	PermissionEditInfo = "edit_info"
+	// PermissionMentionAll 在消息中使用 @all 提及全体成员
+	PermissionMentionAll = "mention_all"
+	// PermissionMute 禁言成员、开启全员禁言和设置慢速模式
+	PermissionMute = "mute"
+	// PermissionCreateTopic 在论坛模式的群组中创建话题
+	PermissionCreateTopic = "create_topic"
+	// PermissionManagePoll 关闭其他成员发起的投票
+	PermissionManagePoll = "manage_poll"
+	// PermissionApproveCard 在群聊的审批卡片中批准或拒绝
+	PermissionApproveCard = "approve_card"
+)
+
+const (
+	errInvalidRole       = "invalid role"
+	errInvalidPermission = "invalid permission"
+	errCannotChangeOwner = "cannot change owner role"
+)
+
+// MentionAllName 提及全体成员时使用的名称，即消息中的 @all
+const MentionAllName = "all"
+
+// defaultGroupPermissions 群组未配置时各项权限所需的最低角色
+var defaultGroupPermissions = map[string]string{
+	PermissionInvite:       RoleAdmin,
+	PermissionApproveJoin:  RoleAdmin,
+	PermissionRemoveMember: RoleAdmin,
+	PermissionPinMessage:   RoleAdmin,
+	PermissionEditInfo:     RoleAdmin,
+	PermissionMentionAll:   RoleAdmin,
+	PermissionMute:         RoleAdmin,
+	PermissionCreateTopic:  RoleMember,
+	PermissionManagePoll:   RoleAdmin,
+	PermissionApproveCard:  RoleAdmin,
+}
+
+// groupPermissionNames 按固定顺序排列的权限名称
+var groupPermissionNames = []string{
+	PermissionInvite,
+	PermissionApproveJoin,
+	PermissionRemoveMember,
+	PermissionPinMessage,
+	PermissionEditInfo,
+	PermissionMentionAll,
+	PermissionMute,
+	PermissionCreateTopic,
+	PermissionManagePoll,
+	PermissionApproveCard,
+}
+
+// roleRank 返回角色的级别，级别越高权限越大，不是群成员时为 0
+func roleRank(role string) int {
+	switch role {
+	case RoleOwner:
+		return 3
+	case RoleAdmin:
+		return 2
+	case RoleMember:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// HasPermission 检查用户在群组中是否拥有指定权限，不是群成员时返回 false
+func (s *GroupService) HasPermission(ctx context.Context, groupID string, userID string, permission string) (bool, error) {
+	return hasGroupPermission(ctx, s.db, groupID, userID, permission)
+}
+
+// GetMemberRole 获取用户在群组中的角色，不是群成员时返回空字符串
+func (s *GroupService) GetMemberRole(ctx context.Context, groupID string, userID string) (string, error) {
+	return getGroupMemberRole(ctx, s.db, groupID, userID)
+}
+
+// GetGroupPermissions 获取群组的权限矩阵和当前用户拥有的权限（仅群成员）
+func (s *GroupService) GetGroupPermissions(ctx context.Context, userID string, groupID string) (*dto.GroupPermissionsResponse, error) {
+	gq := dao.Use(s.db).Group
+	if _, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).First(); err != nil {
+		return nil, fmt.Errorf(errGroupNotFound)
+	}
+
+	role, err := getGroupMemberRole(ctx, s.db, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if role == "" {
+		return nil, fmt.Errorf(errNotInGroup)
+	}
+
+	permissions, err := loadGroupPermissions(ctx, s.db, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	granted := make([]string, 0, len(groupPermissionNames))
+	for _, permission := range groupPermissionNames {
+		if roleRank(role) >= roleRank(permissions[permission]) {
+			granted = append(granted, permission)
+		}
+	}
+
+	return &dto.GroupPermissionsResponse{
+		GroupID:       groupID,
+		Permissions:   permissions,
+		MyRole:        role,
+		MyPermissions: granted,
+	}, nil
+}
+
+// UpdateGroupPermissions 修改群组权限矩阵（仅群主），只修改请求中包含的权限
+func (s *GroupService) UpdateGroupPermissions(ctx context.Context, userID string, groupID string, permissions map[string]string) (*dto.GroupPermissionsResponse, error) {
+	for permission, minRole := range permissions {
+		if _, ok := defaultGroupPermissions[permission]; !ok {
+			return nil, fmt.Errorf(errInvalidPermission)
+		}
+		if roleRank(minRole) == 0 {
+			return nil, fmt.Errorf(errInvalidRole)
+		}
+	}
+
+	if _, err := requireGroupOwner(ctx, s.db, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	if len(permissions) > 0 {
+		rows := make([]*model.GroupPermission, 0, len(permissions))
+		for permission, minRole := range permissions {
+			rows = append(rows, &model.GroupPermission{
+				GroupID:    groupID,
+				Permission: permission,
+				MinRole:    minRole,
+			})
+		}
+
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			pq := dao.Use(tx).GroupPermission
+			err := pq.WithContext(ctx).Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "group_id"}, {Name: "permission"}},
+				DoUpdates: clause.AssignmentColumns([]string{"min_role", "updated_at"}),
+			}).Create(rows...)
+			if err != nil {
+				return err
+			}
+
+			return recordGroupAudit(ctx, tx, groupID, userID, GroupAuditPermissionsUpdated, "", map[string]interface{}{
+				"permissions": permissions,
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.GetGroupPermissions(ctx, userID, groupID)
+}
+
+// UpdateMemberRole 设置群成员为管理员或普通成员（仅群主）
+func (s *GroupService) UpdateMemberRole(ctx context.Context, userID string, groupID string, targetUserID string, role string) error {
+	if role != RoleAdmin && role != RoleMember {
+		return fmt.Errorf(errInvalidRole)
+	}
+
+	if _, err := requireGroupOwner(ctx, s.db, userID, groupID); err != nil {
+		return err
+	}
+
+	targetRole, err := getGroupMemberRole(ctx, s.db, groupID, targetUserID)
+	if err != nil {
+		return err
+	}
+	if targetRole == "" {
+		return fmt.Errorf(errTargetUserNotInGroup)
+	}
+	if targetRole == RoleOwner {
+		return fmt.Errorf(errCannotChangeOwner)
+	}
+
+	if targetRole == role {
+		return nil
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		mq := dao.Use(tx).GroupMember
+		if _, err := mq.WithContext(ctx).Where(mq.GroupID.Eq(groupID), mq.UserID.Eq(targetUserID)).Update(mq.Role, role); err != nil {
+			return err
+		}
+
+		return recordGroupAudit(ctx, tx, groupID, userID, GroupAuditRoleChanged, targetUserID, map[string]interface{}{
+			"from": targetRole,
+			"to":   role,
+		})
+	})
+}
+
+// hasGroupPermission 比较用户的角色与权限所需的最低角色
+func hasGroupPermission(ctx context.Context, db *gorm.DB, groupID string, userID string, permission string) (bool, error) {
+	role, err := getGroupMemberRole(ctx, db, groupID, userID)
+	if err != nil || role == "" {
+		return false, err
+	}
+
+	minRole := defaultGroupPermissions[permission]
+	pq := dao.Use(db).GroupPermission
+	row, err := pq.WithContext(ctx).Where(pq.GroupID.Eq(groupID), pq.Permission.Eq(permission)).First()
+	if err == nil {
+		minRole = row.MinRole
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, err
+	}
+
+	return roleRank(role) >= roleRank(minRole), nil
+}
+
+// requireGroupPermission 获取群组并确认用户拥有指定权限
+func requireGroupPermission(ctx context.Context, db *gorm.DB, userID string, groupID string, permission string) (*model.Group, error) {
+	gq := dao.Use(db).Group
+	group, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).First()
+	if err != nil {
+		return nil, fmt.Errorf(errGroupNotFound)
+	}
+
+	allowed, err := hasGroupPermission(ctx, db, groupID, userID, permission)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, fmt.Errorf(errPermissionDenied)
+	}
+	return group, nil
+}
+
+func getGroupMemberRole(ctx context.Context, db *gorm.DB, groupID string, userID string) (string, error) {
+	mq := dao.Use(db).GroupMember
+	member, err := mq.WithContext(ctx).Where(mq.GroupID.Eq(groupID), mq.UserID.Eq(userID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return member.Role, nil
+}
+
+// loadGroupPermissions 返回群组完整的权限矩阵，未配置的权限使用默认值
+func loadGroupPermissions(ctx context.Context, db *gorm.DB, groupID string) (map[string]string, error) {
+	permissions := make(map[string]string, len(defaultGroupPermissions))
+	for permission, minRole := range defaultGroupPermissions {
+		permissions[permission] = minRole
+	}
+
+	pq := dao.Use(db).GroupPermission
+	rows, err := pq.WithContext(ctx).Where(pq.GroupID.Eq(groupID)).Find()
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if _, ok := permissions[row.Permission]; ok {
+			permissions[row.Permission] = row.MinRole
+		}
+	}
+
+	return permissions, nil
+}
+
+// getGroupIDsWithPermission 获取用户拥有指定权限的所有群组ID
+func (s *GroupService) getGroupIDsWithPermission(ctx context.Context, userID string, permission string) ([]string, error) {
+	mq := dao.Use(s.db).GroupMember
+	memberships, err := mq.WithContext(ctx).Where(mq.UserID.Eq(userID)).Find()
+	if err != nil {
+		return nil, err
+	}
+	if len(memberships) == 0 {
+		return nil, nil
+	}
+
+	groupIDs := make([]string, 0, len(memberships))
+	for _, membership := range memberships {
+		groupIDs = append(groupIDs, membership.GroupID)
+	}
+
+	pq := dao.Use(s.db).GroupPermission
+	rows, err := pq.WithContext(ctx).Where(pq.GroupID.In(groupIDs...), pq.Permission.Eq(permission)).Find()
+	if err != nil {
+		return nil, err
+	}
+	minRoles := make(map[string]string, len(rows))
+	for _, row := range rows {
+		minRoles[row.GroupID] = row.MinRole
+	}
+
+	allowed := make([]string, 0, len(memberships))
+	for _, membership := range memberships {
+		minRole, ok := minRoles[membership.GroupID]
+		if !ok {
+			minRole = defaultGroupPermissions[permission]
+		}
+		if roleRank(membership.Role) >= roleRank(minRole) {
+			allowed = append(allowed, membership.GroupID)
+		}
+	}
+
+	return allowed, nil
+}
+
+// MentionsAll 判断消息内容中是否提及了全体成员
+func MentionsAll(content string) bool {
+	_, mentioned := stripMention(content, MentionAllName)
+	return mentioned
+}
@@ -0,0 +1,411 @@
+package service
+
+import (
+	"chat_backend/internal/config"
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// GroupAvatarPathPrefix 群头像地址的路径前缀，完整地址为 前缀/{group_id}/avatar
+	GroupAvatarPathPrefix = "/api/v1/group/"
+
+	maxGroupDescriptionLength  = 500
+	maxGroupTags               = 10
+	maxGroupTagLength          = 20
+	maxGroupAnnouncementLength = 2000
+	maxGroupAvatarSize         = 2 << 20
+	groupAvatarDirName         = "group_avatars"
+)
+
+const (
+	errInvalidGroupDescription  = "invalid group description"
+	errInvalidGroupTags         = "invalid group tags"
+	errInvalidGroupAnnouncement = "invalid group announcement"
+	errInvalidGroupAvatar       = "invalid group avatar"
+	errGroupAvatarNotFound      = "group avatar not found"
+	errAnnouncementNotFound     = "announcement not found"
+	errAnnouncementOutdated     = "announcement version outdated"
+)
+
+// groupAvatarExtensions 允许上传的群头像格式
+var groupAvatarExtensions = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// UpdateGroupProfile 修改群组简介和标签（需要修改群资料的权限），标签整体替换
+func (s *GroupService) UpdateGroupProfile(ctx context.Context, userID string, groupID string, req dto.UpdateGroupProfileRequest) (*dto.GroupProfileEvent, error) {
+	var tags []string
+	if req.Description != nil {
+		description := strings.TrimSpace(*req.Description)
+		if utf8.RuneCountInString(description) > maxGroupDescriptionLength {
+			return nil, fmt.Errorf(errInvalidGroupDescription)
+		}
+		req.Description = &description
+	}
+	if req.Tags != nil {
+		var err error
+		if tags, err = normalizeGroupTags(*req.Tags); err != nil {
+			return nil, err
+		}
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := requireGroupPermission(ctx, tx, userID, groupID, PermissionEditInfo); err != nil {
+			return err
+		}
+
+		if req.Description != nil {
+			gq := dao.Use(tx).Group
+			if _, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).Update(gq.Description, *req.Description); err != nil {
+				return err
+			}
+		}
+
+		if req.Tags != nil {
+			tq := dao.Use(tx).GroupTag
+			if _, err := tq.WithContext(ctx).Where(tq.GroupID.Eq(groupID)).Delete(); err != nil {
+				return err
+			}
+			groupTags := make([]*model.GroupTag, 0, len(tags))
+			for _, tag := range tags {
+				groupTags = append(groupTags, &model.GroupTag{GroupID: groupID, Tag: tag})
+			}
+			if len(groupTags) > 0 {
+				if err := tq.WithContext(ctx).Create(groupTags...); err != nil {
+					return err
+				}
+			}
+		}
+
+		payload := make(map[string]interface{})
+		if req.Description != nil {
+			payload["description"] = *req.Description
+		}
+		if req.Tags != nil {
+			payload["tags"] = tags
+		}
+		return recordGroupAudit(ctx, tx, groupID, userID, GroupAuditProfileUpdated, "", payload)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getGroupProfileEvent(ctx, userID, groupID)
+}
+
+// SetGroupAvatar 上传群头像（需要修改群资料的权限），支持 PNG、JPEG、GIF 和 WebP，替换后删除旧文件
+func (s *GroupService) SetGroupAvatar(ctx context.Context, userID string, groupID string, file io.Reader) (*dto.GroupProfileEvent, error) {
+	group, err := requireGroupPermission(ctx, s.db, userID, groupID, PermissionEditInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file, maxGroupAvatarSize+1))
+	if err != nil {
+		return nil, err
+	}
+	ext, ok := groupAvatarExtensions[http.DetectContentType(data)]
+	if len(data) == 0 || len(data) > maxGroupAvatarSize || !ok {
+		return nil, fmt.Errorf(errInvalidGroupAvatar)
+	}
+
+	dir := filepath.Join(config.GetStorageDir(), groupAvatarDirName)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	// 文件名包含上传时间，头像地址随之变化，客户端不会使用缓存的旧头像
+	fileName := fmt.Sprintf("%s-%d%s", groupID, time.Now().UnixNano(), ext)
+	if err := os.WriteFile(filepath.Join(dir, fileName), data, 0o644); err != nil {
+		return nil, err
+	}
+
+	gq := dao.Use(s.db).Group
+	if _, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).Update(gq.AvatarFile, fileName); err != nil {
+		_ = os.Remove(filepath.Join(dir, fileName))
+		return nil, err
+	}
+	removeGroupAvatarFile(group.AvatarFile)
+
+	err = recordGroupAudit(ctx, s.db, groupID, userID, GroupAuditProfileUpdated, "", map[string]interface{}{
+		"avatar": "updated",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getGroupProfileEvent(ctx, userID, groupID)
+}
+
+// RemoveGroupAvatar 删除上传的群头像，恢复为默认头像（需要修改群资料的权限）
+func (s *GroupService) RemoveGroupAvatar(ctx context.Context, userID string, groupID string) (*dto.GroupProfileEvent, error) {
+	group, err := requireGroupPermission(ctx, s.db, userID, groupID, PermissionEditInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	gq := dao.Use(s.db).Group
+	if _, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).Update(gq.AvatarFile, ""); err != nil {
+		return nil, err
+	}
+	removeGroupAvatarFile(group.AvatarFile)
+
+	err = recordGroupAudit(ctx, s.db, groupID, userID, GroupAuditProfileUpdated, "", map[string]interface{}{
+		"avatar": "removed",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getGroupProfileEvent(ctx, userID, groupID)
+}
+
+// GetGroupAvatarFile 获取群组上传的头像文件路径，未上传时返回错误
+func (s *GroupService) GetGroupAvatarFile(ctx context.Context, groupID string) (string, error) {
+	gq := dao.Use(s.db).Group
+	group, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).First()
+	if err != nil || group.AvatarFile == "" {
+		return "", fmt.Errorf(errGroupAvatarNotFound)
+	}
+
+	filePath := filepath.Join(config.GetStorageDir(), groupAvatarDirName, group.AvatarFile)
+	if _, err := os.Stat(filePath); err != nil {
+		return "", fmt.Errorf(errGroupAvatarNotFound)
+	}
+
+	return filePath, nil
+}
+
+// UpdateGroupAnnouncement 修改群公告（需要修改群资料的权限），每次修改递增版本，成员需要重新确认
+func (s *GroupService) UpdateGroupAnnouncement(ctx context.Context, userID string, groupID string, content string) (*dto.GroupProfileEvent, error) {
+	content = strings.TrimSpace(content)
+	if utf8.RuneCountInString(content) > maxGroupAnnouncementLength {
+		return nil, fmt.Errorf(errInvalidGroupAnnouncement)
+	}
+
+	if _, err := requireGroupPermission(ctx, s.db, userID, groupID, PermissionEditInfo); err != nil {
+		return nil, err
+	}
+
+	gq := dao.Use(s.db).Group
+	_, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).UpdateSimple(
+		gq.Announcement.Value(content),
+		gq.AnnouncementVersion.Add(1),
+		gq.AnnouncementAt.Value(time.Now()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := s.getGroupProfileEvent(ctx, userID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = recordGroupAudit(ctx, s.db, groupID, userID, GroupAuditAnnouncementUpdated, "", map[string]interface{}{
+		"version": event.AnnouncementVersion,
+		"content": content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 发布者视为已确认自己发布的公告
+	if content != "" {
+		if err := s.ackAnnouncement(ctx, userID, groupID, event.AnnouncementVersion); err != nil {
+			return nil, err
+		}
+	}
+
+	return event, nil
+}
+
+// GetGroupAnnouncement 获取群公告以及当前用户的确认状态（群成员）
+func (s *GroupService) GetGroupAnnouncement(ctx context.Context, userID string, groupID string) (*dto.GroupAnnouncementResponse, error) {
+	member, err := s.getGroupMember(ctx, userID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	gq := dao.Use(s.db).Group
+	group, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).First()
+	if err != nil {
+		return nil, fmt.Errorf(errGroupNotFound)
+	}
+	if group.Announcement == "" {
+		return nil, fmt.Errorf(errAnnouncementNotFound)
+	}
+
+	return s.toGroupAnnouncementResponse(ctx, group, member), nil
+}
+
+// AckGroupAnnouncement 确认已阅读群公告，只能确认当前版本，避免确认了已被修改的旧公告
+func (s *GroupService) AckGroupAnnouncement(ctx context.Context, userID string, groupID string, version int) (*dto.GroupAnnouncementResponse, error) {
+	if _, err := s.getGroupMember(ctx, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	gq := dao.Use(s.db).Group
+	group, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).First()
+	if err != nil {
+		return nil, fmt.Errorf(errGroupNotFound)
+	}
+	if group.Announcement == "" {
+		return nil, fmt.Errorf(errAnnouncementNotFound)
+	}
+	if version != group.AnnouncementVersion {
+		return nil, fmt.Errorf(errAnnouncementOutdated)
+	}
+
+	if err := s.ackAnnouncement(ctx, userID, groupID, version); err != nil {
+		return nil, err
+	}
+
+	return s.GetGroupAnnouncement(ctx, userID, groupID)
+}
+
+// ackAnnouncement 记录成员已确认的公告版本，版本只增不减
+func (s *GroupService) ackAnnouncement(ctx context.Context, userID string, groupID string, version int) error {
+	mq := dao.Use(s.db).GroupMember
+	_, err := mq.WithContext(ctx).Where(
+		mq.GroupID.Eq(groupID),
+		mq.UserID.Eq(userID),
+		mq.AnnouncementAckVersion.Lt(version),
+	).Update(mq.AnnouncementAckVersion, version)
+	return err
+}
+
+func (s *GroupService) getGroupMember(ctx context.Context, userID string, groupID string) (*model.GroupMember, error) {
+	mq := dao.Use(s.db).GroupMember
+	member, err := mq.WithContext(ctx).Where(mq.GroupID.Eq(groupID), mq.UserID.Eq(userID)).First()
+	if err != nil {
+		return nil, fmt.Errorf(errNotInGroup)
+	}
+	return member, nil
+}
+
+// getGroupProfileEvent 读取修改后的群组资料，用于响应和推送
+func (s *GroupService) getGroupProfileEvent(ctx context.Context, userID string, groupID string) (*dto.GroupProfileEvent, error) {
+	gq := dao.Use(s.db).Group
+	group, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).First()
+	if err != nil {
+		return nil, fmt.Errorf(errGroupNotFound)
+	}
+
+	tags, err := loadGroupTags(ctx, s.db, []string{groupID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GroupProfileEvent{
+		GroupID:             group.ID,
+		OperatorID:          userID,
+		Description:         group.Description,
+		Avatar:              groupAvatarURL(s.db, group),
+		Tags:                tags[groupID],
+		Announcement:        group.Announcement,
+		AnnouncementVersion: group.AnnouncementVersion,
+		Visibility:          group.Visibility,
+		JoinPolicy:          group.JoinPolicy,
+		MaxMembers:          group.MaxMembers,
+		Forum:               group.IsForum,
+	}, nil
+}
+
+func (s *GroupService) toGroupAnnouncementResponse(ctx context.Context, group *model.Group, member *model.GroupMember) *dto.GroupAnnouncementResponse {
+	response := &dto.GroupAnnouncementResponse{
+		Content: group.Announcement,
+		Version: group.AnnouncementVersion,
+		Acked:   member.AnnouncementAckVersion >= group.AnnouncementVersion,
+	}
+	if group.AnnouncementAt != nil {
+		response.UpdatedAt = group.AnnouncementAt.Format(time.RFC3339)
+	}
+
+	mq := dao.Use(s.db).GroupMember
+	if count, err := mq.WithContext(ctx).Where(mq.GroupID.Eq(group.ID), mq.AnnouncementAckVersion.Gte(group.AnnouncementVersion)).Count(); err == nil {
+		response.AckCount = count
+	}
+
+	return response
+}
+
+// loadGroupTags 批量获取群组标签，返回群组 ID 到标签列表的映射，没有标签的群组为空列表
+func loadGroupTags(ctx context.Context, db *gorm.DB, groupIDs []string) (map[string][]string, error) {
+	result := make(map[string][]string, len(groupIDs))
+	for _, groupID := range groupIDs {
+		result[groupID] = []string{}
+	}
+	if len(groupIDs) == 0 {
+		return result, nil
+	}
+
+	tq := dao.Use(db).GroupTag
+	tags, err := tq.WithContext(ctx).Where(tq.GroupID.In(groupIDs...)).Order(tq.Tag).Find()
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range tags {
+		result[tag.GroupID] = append(result[tag.GroupID], tag.Tag)
+	}
+
+	return result, nil
+}
+
+// normalizeGroupTags 去除空白、转为小写并去重
+func normalizeGroupTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool, len(tags))
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = NormalizeGroupTag(tag)
+		if tag == "" || utf8.RuneCountInString(tag) > maxGroupTagLength || strings.ContainsAny(tag, " \t\n,") {
+			return nil, fmt.Errorf(errInvalidGroupTags)
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+	if len(result) > maxGroupTags {
+		return nil, fmt.Errorf(errInvalidGroupTags)
+	}
+	return result, nil
+}
+
+// NormalizeGroupTag 将标签统一为存储和搜索使用的格式
+func NormalizeGroupTag(tag string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+}
+
+// groupAvatarURL 返回群头像地址，未上传时使用根据群组生成的默认头像
+func groupAvatarURL(db *gorm.DB, group *model.Group) string {
+	if group.AvatarFile == "" {
+		return NewMessageService(db).generateAvatarUrl(group.ID, group.Name)
+	}
+	version := strings.TrimPrefix(strings.TrimSuffix(group.AvatarFile, filepath.Ext(group.AvatarFile)), group.ID+"-")
+	return GroupAvatarPathPrefix + group.ID + "/avatar?v=" + version
+}
+
+func removeGroupAvatarFile(fileName string) {
+	if fileName == "" {
+		return
+	}
+	_ = os.Remove(filepath.Join(config.GetStorageDir(), groupAvatarDirName, fileName))
+}
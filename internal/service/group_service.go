@@ -6,20 +6,27 @@ import (
 	"chat_backend/internal/model"
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"gorm.io/gorm"
 )
 
 const (
 	RoleOwner  = "owner"
+	RoleAdmin  = "admin"
 	RoleMember = "member"
 )
 
 const (
-	StatusPending  = "pending"
-	StatusRejected = "rejected"
-	StatusApproved = "approved"
+	StatusPending   = "pending"
+	StatusRejected  = "rejected"
+	StatusApproved  = "approved"
+	StatusJoined    = "joined"
+	StatusExpired   = "expired"
+	StatusCancelled = "cancelled"
 )
 
 const (
@@ -53,8 +60,21 @@ func NewGroupService(db *gorm.DB) *GroupService {
 	}
 }
 
-// CreateGroup 创建群组
-func (s *GroupService) CreateGroup(ctx context.Context, userID string, name string) (*dto.GroupResponse, error) {
+// CreateGroup 创建群组，未指定时为公开群组、加入需要审批
+func (s *GroupService) CreateGroup(ctx context.Context, userID string, req dto.CreateGroupRequest) (*dto.GroupResponse, error) {
+	if req.Visibility == "" {
+		req.Visibility = GroupVisibilityPublic
+	}
+	if req.JoinPolicy == "" {
+		req.JoinPolicy = JoinPolicyApproval
+	}
+	if !ValidGroupVisibility(req.Visibility) {
+		return nil, fmt.Errorf(errInvalidGroupVisibility)
+	}
+	if !ValidJoinPolicy(req.JoinPolicy) {
+		return nil, fmt.Errorf(errInvalidJoinPolicy)
+	}
+
 	var group model.Group
 
 	err := s.db.Transaction(func(tx *gorm.DB) error {
@@ -62,9 +82,13 @@ func (s *GroupService) CreateGroup(ctx context.Context, userID string, name stri
 		do := q.WithContext(ctx)
 
 		group = model.Group{
-			Name:        name,
+			Name:        req.Name,
 			OwnerID:     userID,
 			MemberCount: 1,
+			Visibility:  req.Visibility,
+			JoinPolicy:  req.JoinPolicy,
+			IsChannel:   req.Channel,
+			IsForum:     req.Forum,
 		}
 
 		if err := do.Create(&group); err != nil {
@@ -84,7 +108,13 @@ func (s *GroupService) CreateGroup(ctx context.Context, userID string, name stri
 			return err
 		}
 
-		return nil
+		return recordGroupAudit(ctx, tx, group.ID, userID, GroupAuditGroupCreated, "", map[string]interface{}{
+			"name":        group.Name,
+			"visibility":  group.Visibility,
+			"join_policy": group.JoinPolicy,
+			"channel":     group.IsChannel,
+			"forum":       group.IsForum,
+		})
 	})
 
 	if err != nil {
@@ -96,6 +126,10 @@ func (s *GroupService) CreateGroup(ctx context.Context, userID string, name stri
 		Name:        group.Name,
 		OwnerID:     group.OwnerID,
 		MemberCount: group.MemberCount,
+		Visibility:  group.Visibility,
+		JoinPolicy:  group.JoinPolicy,
+		Channel:     group.IsChannel,
+		Forum:       group.IsForum,
 		CreatedAt:   group.CreatedAt.Format(time.RFC3339),
 	}, nil
 }
@@ -112,6 +146,8 @@ func (s *GroupService) GetGroupList(ctx context.Context, userID string, role str
 	switch role {
 	case RoleOwner:
 		query = query.Where(mq.Role.Eq(RoleOwner))
+	case RoleAdmin:
+		query = query.Where(mq.Role.Eq(RoleAdmin))
 	case RoleMember:
 		query = query.Where(mq.Role.Eq(RoleMember))
 	}
@@ -128,6 +164,15 @@ func (s *GroupService) GetGroupList(ctx context.Context, userID string, role str
 	gq := dao.Use(s.db).Group
 	gdo := gq.WithContext(ctx)
 
+	groupIDs := make([]string, 0, len(groupMembers))
+	for _, gm := range groupMembers {
+		groupIDs = append(groupIDs, gm.GroupID)
+	}
+	tags, err := loadGroupTags(ctx, s.db, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	// 获取群组详细信息
 	var responses []*dto.GroupListResponse
 	for _, gm := range groupMembers {
@@ -137,12 +182,20 @@ func (s *GroupService) GetGroupList(ctx context.Context, userID string, role str
 		}
 
 		responses = append(responses, &dto.GroupListResponse{
-			GroupID:     group.ID,
-			Name:        group.Name,
-			OwnerID:     group.OwnerID,
-			MemberCount: group.MemberCount,
-			Role:        gm.Role,
-			CreatedAt:   group.CreatedAt.Format(time.RFC3339),
+			GroupID:             group.ID,
+			Name:                group.Name,
+			OwnerID:             group.OwnerID,
+			MemberCount:         group.MemberCount,
+			Role:                gm.Role,
+			Description:         group.Description,
+			Avatar:              groupAvatarURL(s.db, group),
+			Tags:                tags[group.ID],
+			Visibility:          group.Visibility,
+			JoinPolicy:          group.JoinPolicy,
+			AnnouncementPending: group.Announcement != "" && gm.AnnouncementAckVersion < group.AnnouncementVersion,
+			Channel:             group.IsChannel,
+			Forum:               group.IsForum,
+			CreatedAt:           group.CreatedAt.Format(time.RFC3339),
 		})
 	}
 
@@ -155,7 +208,7 @@ func (s *GroupService) GetGroupDetail(ctx context.Context, userID string, groupI
 	mq := dao.Use(s.db).GroupMember
 	mdo := mq.WithContext(ctx)
 
-	_, err := mdo.Where(mq.GroupID.Eq(groupID), mq.UserID.Eq(userID)).First()
+	self, err := mdo.Where(mq.GroupID.Eq(groupID), mq.UserID.Eq(userID)).First()
 	if err != nil {
 		return nil, fmt.Errorf(errNotInGroup)
 	}
@@ -178,42 +231,51 @@ func (s *GroupService) GetGroupDetail(ctx context.Context, userID string, groupI
 		return nil, err
 	}
 
-	// 获取所有成员
-	var members []model.GroupMember
-	err = mdo.Where(mq.GroupID.Eq(groupID)).Scan(&members)
+	// 只返回前一部分成员，完整列表通过成员列表接口分页获取
+	members, err := listGroupMembers(ctx, s.db, groupID, "", "", maxDetailMembers, "")
 	if err != nil {
 		return nil, err
 	}
 
-	// 构建成员信息列表
-	var memberInfos []dto.GroupMemberInfo
-	for _, m := range members {
-		user, err := udo.Where(uq.ID.Eq(m.UserID)).First()
-		if err != nil {
-			continue
-		}
-		memberInfos = append(memberInfos, dto.GroupMemberInfo{
-			UserID:   m.UserID,
-			Username: user.Username,
-			Role:     m.Role,
-			JoinedAt: m.CreatedAt.Format(time.RFC3339),
-		})
+	tags, err := loadGroupTags(ctx, s.db, []string{groupID})
+	if err != nil {
+		return nil, err
+	}
+
+	var announcement *dto.GroupAnnouncementResponse
+	if group.Announcement != "" {
+		announcement = s.toGroupAnnouncementResponse(ctx, group, self)
 	}
 
 	return &dto.GroupDetailResponse{
-		GroupID:     group.ID,
-		Name:        group.Name,
-		OwnerID:     group.OwnerID,
-		OwnerName:   owner.Username,
-		MemberCount: group.MemberCount,
-		CreatedAt:   group.CreatedAt.Format(time.RFC3339),
-		Members:     memberInfos,
+		GroupID:      group.ID,
+		Name:         group.Name,
+		OwnerID:      group.OwnerID,
+		OwnerName:    owner.Username,
+		MemberCount:  group.MemberCount,
+		MaxMembers:   group.MaxMembers,
+		Description:  group.Description,
+		Avatar:       groupAvatarURL(s.db, group),
+		Tags:         tags[groupID],
+		Visibility:   group.Visibility,
+		JoinPolicy:   group.JoinPolicy,
+		Announcement: announcement,
+		Topic:        group.Topic,
+		Welcome:      group.WelcomeMessage,
+		MuteAll:      group.MuteAll,
+		SlowMode:     group.SlowModeSeconds,
+		Channel:      group.IsChannel,
+		Forum:        group.IsForum,
+		CreatedAt:    group.CreatedAt.Format(time.RFC3339),
+		Members:      members.Members,
+		MoreMembers:  members.HasMore,
 	}, nil
 }
 
-// JoinGroup 加入群组
-func (s *GroupService) JoinGroup(ctx context.Context, userID string, groupID string, inviteCode string) (*dto.JoinGroupResponse, error) {
+// JoinGroup 直接加入开放加入的群组，通过邀请码加入使用 JoinGroupByCode
+func (s *GroupService) JoinGroup(ctx context.Context, userID string, groupID string) (*dto.JoinGroupResponse, error) {
 	var group *model.Group
+	var memberEvent *dto.GroupMemberEvent
 
 	err := s.db.Transaction(func(tx *gorm.DB) error {
 		gq := dao.Use(tx).Group
@@ -225,6 +287,14 @@ func (s *GroupService) JoinGroup(ctx context.Context, userID string, groupID str
 			return fmt.Errorf(errGroupNotFound)
 		}
 
+		if err := checkJoinPolicy(group, true); err != nil {
+			return err
+		}
+
+		if err := checkGroupBan(ctx, tx, groupID, userID); err != nil {
+			return err
+		}
+
 		mq := dao.Use(tx).GroupMember
 		mdo := mq.WithContext(ctx)
 
@@ -243,12 +313,20 @@ func (s *GroupService) JoinGroup(ctx context.Context, userID string, groupID str
 			return err
 		}
 
-		_, err = gdo.Where(gq.ID.Eq(groupID)).UpdateSimple(gq.MemberCount.Add(1))
-		if err != nil {
+		if err := incrementGroupMemberCount(ctx, tx, groupID); err != nil {
+			return err
+		}
+
+		if err := enqueueWebhookEvent(ctx, tx, newMemberWebhookEvent(WebhookEventGroupMemberJoined, groupID, userID, "")); err != nil {
+			return err
+		}
+
+		if err := recordGroupAudit(ctx, tx, groupID, userID, GroupAuditMemberJoined, userID, nil); err != nil {
 			return err
 		}
 
-		return nil
+		memberEvent, err = recordGroupMemberEvent(ctx, tx, groupID, GroupMemberJoined, userID, "")
+		return err
 	})
 
 	if err != nil {
@@ -256,13 +334,14 @@ func (s *GroupService) JoinGroup(ctx context.Context, userID string, groupID str
 	}
 
 	return &dto.JoinGroupResponse{
-		GroupID: group.ID,
-		Name:    group.Name,
-		Status:  "joined",
+		GroupID:     group.ID,
+		Name:        group.Name,
+		Status:      "joined",
+		MemberEvent: memberEvent,
 	}, nil
 }
 
-// RequestJoinGroup 申请加入群组
+// RequestJoinGroup 申请加入群组，开放加入的群组直接加入
 func (s *GroupService) RequestJoinGroup(ctx context.Context, userID string, groupID string, message string) (*dto.RequestJoinGroupResponse, error) {
 	gq := dao.Use(s.db).Group
 	gdo := gq.WithContext(ctx)
@@ -272,6 +351,26 @@ func (s *GroupService) RequestJoinGroup(ctx context.Context, userID string, grou
 		return nil, fmt.Errorf(errGroupNotFound)
 	}
 
+	if err := checkJoinPolicy(group, false); err != nil {
+		return nil, err
+	}
+	if group.JoinPolicy == JoinPolicyOpen {
+		joined, err := s.JoinGroup(ctx, userID, groupID)
+		if err != nil {
+			return nil, err
+		}
+		return &dto.RequestJoinGroupResponse{
+			GroupID:     joined.GroupID,
+			Name:        joined.Name,
+			Status:      joined.Status,
+			MemberEvent: joined.MemberEvent,
+		}, nil
+	}
+
+	if err := checkGroupBan(ctx, s.db, groupID, userID); err != nil {
+		return nil, err
+	}
+
 	mq := dao.Use(s.db).GroupMember
 	mdo := mq.WithContext(ctx)
 
@@ -280,51 +379,79 @@ func (s *GroupService) RequestJoinGroup(ctx context.Context, userID string, grou
 		return nil, fmt.Errorf(errAlreadyInGroup)
 	}
 
-	rq := dao.Use(s.db).GroupJoinRequest
-	rdo := rq.WithContext(ctx)
+	var status string
+	var memberEvent *dto.GroupMemberEvent
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		status, memberEvent, err = createJoinRequest(ctx, tx, userID, groupID, message, "")
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.RequestJoinGroupResponse{
+		GroupID:     group.ID,
+		Name:        group.Name,
+		Status:      status,
+		MemberEvent: memberEvent,
+	}, nil
+}
 
-	sevenDaysAgo := time.Now().AddDate(0, 0, -7)
+// createJoinRequest 创建入群申请，已有未过期的待处理申请或 7 天内被拒绝时不能再次申请。
+// 申请符合群组的自动审批规则时直接加入群组并返回 StatusJoined 和成员加入事件，否则等待审批并返回 StatusPending；
+// inviteCode 为通过邀请链接申请时使用的邀请码
+func createJoinRequest(ctx context.Context, tx *gorm.DB, userID string, groupID string, message string, inviteCode string) (string, *dto.GroupMemberEvent, error) {
+	rq := dao.Use(tx).GroupJoinRequest
+	rdo := rq.WithContext(ctx)
 
-	_, err = rdo.Where(
+	_, err := rdo.Where(
 		rq.SenderID.Eq(userID),
 		rq.TargetGroupID.Eq(groupID),
 		rq.Status.Eq(StatusPending),
-		rq.CreatedAt.Gte(sevenDaysAgo),
+		rq.CreatedAt.Gt(joinRequestExpiredBefore()),
 	).First()
 	if err == nil {
-		return nil, fmt.Errorf(errPendingRequestExists)
+		return "", nil, fmt.Errorf(errPendingRequestExists)
 	}
 
 	_, err = rdo.Where(
 		rq.SenderID.Eq(userID),
 		rq.TargetGroupID.Eq(groupID),
 		rq.Status.Eq(StatusRejected),
-		rq.CreatedAt.Gte(sevenDaysAgo),
+		rq.CreatedAt.Gte(time.Now().Add(-joinRequestCooldown)),
 	).First()
 	if err == nil {
-		return nil, fmt.Errorf(errCannotRequestCooldown)
+		return "", nil, fmt.Errorf(errCannotRequestCooldown)
 	}
 
-	joinRequest := model.GroupJoinRequest{
+	joinRequest := &model.GroupJoinRequest{
 		SenderID:      userID,
 		TargetGroupID: groupID,
 		Status:        StatusPending,
 		Message:       message,
 	}
 
-	if err := rdo.Create(&joinRequest); err != nil {
-		return nil, err
+	rule, err := matchJoinRule(ctx, tx, groupID, userID, inviteCode)
+	if err != nil {
+		return "", nil, err
+	}
+	if rule != nil {
+		memberEvent, err := autoApproveJoinRequest(ctx, tx, joinRequest, rule)
+		if err != nil {
+			return "", nil, err
+		}
+		return StatusJoined, memberEvent, nil
 	}
 
-	return &dto.RequestJoinGroupResponse{
-		GroupID: group.ID,
-		Name:    group.Name,
-		Status:  errStatusPending,
-	}, nil
+	if err := rdo.Create(joinRequest); err != nil {
+		return "", nil, err
+	}
+	return StatusPending, nil, nil
 }
 
-// LeaveGroup 退出群组
-func (s *GroupService) LeaveGroup(ctx context.Context, userID string, groupID string) error {
+// LeaveGroup 退出群组，返回在事务中记录的成员退出事件
+func (s *GroupService) LeaveGroup(ctx context.Context, userID string, groupID string) (*dto.GroupMemberEvent, error) {
+	var memberEvent *dto.GroupMemberEvent
 	err := s.db.Transaction(func(tx *gorm.DB) error {
 		gq := dao.Use(tx).Group
 		gdo := gq.WithContext(ctx)
@@ -351,19 +478,31 @@ func (s *GroupService) LeaveGroup(ctx context.Context, userID string, groupID st
 			return err
 		}
 
-		_, err = gdo.Where(gq.ID.Eq(groupID)).UpdateSimple(gq.MemberCount.Sub(1))
-		if err != nil {
+		if err := decrementGroupMemberCount(ctx, tx, groupID); err != nil {
 			return err
 		}
 
-		return nil
+		if err := enqueueWebhookEvent(ctx, tx, newMemberWebhookEvent(WebhookEventGroupMemberLeft, groupID, userID, "")); err != nil {
+			return err
+		}
+
+		if err := recordGroupAudit(ctx, tx, groupID, userID, GroupAuditMemberLeft, userID, nil); err != nil {
+			return err
+		}
+
+		memberEvent, err = recordGroupMemberEvent(ctx, tx, groupID, GroupMemberLeft, userID, "")
+		return err
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	return memberEvent, nil
 }
 
-// DisbandGroup 解散群组
-func (s *GroupService) DisbandGroup(ctx context.Context, userID string, groupID string) error {
+// DisbandGroup 解散群组，返回解散前的成员ID，用于通知成员
+func (s *GroupService) DisbandGroup(ctx context.Context, userID string, groupID string) ([]string, error) {
+	var memberIDs []string
 	err := s.db.Transaction(func(tx *gorm.DB) error {
 		gq := dao.Use(tx).Group
 		gdo := gq.WithContext(ctx)
@@ -380,6 +519,24 @@ func (s *GroupService) DisbandGroup(ctx context.Context, userID string, groupID
 		mq := dao.Use(tx).GroupMember
 		mdo := mq.WithContext(ctx)
 
+		// 在删除成员前通知，成员订阅的 Webhook 也能收到解散事件
+		if err = mdo.Where(mq.GroupID.Eq(groupID)).Pluck(mq.UserID, &memberIDs); err != nil {
+			return err
+		}
+		err = enqueueWebhookEvent(ctx, tx, webhookEvent{
+			Name:    WebhookEventGroupDisbanded,
+			GroupID: groupID,
+			UserIDs: memberIDs,
+			Data: dto.WebhookGroupData{
+				GroupID:    groupID,
+				Name:       group.Name,
+				OperatorID: userID,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
 		_, err = mdo.Where(mq.GroupID.Eq(groupID)).Delete()
 		if err != nil {
 			return err
@@ -390,14 +547,22 @@ func (s *GroupService) DisbandGroup(ctx context.Context, userID string, groupID
 			return err
 		}
 
-		return nil
+		// 审计日志在群组解散后保留
+		return recordGroupAudit(ctx, tx, groupID, userID, GroupAuditGroupDisbanded, "", map[string]interface{}{
+			"name":         group.Name,
+			"member_count": len(memberIDs),
+		})
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	return memberIDs, nil
 }
 
-// TransferGroup 转让群组
-func (s *GroupService) TransferGroup(ctx context.Context, userID string, groupID string, newOwnerID string) error {
+// TransferGroup 转让群组，返回在事务中记录的群主转让事件
+func (s *GroupService) TransferGroup(ctx context.Context, userID string, groupID string, newOwnerID string) (*dto.GroupMemberEvent, error) {
+	var memberEvent *dto.GroupMemberEvent
 	err := s.db.Transaction(func(tx *gorm.DB) error {
 		gq := dao.Use(tx).Group
 		gdo := gq.WithContext(ctx)
@@ -434,128 +599,113 @@ func (s *GroupService) TransferGroup(ctx context.Context, userID string, groupID
 			return err
 		}
 
-		return nil
+		if err := recordGroupAudit(ctx, tx, groupID, userID, GroupAuditOwnershipTransferred, newOwnerID, nil); err != nil {
+			return err
+		}
+
+		memberEvent, err = recordGroupMemberEvent(ctx, tx, groupID, GroupMemberOwnerTransferred, newOwnerID, userID)
+		return err
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	return memberEvent, nil
 }
 
-// RemoveMember 移除群组成员
-func (s *GroupService) RemoveMember(ctx context.Context, userID string, groupID string, targetUserID string) error {
+// RemoveMember 移除群组成员，返回在事务中记录的成员移除事件
+func (s *GroupService) RemoveMember(ctx context.Context, userID string, groupID string, targetUserID string) (*dto.GroupMemberEvent, error) {
+	var memberEvent *dto.GroupMemberEvent
 	err := s.db.Transaction(func(tx *gorm.DB) error {
-		gq := dao.Use(tx).Group
-		gdo := gq.WithContext(ctx)
-
-		group, err := gdo.Where(gq.ID.Eq(groupID)).First()
-		if err != nil {
-			return fmt.Errorf(errGroupNotFound)
-		}
-
-		if group.OwnerID != userID {
-			return fmt.Errorf(errPermissionDenied)
+		if _, err := requireGroupPermission(ctx, tx, userID, groupID, PermissionRemoveMember); err != nil {
+			return err
 		}
 
 		if targetUserID == userID {
 			return fmt.Errorf(errCannotRemoveYourself)
 		}
 
-		mq := dao.Use(tx).GroupMember
-		mdo := mq.WithContext(ctx)
-
-		targetMember, err := mdo.Where(mq.GroupID.Eq(groupID), mq.UserID.Eq(targetUserID)).First()
+		targetRole, err := getGroupMemberRole(ctx, tx, groupID, targetUserID)
 		if err != nil {
-			return fmt.Errorf(errTargetUserNotInGroup)
+			return err
 		}
-
-		if targetMember.Role == RoleOwner {
-			return fmt.Errorf(errCannotRemoveOwner)
+		if targetRole == "" {
+			return fmt.Errorf(errTargetUserNotInGroup)
 		}
 
-		_, err = mdo.Where(mq.GroupID.Eq(groupID), mq.UserID.Eq(targetUserID)).Delete()
-		if err != nil {
+		if err := checkCanRemoveRole(ctx, tx, groupID, userID, targetRole); err != nil {
 			return err
 		}
 
-		_, err = gdo.Where(gq.ID.Eq(groupID)).UpdateSimple(gq.MemberCount.Sub(1))
+		memberEvent, err = removeGroupMember(ctx, tx, groupID, targetUserID, userID)
 		if err != nil {
 			return err
 		}
 
-		return nil
+		return recordGroupAudit(ctx, tx, groupID, userID, GroupAuditMemberRemoved, targetUserID, map[string]interface{}{
+			"role": targetRole,
+		})
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	return memberEvent, nil
 }
 
-// JoinGroupByCode 通过邀请码加入群组
-func (s *GroupService) JoinGroupByCode(ctx context.Context, userID string, inviteCode string) (*dto.JoinGroupByCodeResponse, error) {
-	var group *model.Group
-	var groupID string
-
-	err := s.db.Transaction(func(tx *gorm.DB) error {
-		icQ := dao.Use(tx).InvitationCode
-		icDo := icQ.WithContext(ctx)
-
-		invitationCode, err := icDo.Where(icQ.Code.Eq(inviteCode)).First()
-		if err != nil {
-			return fmt.Errorf(errInvalidInviteCode)
-		}
-
-		groupID = invitationCode.UUID
-
-		gq := dao.Use(tx).Group
-		gdo := gq.WithContext(ctx)
-
-		group, err = gdo.Where(gq.ID.Eq(groupID)).First()
-		if err != nil {
-			return fmt.Errorf(errGroupNotFound)
-		}
-
-		mq := dao.Use(tx).GroupMember
-		mdo := mq.WithContext(ctx)
-
-		_, err = mdo.Where(mq.GroupID.Eq(groupID), mq.UserID.Eq(userID)).First()
-		if err == nil {
-			return fmt.Errorf(errAlreadyInGroup)
-		}
+// checkCanRemoveRole 确认操作者可以移除指定角色的成员：群主不能被移除，其他成员只能被角色更高的成员移除
+func checkCanRemoveRole(ctx context.Context, tx *gorm.DB, groupID string, operatorID string, targetRole string) error {
+	if targetRole == RoleOwner {
+		return fmt.Errorf(errCannotRemoveOwner)
+	}
 
-		groupMember := model.GroupMember{
-			GroupID: groupID,
-			UserID:  userID,
-			Role:    RoleMember,
-		}
+	// 管理员之间不能互相移除
+	operatorRole, err := getGroupMemberRole(ctx, tx, groupID, operatorID)
+	if err != nil {
+		return err
+	}
+	if roleRank(operatorRole) <= roleRank(targetRole) {
+		return fmt.Errorf(errPermissionDenied)
+	}
 
-		if err = mdo.Create(&groupMember); err != nil {
-			return err
-		}
+	return nil
+}
 
-		_, err = gdo.Where(gq.ID.Eq(groupID)).UpdateSimple(gq.MemberCount.Add(1))
-		if err != nil {
-			return err
-		}
+// removeGroupMember 在事务中删除群成员、更新成员数、发送成员退出的 Webhook 事件并记录成员移除的系统消息
+func removeGroupMember(ctx context.Context, tx *gorm.DB, groupID string, targetUserID string, operatorID string) (*dto.GroupMemberEvent, error) {
+	mq := dao.Use(tx).GroupMember
+	if _, err := mq.WithContext(ctx).Where(mq.GroupID.Eq(groupID), mq.UserID.Eq(targetUserID)).Delete(); err != nil {
+		return nil, err
+	}
 
-		return nil
-	})
+	if err := decrementGroupMemberCount(ctx, tx, groupID); err != nil {
+		return nil, err
+	}
 
-	if err != nil {
+	if err := enqueueWebhookEvent(ctx, tx, newMemberWebhookEvent(WebhookEventGroupMemberLeft, groupID, targetUserID, operatorID)); err != nil {
 		return nil, err
 	}
 
-	return &dto.JoinGroupByCodeResponse{
-		GroupID: group.ID,
-		Name:    group.Name,
-		Status:  errStatusJoined,
-	}, nil
+	return recordGroupMemberEvent(ctx, tx, groupID, GroupMemberRemoved, targetUserID, operatorID)
 }
 
-// SearchGroup 搜索群组
-func (s *GroupService) SearchGroup(ctx context.Context, groupName string) ([]*dto.SearchGroupResponse, error) {
+// SearchGroup 按名称和标签搜索公开群组，两者都传时需要同时满足
+func (s *GroupService) SearchGroup(ctx context.Context, groupName string, tag string) ([]*dto.SearchGroupResponse, error) {
 	gq := dao.Use(s.db).Group
 	gdo := gq.WithContext(ctx)
 
 	var groups []model.Group
 
-	err := gdo.Where(gq.Name.Like("%" + groupName + "%")).Scan(&groups)
+	query := gdo.Where(gq.Visibility.Eq(GroupVisibilityPublic))
+	if groupName != "" {
+		query = query.Where(gq.Name.Like(containsPattern(groupName)))
+	}
+	if tag != "" {
+		tq := dao.Use(s.db).GroupTag
+		query = query.Where(gq.Columns(gq.ID).In(tq.WithContext(ctx).Select(tq.GroupID).Where(tq.Tag.Eq(NormalizeGroupTag(tag)))))
+	}
+
+	err := query.Scan(&groups)
 	if err != nil {
 		return nil, err
 	}
@@ -564,50 +714,56 @@ func (s *GroupService) SearchGroup(ctx context.Context, groupName string) ([]*dt
 		return []*dto.SearchGroupResponse{}, nil
 	}
 
+	groupIDs := make([]string, 0, len(groups))
+	for _, group := range groups {
+		groupIDs = append(groupIDs, group.ID)
+	}
+	tags, err := loadGroupTags(ctx, s.db, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	responses := make([]*dto.SearchGroupResponse, 0, len(groups))
 	for _, group := range groups {
-		responses = append(responses, &dto.SearchGroupResponse{
-			GroupID:     group.ID,
-			Name:        group.Name,
-			OwnerID:     group.OwnerID,
-			MemberCount: group.MemberCount,
-			CreatedAt:   group.CreatedAt.Format(time.RFC3339),
-		})
+		responses = append(responses, s.toSearchGroupResponse(&group, tags[group.ID]))
 	}
 
 	return responses, nil
 }
 
-// GetPendingJoinRequests 获取待审核的入群请求
-func (s *GroupService) GetPendingJoinRequests(ctx context.Context, userID string) ([]*dto.PendingJoinRequest, error) {
-	gq := dao.Use(s.db).Group
-	gdo := gq.WithContext(ctx)
-
-	groups, err := gdo.Where(gq.OwnerID.Eq(userID)).Find()
+// GetPendingJoinRequests 获取用户可以审批的待审核入群请求，按申请时间先后排列；groupID 不为空时只返回该群组的请求
+func (s *GroupService) GetPendingJoinRequests(ctx context.Context, userID string, groupID string) ([]*dto.PendingJoinRequest, error) {
+	groupIDs, err := s.getGroupIDsWithPermission(ctx, userID, PermissionApproveJoin)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(groups) == 0 {
+	if groupID != "" {
+		if !slices.Contains(groupIDs, groupID) {
+			return nil, fmt.Errorf(errPermissionDenied)
+		}
+		groupIDs = []string{groupID}
+	}
+
+	if len(groupIDs) == 0 {
 		return []*dto.PendingJoinRequest{}, nil
 	}
 
-	groupIDs := make([]string, 0, len(groups))
-	for _, group := range groups {
-		groupIDs = append(groupIDs, group.ID)
+	gq := dao.Use(s.db).Group
+	groups, err := gq.WithContext(ctx).Where(gq.ID.In(groupIDs...)).Find()
+	if err != nil {
+		return nil, err
 	}
 
 	rq := dao.Use(s.db).GroupJoinRequest
 	rdo := rq.WithContext(ctx)
 
-	sevenDaysAgo := time.Now().AddDate(0, 0, -7)
-
 	var requests []model.GroupJoinRequest
 	err = rdo.Where(
 		rq.TargetGroupID.In(groupIDs...),
 		rq.Status.Eq(StatusPending),
-		rq.CreatedAt.Gte(sevenDaysAgo),
-	).Scan(&requests)
+		rq.CreatedAt.Gt(joinRequestExpiredBefore()),
+	).Order(rq.CreatedAt).Scan(&requests)
 	if err != nil {
 		return nil, err
 	}
@@ -642,6 +798,7 @@ func (s *GroupService) GetPendingJoinRequests(ctx context.Context, userID string
 			Username:  user.Username,
 			Message:   req.Message,
 			CreatedAt: req.CreatedAt.Format(time.RFC3339),
+			ExpiresAt: joinRequestExpiresAt(&req).Format(time.RFC3339),
 		})
 	}
 
@@ -683,44 +840,72 @@ func (s *GroupService) IsGroupMember(ctx context.Context, groupID string, userID
 	return count > 0, nil
 }
 
-// ApproveJoinRequest 审批入群请求
-func (s *GroupService) ApproveJoinRequest(ctx context.Context, userID string, groupID string, senderID string, action string) error {
-	gq := dao.Use(s.db).Group
-	gdo := gq.WithContext(ctx)
-
-	group, err := gdo.Where(gq.ID.Eq(groupID)).First()
-	if err != nil {
-		return fmt.Errorf("group not found")
+// ApproveJoinRequest 审批入群请求（需要审批入群的权限），审批结果和理由记录在申请中，返回用于通知申请者和审批人的事件
+func (s *GroupService) ApproveJoinRequest(ctx context.Context, userID string, groupID string, senderID string, action string, reason string) (*dto.JoinRequestEvent, error) {
+	reason = strings.TrimSpace(reason)
+	if utf8.RuneCountInString(reason) > maxDecisionReasonLength {
+		return nil, fmt.Errorf(errInvalidDecisionReason)
 	}
 
-	if group.OwnerID != userID {
-		return fmt.Errorf("permission denied")
+	if _, err := requireGroupPermission(ctx, s.db, userID, groupID, PermissionApproveJoin); err != nil {
+		return nil, err
 	}
 
 	rq := dao.Use(s.db).GroupJoinRequest
-	rdo := rq.WithContext(ctx)
-
-	sevenDaysAgo := time.Now().AddDate(0, 0, -7)
-
-	joinRequest, err := rdo.Where(
+	joinRequest, err := rq.WithContext(ctx).Where(
 		rq.TargetGroupID.Eq(groupID),
 		rq.SenderID.Eq(senderID),
 		rq.Status.Eq(StatusPending),
-		rq.CreatedAt.Gte(sevenDaysAgo),
+		rq.CreatedAt.Gt(joinRequestExpiredBefore()),
 	).First()
 	if err != nil {
-		return fmt.Errorf("join request not found")
+		return nil, fmt.Errorf(errJoinRequestNotFound)
+	}
+
+	now := time.Now()
+	decision := map[string]interface{}{
+		"decided_by":      userID,
+		"decision_reason": reason,
+		"decided_at":      now,
 	}
 
+	var memberEvent *dto.GroupMemberEvent
 	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// 只处理仍然待处理且未过期的申请，申请在查询之后被撤回、过期或已被其他审批人处理时返回未找到
+		decide := func(status string) error {
+			decision["status"] = status
+			trq := dao.Use(tx).GroupJoinRequest
+			result, err := trq.WithContext(ctx).Where(
+				trq.ID.Eq(joinRequest.ID),
+				trq.Status.Eq(StatusPending),
+				trq.CreatedAt.Gt(joinRequestExpiredBefore()),
+			).Updates(decision)
+			if err != nil {
+				return err
+			}
+			if result.RowsAffected == 0 {
+				return fmt.Errorf(errJoinRequestNotFound)
+			}
+			return nil
+		}
+
 		switch action {
-		case "approve":
+		case errActionApprove:
+			if err := decide(StatusApproved); err != nil {
+				return err
+			}
+
+			// 申请者可能在提交申请后被封禁，在锁定申请之后重新检查
+			if err := checkGroupBan(ctx, tx, groupID, senderID); err != nil {
+				return err
+			}
+
 			mq := dao.Use(tx).GroupMember
 			mdo := mq.WithContext(ctx)
 
 			_, err = mdo.Where(mq.GroupID.Eq(groupID), mq.UserID.Eq(senderID)).First()
 			if err == nil {
-				return fmt.Errorf("already in group")
+				return fmt.Errorf(errAlreadyInGroup)
 			}
 
 			groupMember := model.GroupMember{
@@ -733,30 +918,71 @@ func (s *GroupService) ApproveJoinRequest(ctx context.Context, userID string, gr
 				return err
 			}
 
-			_, err = gdo.Where(gq.ID.Eq(groupID)).UpdateSimple(gq.MemberCount.Add(1))
-			if err != nil {
+			if err := incrementGroupMemberCount(ctx, tx, groupID); err != nil {
 				return err
 			}
 
-			_, err = rdo.Where(
-				rq.ID.Eq(joinRequest.ID),
-			).Delete()
+			err = enqueueWebhookEvent(ctx, tx, newMemberWebhookEvent(WebhookEventGroupMemberJoined, groupID, senderID, userID))
 			if err != nil {
 				return err
 			}
-		case "reject":
-			_, err = rdo.Where(
-				rq.ID.Eq(joinRequest.ID),
-			).Update(rq.Status, StatusRejected)
+
+			if err := updateInvitationUseStatus(ctx, tx, groupID, senderID, errStatusJoined); err != nil {
+				return err
+			}
+
+			err = recordGroupAudit(ctx, tx, groupID, userID, GroupAuditJoinApproved, senderID, map[string]interface{}{
+				"request_id": joinRequest.ID,
+				"reason":     reason,
+			})
 			if err != nil {
 				return err
 			}
+
+			memberEvent, err = recordGroupMemberEvent(ctx, tx, groupID, GroupMemberJoined, senderID, userID)
+			return err
+		case errActionReject:
+			if err := decide(StatusRejected); err != nil {
+				return err
+			}
+
+			if err := updateInvitationUseStatus(ctx, tx, groupID, senderID, StatusRejected); err != nil {
+				return err
+			}
+
+			return recordGroupAudit(ctx, tx, groupID, userID, GroupAuditJoinRejected, senderID, map[string]interface{}{
+				"request_id": joinRequest.ID,
+				"reason":     reason,
+			})
 		default:
-			return fmt.Errorf("invalid action")
+			return fmt.Errorf(errInvalidAction)
 		}
-
-		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	joinRequest.Status = decision["status"].(string)
+	joinRequest.DecidedBy = &userID
+	joinRequest.DecisionReason = reason
+	joinRequest.DecidedAt = &now
+	event, err := s.newJoinRequestEvent(ctx, joinRequest)
+	if err != nil {
+		return nil, err
+	}
+	event.MemberEvent = memberEvent
+	return event, nil
+}
+
+// requireGroupOwner 获取群组并确认用户是群主
+func requireGroupOwner(ctx context.Context, db *gorm.DB, userID string, groupID string) (*model.Group, error) {
+	gq := dao.Use(db).Group
+	group, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).First()
+	if err != nil {
+		return nil, fmt.Errorf(errGroupNotFound)
+	}
+	if group.OwnerID != userID {
+		return nil, fmt.Errorf(errPermissionDenied)
+	}
+	return group, nil
 }
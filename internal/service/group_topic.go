@@ -0,0 +1,268 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	GroupTopicCreated  = "created"
+	GroupTopicPinned   = "pinned"
+	GroupTopicUnpinned = "unpinned"
+
+	maxTopicNameLength = 50
+	maxTopicsPerGroup  = 200
+)
+
+const (
+	errNotForumGroup    = "group is not a forum"
+	errTopicNotFound    = "topic not found"
+	errInvalidTopicName = "invalid topic name"
+	errTooManyTopics    = "too many topics"
+)
+
+// UpdateGroupForum 开启或关闭论坛模式（仅群主）。关闭后已有的话题和话题中的消息保留，重新开启后恢复
+func (s *GroupService) UpdateGroupForum(ctx context.Context, userID string, groupID string, enabled bool) (*dto.GroupProfileEvent, error) {
+	if _, err := requireGroupOwner(ctx, s.db, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		gq := dao.Use(tx).Group
+		if _, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).Update(gq.IsForum, enabled); err != nil {
+			return err
+		}
+
+		return recordGroupAudit(ctx, tx, groupID, userID, GroupAuditForumUpdated, "", map[string]interface{}{
+			"enabled": enabled,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getGroupProfileEvent(ctx, userID, groupID)
+}
+
+// CreateGroupTopic 在论坛模式的群组中创建话题（需要创建话题的权限）
+func (s *GroupService) CreateGroupTopic(ctx context.Context, userID string, groupID string, name string) (*dto.GroupTopicEvent, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxTopicNameLength {
+		return nil, fmt.Errorf(errInvalidTopicName)
+	}
+
+	group, err := requireGroupPermission(ctx, s.db, userID, groupID, PermissionCreateTopic)
+	if err != nil {
+		return nil, err
+	}
+	if !group.IsForum {
+		return nil, fmt.Errorf(errNotForumGroup)
+	}
+
+	tq := dao.Use(s.db).GroupTopic
+	count, err := tq.WithContext(ctx).Where(tq.GroupID.Eq(groupID)).Count()
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxTopicsPerGroup {
+		return nil, fmt.Errorf(errTooManyTopics)
+	}
+
+	topic := &model.GroupTopic{
+		GroupID:   groupID,
+		Name:      name,
+		CreatorID: userID,
+	}
+	if err := tq.WithContext(ctx).Create(topic); err != nil {
+		return nil, err
+	}
+
+	return &dto.GroupTopicEvent{
+		GroupID:    groupID,
+		Action:     GroupTopicCreated,
+		OperatorID: userID,
+		Topic:      toGroupTopicResponse(topic, 0),
+	}, nil
+}
+
+// ListGroupTopics 获取群组的话题及当前用户在每个话题中的未读消息数（仅群成员）。
+// 置顶话题排在最前，其余按最后一条消息的时间倒序排列
+func (s *GroupService) ListGroupTopics(ctx context.Context, userID string, groupID string) ([]dto.GroupTopicResponse, error) {
+	if _, err := s.getGroupMember(ctx, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	tq := dao.Use(s.db).GroupTopic
+	topics, err := tq.WithContext(ctx).Where(tq.GroupID.Eq(groupID)).Find()
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(topics, func(i, j int) bool {
+		if topics[i].IsPinned != topics[j].IsPinned {
+			return topics[i].IsPinned
+		}
+		if topics[i].IsPinned {
+			return topics[i].PinnedAt.After(*topics[j].PinnedAt)
+		}
+		return topicActiveAt(topics[i]).After(topicActiveAt(topics[j]))
+	})
+
+	rows, err := countTopicUnread(ctx, s.db, userID, []string{groupID})
+	if err != nil {
+		return nil, err
+	}
+	unread := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		unread[row.TopicID] = row.Unread
+	}
+
+	result := make([]dto.GroupTopicResponse, 0, len(topics))
+	for _, topic := range topics {
+		result = append(result, toGroupTopicResponse(topic, unread[topic.ID]))
+	}
+
+	return result, nil
+}
+
+// PinGroupTopic 置顶或取消置顶话题（需要置顶消息的权限）
+func (s *GroupService) PinGroupTopic(ctx context.Context, userID string, groupID string, topicID string, pinned bool) (*dto.GroupTopicEvent, error) {
+	if _, err := requireGroupPermission(ctx, s.db, userID, groupID, PermissionPinMessage); err != nil {
+		return nil, err
+	}
+
+	topic, err := getGroupTopic(ctx, s.db, groupID, topicID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pinnedAt *time.Time
+	action := GroupTopicUnpinned
+	if pinned {
+		now := time.Now()
+		pinnedAt = &now
+		action = GroupTopicPinned
+	}
+
+	tq := dao.Use(s.db).GroupTopic
+	if _, err := tq.WithContext(ctx).Where(tq.ID.Eq(topic.ID)).Updates(map[string]interface{}{
+		"is_pinned": pinned,
+		"pinned_at": pinnedAt,
+	}); err != nil {
+		return nil, err
+	}
+	topic.IsPinned = pinned
+	topic.PinnedAt = pinnedAt
+
+	return &dto.GroupTopicEvent{
+		GroupID:    groupID,
+		Action:     action,
+		OperatorID: userID,
+		Topic:      toGroupTopicResponse(topic, 0),
+	}, nil
+}
+
+// MarkGroupTopicRead 将话题中当前时间之前的消息标记为已读（仅群成员）
+func (s *GroupService) MarkGroupTopicRead(ctx context.Context, userID string, groupID string, topicID string) error {
+	if _, err := s.getGroupMember(ctx, userID, groupID); err != nil {
+		return err
+	}
+
+	topic, err := getGroupTopic(ctx, s.db, groupID, topicID)
+	if err != nil {
+		return err
+	}
+
+	return dao.Use(s.db).GroupTopicRead.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(&model.GroupTopicRead{
+		TopicID:    topic.ID,
+		UserID:     userID,
+		LastReadAt: time.Now(),
+	})
+}
+
+// CheckGroupTopic 检查话题是否属于群组且群组处于论坛模式，发送话题消息前调用
+func (s *GroupService) CheckGroupTopic(ctx context.Context, groupID string, topicID string) error {
+	gq := dao.Use(s.db).Group
+	group, err := gq.WithContext(ctx).Where(gq.ID.Eq(groupID)).First()
+	if err != nil {
+		return fmt.Errorf(errGroupNotFound)
+	}
+	if !group.IsForum {
+		return fmt.Errorf(errNotForumGroup)
+	}
+
+	_, err = getGroupTopic(ctx, s.db, groupID, topicID)
+	return err
+}
+
+// getGroupTopic 获取属于群组的话题
+func getGroupTopic(ctx context.Context, db *gorm.DB, groupID string, topicID string) (*model.GroupTopic, error) {
+	tq := dao.Use(db).GroupTopic
+	topic, err := tq.WithContext(ctx).Where(tq.ID.Eq(topicID), tq.GroupID.Eq(groupID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(errTopicNotFound)
+		}
+		return nil, err
+	}
+	return topic, nil
+}
+
+// topicUnread 用户在一个话题中的未读消息数
+type topicUnread struct {
+	GroupID string
+	TopicID string
+	Unread  int64
+}
+
+// countTopicUnread 统计用户在群组各话题中的未读消息数，没有未读消息的话题不返回。
+// 没有已读记录的话题从加入群组的时间开始计算，自己发送的消息不计入
+func countTopicUnread(ctx context.Context, db *gorm.DB, userID string, groupIDs []string) ([]topicUnread, error) {
+	var rows []topicUnread
+	err := db.WithContext(ctx).Raw(`
+		SELECT m.target_id AS group_id, m.topic_id, COUNT(*) AS unread
+		FROM messages m
+		JOIN group_members gm ON gm.group_id = m.target_id AND gm.user_id = ?
+		LEFT JOIN group_topic_reads r ON r.topic_id = m.topic_id AND r.user_id = gm.user_id
+		WHERE m.type = ? AND m.target_id IN (?) AND m.topic_id IS NOT NULL
+			AND m.from_user_id <> gm.user_id
+			AND m.created_at > COALESCE(r.last_read_at, gm.created_at)
+		GROUP BY m.target_id, m.topic_id
+	`, userID, string(model.MessageTypeGroup), groupIDs).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// topicActiveAt 话题最后活跃的时间，没有消息时为创建时间
+func topicActiveAt(topic *model.GroupTopic) time.Time {
+	if topic.LastMessageAt != nil {
+		return *topic.LastMessageAt
+	}
+	return topic.CreatedAt
+}
+
+func toGroupTopicResponse(topic *model.GroupTopic, unread int64) dto.GroupTopicResponse {
+	return dto.GroupTopicResponse{
+		TopicID:       topic.ID,
+		GroupID:       topic.GroupID,
+		Name:          topic.Name,
+		CreatorID:     topic.CreatorID,
+		IsPinned:      topic.IsPinned,
+		PinnedAt:      topic.PinnedAt,
+		LastMessageAt: topic.LastMessageAt,
+		UnreadCount:   unread,
+		CreatedAt:     topic.CreatedAt,
+	}
+}
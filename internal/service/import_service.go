@@ -0,0 +1,641 @@
+package service
+
+import (
+	"bufio"
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"chat_backend/pkg/logger"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+const (
+	ImportStatusRunning   = "running"
+	ImportStatusCompleted = "completed"
+	ImportStatusFailed    = "failed"
+
+	ImportRecordUser    = "user"
+	ImportRecordGroup   = "group"
+	ImportRecordMember  = "member"
+	ImportRecordMessage = "message"
+
+	importBatchSize = 500
+	// importMaxLineSize NDJSON 单行最大长度
+	importMaxLineSize = 4 * 1024 * 1024
+	// unusablePasswordHash 不能通过密码登录的账号（未提供密码的导入用户、机器人）使用的密码哈希
+	unusablePasswordHash = "!"
+)
+
+const (
+	errImportAlreadyCompleted = "this file has already been imported"
+	errImportUnfinished       = "a previous import of this file did not finish, rerun with resume to continue"
+	errDryRunRollback         = "dry run rollback"
+)
+
+// ImportOptions 导入选项
+type ImportOptions struct {
+	DryRun bool // 仅校验并统计，不写入数据库
+	Resume bool // 从上次失败的位置继续导入
+}
+
+// importValidationError 导入记录校验失败
+type importValidationError struct {
+	index int
+	msg   string
+}
+
+func (e *importValidationError) Error() string {
+	return fmt.Sprintf("record %d: %s", e.index, e.msg)
+}
+
+// importState 导入过程中外部ID到本系统ID的映射
+type importState struct {
+	committed map[string]string // 已提交的映射
+	batch     map[string]string // 当前批次新增、尚未提交的映射
+}
+
+func (st *importState) lookup(kind string, externalID string) (string, bool) {
+	key := kind + ":" + externalID
+	if id, ok := st.batch[key]; ok {
+		return id, true
+	}
+	id, ok := st.committed[key]
+	return id, ok
+}
+
+type ImportService struct {
+	db *gorm.DB
+}
+
+func NewImportService(db *gorm.DB) *ImportService {
+	return &ImportService{
+		db: db,
+	}
+}
+
+// Import 从 JSON 或 NDJSON 文件批量导入用户、群组、群成员和聊天记录
+// 记录按批次在事务中提交，每批提交后记录进度，失败后可以通过 Resume 从断点继续；
+// 校验失败的记录会被跳过并记录在 ValidationErrs 中，不会中断导入；
+// DryRun 模式下所有写入都在一个最终回滚的事务中执行，只输出统计和校验错误
+func (s *ImportService) Import(ctx context.Context, path string, opts ImportOptions) (*dto.ImportReport, error) {
+	report := &dto.ImportReport{DryRun: opts.DryRun}
+	state := &importState{
+		committed: make(map[string]string),
+		batch:     make(map[string]string),
+	}
+
+	var job *model.ImportJob
+	if !opts.DryRun {
+		checksum, err := importFileChecksum(path)
+		if err != nil {
+			return nil, err
+		}
+
+		job, err = s.prepareImportJob(ctx, path, checksum, opts.Resume, state)
+		if err != nil {
+			return nil, err
+		}
+		report.JobID = job.ID
+		report.Skipped = job.Processed
+	}
+
+	reader, err := openImportReader(path)
+	if err != nil {
+		return report, err
+	}
+	defer reader.Close()
+
+	if opts.DryRun {
+		err = s.db.Transaction(func(tx *gorm.DB) error {
+			if err := s.runImport(ctx, tx, reader, nil, state, report); err != nil {
+				return err
+			}
+			return errors.New(errDryRunRollback)
+		})
+		if err != nil && err.Error() != errDryRunRollback {
+			return report, err
+		}
+		return report, nil
+	}
+
+	if err := s.runImport(ctx, s.db, reader, job, state, report); err != nil {
+		jq := dao.Use(s.db).ImportJob
+		if _, updateErr := jq.WithContext(ctx).Where(jq.ID.Eq(job.ID)).UpdateSimple(
+			jq.Status.Value(ImportStatusFailed),
+			jq.Error.Value(err.Error()),
+		); updateErr != nil {
+			logger.GetLogger().Errorw("Failed to update import job status", "job_id", job.ID, "error", updateErr)
+		}
+		return report, err
+	}
+
+	jq := dao.Use(s.db).ImportJob
+	if _, err := jq.WithContext(ctx).Where(jq.ID.Eq(job.ID)).UpdateSimple(
+		jq.Status.Value(ImportStatusCompleted),
+		jq.Error.Value(""),
+		jq.CompletedAt.Value(time.Now()),
+	); err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
+
+// prepareImportJob 创建导入任务，或在断点续传时加载已有任务和ID映射
+func (s *ImportService) prepareImportJob(ctx context.Context, path string, checksum string, resume bool, state *importState) (*model.ImportJob, error) {
+	jq := dao.Use(s.db).ImportJob
+	jdo := jq.WithContext(ctx)
+
+	job, err := jdo.Where(jq.Checksum.Eq(checksum)).First()
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+
+		job = &model.ImportJob{
+			Source:   path,
+			Checksum: checksum,
+			Status:   ImportStatusRunning,
+		}
+		if err := jdo.Create(job); err != nil {
+			return nil, err
+		}
+		return job, nil
+	}
+
+	if job.Status == ImportStatusCompleted {
+		return nil, fmt.Errorf(errImportAlreadyCompleted)
+	}
+	if !resume {
+		return nil, fmt.Errorf(errImportUnfinished)
+	}
+
+	mq := dao.Use(s.db).ImportMapping
+	mappings, err := mq.WithContext(ctx).Where(mq.JobID.Eq(job.ID)).Find()
+	if err != nil {
+		return nil, err
+	}
+	for _, mapping := range mappings {
+		state.committed[mapping.Kind+":"+mapping.ExternalID] = mapping.InternalID
+	}
+
+	if _, err := jdo.Where(jq.ID.Eq(job.ID)).Update(jq.Status, ImportStatusRunning); err != nil {
+		return nil, err
+	}
+
+	logger.GetLogger().Infow("Resuming import job", "job_id", job.ID, "processed", job.Processed, "mappings", len(mappings))
+	return job, nil
+}
+
+// runImport 按批次读取并提交记录；job 为空表示 DryRun
+func (s *ImportService) runImport(ctx context.Context, db *gorm.DB, reader importReader, job *model.ImportJob, state *importState, report *dto.ImportReport) error {
+	skip := 0
+	if job != nil {
+		skip = job.Processed
+	}
+
+	index := 0
+	batch := make([]*dto.ImportRecord, 0, importBatchSize)
+	for {
+		record, err := reader.Next()
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		if record != nil {
+			index++
+			if index <= skip {
+				continue
+			}
+			batch = append(batch, record)
+		}
+
+		if len(batch) > 0 && (len(batch) == importBatchSize || errors.Is(err, io.EOF)) {
+			if err := s.importBatch(ctx, db, batch, index-len(batch)+1, job, state, report); err != nil {
+				return err
+			}
+			batch = batch[:0]
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+	}
+}
+
+// importBatch 在一个事务中导入一批记录并更新任务进度
+func (s *ImportService) importBatch(ctx context.Context, db *gorm.DB, records []*dto.ImportRecord, firstIndex int, job *model.ImportJob, state *importState, report *dto.ImportReport) error {
+	batchReport := dto.ImportReport{}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var messages []*model.Message
+		for i, record := range records {
+			message, err := s.importRecord(ctx, tx, record, firstIndex+i, state, &batchReport)
+			if err != nil {
+				var validationErr *importValidationError
+				if errors.As(err, &validationErr) {
+					// 校验失败的记录在写入前被拒绝，跳过并记录，不中断导入
+					batchReport.Invalid++
+					batchReport.ValidationErrs = append(batchReport.ValidationErrs, err.Error())
+					continue
+				}
+				return err
+			}
+			if message != nil {
+				messages = append(messages, message)
+			}
+		}
+
+		if len(messages) > 0 {
+			if err := dao.Use(tx).Message.WithContext(ctx).CreateInBatches(messages, importBatchSize); err != nil {
+				return err
+			}
+			batchReport.MessagesAdded += len(messages)
+		}
+
+		if job == nil {
+			return nil
+		}
+
+		mappings := make([]*model.ImportMapping, 0, len(state.batch))
+		for key, internalID := range state.batch {
+			kind, externalID, _ := strings.Cut(key, ":")
+			mappings = append(mappings, &model.ImportMapping{
+				JobID:      job.ID,
+				Kind:       kind,
+				ExternalID: externalID,
+				InternalID: internalID,
+			})
+		}
+		if len(mappings) > 0 {
+			if err := dao.Use(tx).ImportMapping.WithContext(ctx).CreateInBatches(mappings, importBatchSize); err != nil {
+				return err
+			}
+		}
+
+		jq := dao.Use(tx).ImportJob
+		_, err := jq.WithContext(ctx).Where(jq.ID.Eq(job.ID)).UpdateSimple(
+			jq.Processed.Add(len(records)),
+			jq.Invalid.Add(batchReport.Invalid),
+		)
+		return err
+	})
+	if err != nil {
+		state.batch = make(map[string]string)
+		return err
+	}
+
+	for key, internalID := range state.batch {
+		state.committed[key] = internalID
+	}
+	state.batch = make(map[string]string)
+	if job != nil {
+		job.Processed += len(records)
+		job.Invalid += batchReport.Invalid
+	}
+
+	report.Processed += len(records)
+	report.UsersCreated += batchReport.UsersCreated
+	report.UsersMatched += batchReport.UsersMatched
+	report.GroupsCreated += batchReport.GroupsCreated
+	report.MembersAdded += batchReport.MembersAdded
+	report.MessagesAdded += batchReport.MessagesAdded
+	report.Invalid += batchReport.Invalid
+	report.ValidationErrs = append(report.ValidationErrs, batchReport.ValidationErrs...)
+
+	logger.GetLogger().Infow("Import batch committed", "dry_run", job == nil, "processed", report.Processed+report.Skipped)
+	return nil
+}
+
+// importRecord 导入单条记录；消息记录不立即写入，而是返回给调用方批量插入
+func (s *ImportService) importRecord(ctx context.Context, tx *gorm.DB, record *dto.ImportRecord, index int, state *importState, report *dto.ImportReport) (*model.Message, error) {
+	invalid := func(format string, args ...interface{}) error {
+		return &importValidationError{index: index, msg: fmt.Sprintf(format, args...)}
+	}
+
+	switch record.Type {
+	case ImportRecordUser:
+		if record.ID == "" || record.Username == "" {
+			return nil, invalid("user requires id and username")
+		}
+		if _, ok := state.lookup(ImportRecordUser, record.ID); ok {
+			return nil, invalid("duplicate user id %q", record.ID)
+		}
+
+		uq := dao.Use(tx).User
+		udo := uq.WithContext(ctx)
+
+		// 只有显式指定 map_to 时才映射到已有用户，避免把导入的记录合并到同名的无关账号
+		if record.MapTo != "" {
+			user, err := udo.Where(uq.Username.Eq(record.MapTo)).First()
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return nil, invalid("map_to user %q not found", record.MapTo)
+				}
+				return nil, err
+			}
+			state.batch[ImportRecordUser+":"+record.ID] = user.ID
+			report.UsersMatched++
+			return nil, nil
+		}
+
+		count, err := udo.Where(uq.Username.Eq(record.Username)).Count()
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			return nil, invalid("username %q already exists, set map_to to map it to the existing user", record.Username)
+		}
+
+		passwordHash := unusablePasswordHash
+		if record.Password != "" {
+			hashed, err := bcrypt.GenerateFromPassword([]byte(record.Password), bcrypt.DefaultCost)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", errFailedToHashPassword, err)
+			}
+			passwordHash = string(hashed)
+		}
+
+		user := &model.User{
+			Username:     record.Username,
+			PasswordHash: passwordHash,
+		}
+		if record.CreatedAt != nil {
+			user.CreatedAt = *record.CreatedAt
+		}
+		if err := udo.Create(user); err != nil {
+			return nil, err
+		}
+
+		state.batch[ImportRecordUser+":"+record.ID] = user.ID
+		report.UsersCreated++
+		return nil, nil
+
+	case ImportRecordGroup:
+		if record.ID == "" || record.Name == "" || record.OwnerID == "" {
+			return nil, invalid("group requires id, name and owner_id")
+		}
+		if _, ok := state.lookup(ImportRecordGroup, record.ID); ok {
+			return nil, invalid("duplicate group id %q", record.ID)
+		}
+		ownerID, ok := state.lookup(ImportRecordUser, record.OwnerID)
+		if !ok {
+			return nil, invalid("unknown owner_id %q", record.OwnerID)
+		}
+
+		group := &model.Group{
+			Name:        record.Name,
+			OwnerID:     ownerID,
+			MemberCount: 1,
+		}
+		if record.CreatedAt != nil {
+			group.CreatedAt = *record.CreatedAt
+		}
+		if err := dao.Use(tx).Group.WithContext(ctx).Create(group); err != nil {
+			return nil, err
+		}
+
+		owner := &model.GroupMember{
+			GroupID:   group.ID,
+			UserID:    ownerID,
+			Role:      RoleOwner,
+			CreatedAt: group.CreatedAt,
+		}
+		if err := dao.Use(tx).GroupMember.WithContext(ctx).Create(owner); err != nil {
+			return nil, err
+		}
+
+		state.batch[ImportRecordGroup+":"+record.ID] = group.ID
+		report.GroupsCreated++
+		return nil, nil
+
+	case ImportRecordMember:
+		if record.GroupID == "" || record.UserID == "" {
+			return nil, invalid("member requires group_id and user_id")
+		}
+		groupID, ok := state.lookup(ImportRecordGroup, record.GroupID)
+		if !ok {
+			return nil, invalid("unknown group_id %q", record.GroupID)
+		}
+		userID, ok := state.lookup(ImportRecordUser, record.UserID)
+		if !ok {
+			return nil, invalid("unknown user_id %q", record.UserID)
+		}
+		role := record.Role
+		if role == "" {
+			role = RoleMember
+		}
+		if !isImportableMemberRole(role) {
+			return nil, invalid("invalid member role %q", record.Role)
+		}
+
+		mq := dao.Use(tx).GroupMember
+		mdo := mq.WithContext(ctx)
+
+		count, err := mdo.Where(mq.GroupID.Eq(groupID), mq.UserID.Eq(userID)).Count()
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			// 群主或重复的成员记录直接跳过
+			return nil, nil
+		}
+
+		// 先占用成员名额，群组已满时作为无效记录跳过
+		if err := incrementGroupMemberCount(ctx, tx, groupID); err != nil {
+			if err.Error() == errGroupFull {
+				return nil, invalid("group %q is full", record.GroupID)
+			}
+			return nil, err
+		}
+
+		member := &model.GroupMember{
+			GroupID: groupID,
+			UserID:  userID,
+			Role:    role,
+		}
+		if record.CreatedAt != nil {
+			member.CreatedAt = *record.CreatedAt
+		}
+		if err := mdo.Create(member); err != nil {
+			return nil, err
+		}
+
+		report.MembersAdded++
+		return nil, nil
+
+	case ImportRecordMessage:
+		if record.FromUserID == "" || record.TargetID == "" || record.Content == "" || record.CreatedAt == nil {
+			return nil, invalid("message requires from_user_id, target_id, content and created_at")
+		}
+		fromUserID, ok := state.lookup(ImportRecordUser, record.FromUserID)
+		if !ok {
+			return nil, invalid("unknown from_user_id %q", record.FromUserID)
+		}
+
+		var targetID string
+		switch model.MessageType(record.ChatType) {
+		case model.MessageTypePrivate:
+			targetID, ok = state.lookup(ImportRecordUser, record.TargetID)
+		case model.MessageTypeGroup:
+			targetID, ok = state.lookup(ImportRecordGroup, record.TargetID)
+		default:
+			return nil, invalid("invalid chat_type %q", record.ChatType)
+		}
+		if !ok {
+			return nil, invalid("unknown target_id %q", record.TargetID)
+		}
+
+		kind := model.MessageKind(record.Kind)
+		switch kind {
+		case "":
+			kind = model.MessageKindText
+		case model.MessageKindText, model.MessageKindImage, model.MessageKindFile:
+		default:
+			return nil, invalid("invalid message kind %q", record.Kind)
+		}
+
+		// 保留原始发送时间，历史消息不生成投递回执
+		return &model.Message{
+			FromUserID: fromUserID,
+			TargetID:   targetID,
+			Type:       model.MessageType(record.ChatType),
+			Kind:       kind,
+			Content:    record.Content,
+			CreatedAt:  *record.CreatedAt,
+		}, nil
+	}
+
+	return nil, invalid("unknown record type %q", record.Type)
+}
+
+// isImportableMemberRole 导入时允许的成员角色，群主由群组记录的 owner_id 决定
+func isImportableMemberRole(role string) bool {
+	return role == RoleMember
+}
+
+// importFileChecksum 计算导入文件的 SHA-256
+func importFileChecksum(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// importReader 顺序读取导入记录，读完时返回 io.EOF
+type importReader interface {
+	Next() (*dto.ImportRecord, error)
+	Close() error
+}
+
+// openImportReader 根据文件扩展名打开导入文件：.json 为 JSON 文档，其余按 NDJSON 逐行读取
+func openImportReader(path string) (importReader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		defer file.Close()
+
+		var doc dto.ImportDocument
+		if err := json.NewDecoder(file).Decode(&doc); err != nil {
+			return nil, err
+		}
+
+		// 按 用户 -> 群组 -> 成员 -> 消息 的顺序展开，保证引用的记录先被导入
+		records := make([]*dto.ImportRecord, 0, len(doc.Users)+len(doc.Groups)+len(doc.Members)+len(doc.Messages))
+		for _, group := range []struct {
+			recordType string
+			items      []dto.ImportRecord
+		}{
+			{ImportRecordUser, doc.Users},
+			{ImportRecordGroup, doc.Groups},
+			{ImportRecordMember, doc.Members},
+			{ImportRecordMessage, doc.Messages},
+		} {
+			for i := range group.items {
+				record := group.items[i]
+				record.Type = group.recordType
+				records = append(records, &record)
+			}
+		}
+
+		return &documentImportReader{records: records}, nil
+	}
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), importMaxLineSize)
+	return &ndjsonImportReader{file: file, scanner: scanner}, nil
+}
+
+// documentImportReader 读取已解析的 JSON 文档
+type documentImportReader struct {
+	records []*dto.ImportRecord
+	next    int
+}
+
+func (r *documentImportReader) Next() (*dto.ImportRecord, error) {
+	if r.next >= len(r.records) {
+		return nil, io.EOF
+	}
+	record := r.records[r.next]
+	r.next++
+	return record, nil
+}
+
+func (r *documentImportReader) Close() error {
+	return nil
+}
+
+// ndjsonImportReader 逐行读取 NDJSON 文件
+type ndjsonImportReader struct {
+	file    *os.File
+	scanner *bufio.Scanner
+	line    int
+}
+
+func (r *ndjsonImportReader) Next() (*dto.ImportRecord, error) {
+	for r.scanner.Scan() {
+		r.line++
+		line := strings.TrimSpace(r.scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var record dto.ImportRecord
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			return nil, fmt.Errorf("line %d: %w", r.line, err)
+		}
+		return &record, nil
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+func (r *ndjsonImportReader) Close() error {
+	return r.file.Close()
+}
@@ -0,0 +1,250 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// IncomingWebhookPathPrefix 传入 Webhook 地址的路径前缀，完整地址为 前缀/{webhook_id}/{token}
+	IncomingWebhookPathPrefix = "/api/v1/hooks/"
+
+	incomingWebhookTokenBytes       = 24
+	incomingWebhookTouchPeriod      = 5 * time.Minute
+	maxIncomingWebhooksPerGroup     = 10
+	maxIncomingWebhookNameLength    = 32
+	maxIncomingWebhookMessageLength = 1000
+)
+
+const (
+	errIncomingWebhookNotFound    = "incoming webhook not found"
+	errInvalidIncomingWebhook     = "invalid incoming webhook"
+	errInvalidIncomingWebhookName = "invalid incoming webhook name"
+	errTooManyIncomingWebhooks    = "too many incoming webhooks"
+	errInvalidMessageContent      = "invalid message content"
+)
+
+type IncomingWebhookService struct {
+	db *gorm.DB
+}
+
+func NewIncomingWebhookService(db *gorm.DB) *IncomingWebhookService {
+	return &IncomingWebhookService{
+		db: db,
+	}
+}
+
+// CreateIncomingWebhook 群主为群组创建传入 Webhook，同时创建同名的集成账号作为消息发送者
+func (s *IncomingWebhookService) CreateIncomingWebhook(ctx context.Context, userID string, groupID string, name string) (*dto.IncomingWebhookURLResponse, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxIncomingWebhookNameLength {
+		return nil, fmt.Errorf(errInvalidIncomingWebhookName)
+	}
+
+	if err := s.checkGroupOwner(ctx, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	iq := dao.Use(s.db).IncomingWebhook
+	count, err := iq.WithContext(ctx).Where(iq.GroupID.Eq(groupID)).Count()
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxIncomingWebhooksPerGroup {
+		return nil, fmt.Errorf(errTooManyIncomingWebhooks)
+	}
+
+	uq := dao.Use(s.db).User
+	if _, err := uq.WithContext(ctx).Where(uq.Username.Eq(name)).First(); err == nil {
+		return nil, ErrUsernameAlreadyExists
+	}
+
+	token, err := generateIncomingWebhookToken()
+	if err != nil {
+		return nil, err
+	}
+
+	var integration *model.User
+	var webhook *model.IncomingWebhook
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		integration = &model.User{
+			Username:     name,
+			PasswordHash: unusablePasswordHash,
+			Type:         model.UserTypeIntegration,
+		}
+		if err := dao.Use(tx).User.WithContext(ctx).Create(integration); err != nil {
+			return fmt.Errorf("%s: %w", errFailedToCreateUser, err)
+		}
+
+		webhook = &model.IncomingWebhook{
+			GroupID:   groupID,
+			CreatorID: userID,
+			UserID:    integration.ID,
+			Name:      name,
+			TokenHash: hashToken(token),
+		}
+		return dao.Use(tx).IncomingWebhook.WithContext(ctx).Create(webhook)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.IncomingWebhookURLResponse{
+		Webhook: s.toIncomingWebhookResponse(webhook),
+		URL:     IncomingWebhookPathPrefix + webhook.ID + "/" + token,
+	}, nil
+}
+
+// ListIncomingWebhooks 获取群组的传入 Webhook 列表（仅群主）
+func (s *IncomingWebhookService) ListIncomingWebhooks(ctx context.Context, userID string, groupID string) ([]*dto.IncomingWebhookResponse, error) {
+	if err := s.checkGroupOwner(ctx, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	iq := dao.Use(s.db).IncomingWebhook
+	webhooks, err := iq.WithContext(ctx).Where(iq.GroupID.Eq(groupID)).Order(iq.CreatedAt).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.IncomingWebhookResponse, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		response := s.toIncomingWebhookResponse(webhook)
+		result = append(result, &response)
+	}
+
+	return result, nil
+}
+
+// RegenerateIncomingWebhookToken 重新生成令牌，旧地址立即失效
+func (s *IncomingWebhookService) RegenerateIncomingWebhookToken(ctx context.Context, userID string, groupID string, webhookID string) (*dto.IncomingWebhookURLResponse, error) {
+	webhook, err := s.getGroupIncomingWebhook(ctx, userID, groupID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := generateIncomingWebhookToken()
+	if err != nil {
+		return nil, err
+	}
+
+	iq := dao.Use(s.db).IncomingWebhook
+	if _, err := iq.WithContext(ctx).Where(iq.ID.Eq(webhook.ID)).Update(iq.TokenHash, hashToken(token)); err != nil {
+		return nil, err
+	}
+
+	return &dto.IncomingWebhookURLResponse{
+		Webhook: s.toIncomingWebhookResponse(webhook),
+		URL:     IncomingWebhookPathPrefix + webhook.ID + "/" + token,
+	}, nil
+}
+
+// DeleteIncomingWebhook 删除传入 Webhook，集成账号保留，历史消息仍显示原来的发送者
+func (s *IncomingWebhookService) DeleteIncomingWebhook(ctx context.Context, userID string, groupID string, webhookID string) error {
+	webhook, err := s.getGroupIncomingWebhook(ctx, userID, groupID, webhookID)
+	if err != nil {
+		return err
+	}
+
+	iq := dao.Use(s.db).IncomingWebhook
+	_, err = iq.WithContext(ctx).Where(iq.ID.Eq(webhook.ID)).Delete()
+	return err
+}
+
+// AuthenticateIncomingWebhook 校验传入 Webhook 地址中的令牌，群组已解散时同样视为无效
+func (s *IncomingWebhookService) AuthenticateIncomingWebhook(ctx context.Context, webhookID string, token string) (*model.IncomingWebhook, error) {
+	iq := dao.Use(s.db).IncomingWebhook
+	webhook, err := iq.WithContext(ctx).Where(iq.ID.Eq(webhookID)).First()
+	if err != nil {
+		return nil, fmt.Errorf(errInvalidIncomingWebhook)
+	}
+	if subtle.ConstantTimeCompare([]byte(webhook.TokenHash), []byte(hashToken(token))) != 1 {
+		return nil, fmt.Errorf(errInvalidIncomingWebhook)
+	}
+
+	gq := dao.Use(s.db).Group
+	if _, err := gq.WithContext(ctx).Where(gq.ID.Eq(webhook.GroupID)).First(); err != nil {
+		return nil, fmt.Errorf(errInvalidIncomingWebhook)
+	}
+
+	return webhook, nil
+}
+
+// PostMessage 以集成账号的名义向群组发送文本消息，与群成员发送的消息一样存储并创建未送达回执
+func (s *IncomingWebhookService) PostMessage(ctx context.Context, webhook *model.IncomingWebhook, text string, recipientIDs []string, onlineUserIDs map[string]bool) (*model.Message, error) {
+	if strings.TrimSpace(text) == "" || utf8.RuneCountInString(text) > maxIncomingWebhookMessageLength {
+		return nil, fmt.Errorf(errInvalidMessageContent)
+	}
+
+	message, err := NewMessageService(s.db).SendGroupMessage(ctx, webhook.UserID, webhook.GroupID, "", model.MessageKindText, text, "", recipientIDs, onlineUserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// 限制最后使用时间的更新频率，避免每条消息都写一次
+	now := time.Now()
+	if webhook.LastUsedAt == nil || now.Sub(*webhook.LastUsedAt) > incomingWebhookTouchPeriod {
+		iq := dao.Use(s.db).IncomingWebhook
+		if _, err := iq.WithContext(ctx).Where(iq.ID.Eq(webhook.ID)).Update(iq.LastUsedAt, now); err != nil {
+			return nil, err
+		}
+	}
+
+	return message, nil
+}
+
+func (s *IncomingWebhookService) checkGroupOwner(ctx context.Context, userID string, groupID string) error {
+	_, err := requireGroupOwner(ctx, s.db, userID, groupID)
+	return err
+}
+
+// getGroupIncomingWebhook 获取群主可以管理的传入 Webhook
+func (s *IncomingWebhookService) getGroupIncomingWebhook(ctx context.Context, userID string, groupID string, webhookID string) (*model.IncomingWebhook, error) {
+	if err := s.checkGroupOwner(ctx, userID, groupID); err != nil {
+		return nil, err
+	}
+
+	iq := dao.Use(s.db).IncomingWebhook
+	webhook, err := iq.WithContext(ctx).Where(iq.ID.Eq(webhookID), iq.GroupID.Eq(groupID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(errIncomingWebhookNotFound)
+		}
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+func (s *IncomingWebhookService) toIncomingWebhookResponse(webhook *model.IncomingWebhook) dto.IncomingWebhookResponse {
+	return dto.IncomingWebhookResponse{
+		WebhookID:  webhook.ID,
+		GroupID:    webhook.GroupID,
+		Name:       webhook.Name,
+		UserID:     webhook.UserID,
+		Avatar:     NewMessageService(s.db).generateAvatarUrl(webhook.UserID, webhook.Name),
+		CreatorID:  webhook.CreatorID,
+		CreatedAt:  webhook.CreatedAt,
+		LastUsedAt: webhook.LastUsedAt,
+	}
+}
+
+func generateIncomingWebhookToken() (string, error) {
+	buf := make([]byte, incomingWebhookTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -70,6 +70,7 @@ func (s *MessageService) GetPrivateMessages(ctx context.Context, userID string,
 			FromUserID: msg.FromUserID,
 			TargetID:   msg.TargetID,
 			Type:       string(msg.Type),
+			Kind:       string(msg.Kind),
 			Content:    msg.Content,
 			CreatedAt:  msg.CreatedAt,
 			FromUser:   fromUser,
@@ -77,6 +78,10 @@ func (s *MessageService) GetPrivateMessages(ctx context.Context, userID string,
 		})
 	}
 
+	if err := s.attachMessageDetails(ctx, userID, messageResponses); err != nil {
+		return nil, err
+	}
+
 	if len(messages) == limit {
 		nextCursor = messages[len(messages)-1].CreatedAt.Format(time.RFC3339Nano)
 		hasMore = true
@@ -89,7 +94,11 @@ func (s *MessageService) GetPrivateMessages(ctx context.Context, userID string,
 	}, nil
 }
 
-func (s *MessageService) GetGroupMessages(ctx context.Context, groupID string, limit int, cursor string) (*dto.GetMessagesResponse, error) {
+// GetGroupMessages 获取群聊消息记录。默认从 cursor 开始向前翻页，按时间倒序返回；
+// after 不为空时返回该时间之后的消息，按时间正序返回，用于频道订阅者等没有离线回执的成员上线后补齐消息，
+// 此时 next_cursor 作为下一次请求的 after。
+// topicID 不为空时只返回该话题的消息；论坛模式的群组未指定话题时只返回不属于任何话题的消息
+func (s *MessageService) GetGroupMessages(ctx context.Context, userID string, groupID string, topicID string, limit int, cursor string, after string) (*dto.GetMessagesResponse, error) {
 	if limit <= 0 || limit > 100 {
 		limit = 20
 	}
@@ -102,14 +111,36 @@ func (s *MessageService) GetGroupMessages(ctx context.Context, groupID string, l
 		q.TargetID.Eq(groupID),
 	)
 
-	if cursor != "" {
-		cursorTime, err := time.Parse(time.RFC3339Nano, cursor)
+	if topicID != "" {
+		query = query.Where(q.TopicID.Eq(topicID))
+	} else {
+		gq := dao.Use(s.db).Group
+		group, err := gq.WithContext(ctx).Select(gq.IsForum).Where(gq.ID.Eq(groupID)).First()
+		if err != nil {
+			return nil, err
+		}
+		if group.IsForum {
+			query = query.Where(q.TopicID.IsNull())
+		}
+	}
+
+	if after != "" {
+		afterTime, err := time.Parse(time.RFC3339Nano, after)
 		if err == nil {
-			query = query.Where(q.CreatedAt.Lt(cursorTime))
+			query = query.Where(q.CreatedAt.Gt(afterTime))
 		}
+		query = query.Order(q.CreatedAt)
+	} else {
+		if cursor != "" {
+			cursorTime, err := time.Parse(time.RFC3339Nano, cursor)
+			if err == nil {
+				query = query.Where(q.CreatedAt.Lt(cursorTime))
+			}
+		}
+		query = query.Order(q.CreatedAt.Desc())
 	}
 
-	messages, err := query.Order(q.CreatedAt.Desc()).Limit(limit).Find()
+	messages, err := query.Limit(limit).Find()
 	if err != nil {
 		return nil, err
 	}
@@ -127,13 +158,22 @@ func (s *MessageService) GetGroupMessages(ctx context.Context, groupID string, l
 			FromUserID:  msg.FromUserID,
 			TargetID:    msg.TargetID,
 			Type:        string(msg.Type),
+			Kind:        string(msg.Kind),
 			Content:     msg.Content,
+			TopicID:     messageTopicID(msg),
 			CreatedAt:   msg.CreatedAt,
 			FromUser:    fromUser,
 			TargetGroup: groupInfo,
 		})
 	}
 
+	if err := s.attachSenderNicknames(ctx, messageResponses); err != nil {
+		return nil, err
+	}
+	if err := s.attachMessageDetails(ctx, userID, messageResponses); err != nil {
+		return nil, err
+	}
+
 	if len(messages) == limit {
 		nextCursor = messages[len(messages)-1].CreatedAt.Format(time.RFC3339Nano)
 		hasMore = true
@@ -146,6 +186,62 @@ func (s *MessageService) GetGroupMessages(ctx context.Context, groupID string, l
 	}, nil
 }
 
+// attachSenderNicknames 为群消息的发送者填充其在群组中的昵称
+func (s *MessageService) attachSenderNicknames(ctx context.Context, messages []dto.MessageResponse) error {
+	senders := make(map[string][]string)
+	for _, msg := range messages {
+		if msg.Type == string(model.MessageTypeGroup) && msg.FromUser != nil {
+			senders[msg.TargetID] = append(senders[msg.TargetID], msg.FromUserID)
+		}
+	}
+
+	for groupID, userIDs := range senders {
+		nicknames, err := loadGroupNicknames(ctx, s.db, groupID, userIDs)
+		if err != nil {
+			return err
+		}
+		for i := range messages {
+			if messages[i].TargetID == groupID && messages[i].FromUser != nil {
+				messages[i].FromUser.Nickname = nicknames[messages[i].FromUserID]
+			}
+		}
+	}
+	return nil
+}
+
+// attachMessageDetails 为投票消息填充投票详情，为卡片消息填充卡片内容
+func (s *MessageService) attachMessageDetails(ctx context.Context, viewerID string, messages []dto.MessageResponse) error {
+	var pollMessageIDs, cardMessageIDs []string
+	for _, msg := range messages {
+		switch model.MessageKind(msg.Kind) {
+		case model.MessageKindPoll:
+			pollMessageIDs = append(pollMessageIDs, msg.MessageID)
+		case model.MessageKindCard:
+			cardMessageIDs = append(cardMessageIDs, msg.MessageID)
+		}
+	}
+	if len(pollMessageIDs) == 0 && len(cardMessageIDs) == 0 {
+		return nil
+	}
+
+	// 只读取投票和卡片内容，不需要 Redis
+	polls, err := NewPollService(s.db, nil).GetPollsByMessageIDs(ctx, viewerID, pollMessageIDs)
+	if err != nil {
+		return err
+	}
+	cards, err := NewCardService(s.db, nil).GetCardsByMessageIDs(ctx, viewerID, cardMessageIDs)
+	if err != nil {
+		return err
+	}
+
+	for i := range messages {
+		messages[i].Poll = polls[messages[i].MessageID]
+		messages[i].Card = cards[messages[i].MessageID]
+	}
+
+	return nil
+}
+
 func (s *MessageService) getUserInfo(ctx context.Context, userID string) (*dto.UserInfo, error) {
 	q := dao.Use(s.db).User
 	do := q.WithContext(ctx)
@@ -161,6 +257,7 @@ func (s *MessageService) getUserInfo(ctx context.Context, userID string) (*dto.U
 		UserID:   user.ID,
 		Username: user.Username,
 		Avatar:   avatarUrl,
+		IsBot:    user.Type != model.UserTypeUser,
 	}, nil
 }
 
@@ -184,7 +281,7 @@ func (s *MessageService) generateAvatarUrl(userID string, username string) strin
 	return avatarUrlBase + "?name=" + username + "&background=" + color + "&rounded=true&size=" + avatarSize
 }
 
-func (s *MessageService) SendPrivateMessage(ctx context.Context, fromUserID string, targetUserID string, content string, messageID string, isTargetOnline bool) (*model.Message, error) {
+func (s *MessageService) SendPrivateMessage(ctx context.Context, fromUserID string, targetUserID string, kind model.MessageKind, content string, messageID string, isTargetOnline bool) (*model.Message, error) {
 	var message *model.Message
 
 	err := s.db.Transaction(func(tx *gorm.DB) error {
@@ -195,6 +292,7 @@ func (s *MessageService) SendPrivateMessage(ctx context.Context, fromUserID stri
 			FromUserID: fromUserID,
 			TargetID:   targetUserID,
 			Type:       model.MessageTypePrivate,
+			Kind:       kind,
 			Content:    content,
 		}
 
@@ -231,57 +329,94 @@ func (s *MessageService) SendPrivateMessage(ctx context.Context, fromUserID stri
 	return message, nil
 }
 
-func (s *MessageService) SendGroupMessage(ctx context.Context, fromUserID string, groupID string, content string, messageID string, recipientIDs []string, onlineUserIDs map[string]bool) (*model.Message, error) {
+// SendGroupMessage 存储群聊消息，topicID 为论坛模式群组中消息所属的话题，为空表示不属于任何话题
+func (s *MessageService) SendGroupMessage(ctx context.Context, fromUserID string, groupID string, topicID string, kind model.MessageKind, content string, messageID string, recipientIDs []string, onlineUserIDs map[string]bool) (*model.Message, error) {
 	var message *model.Message
 
 	err := s.db.Transaction(func(tx *gorm.DB) error {
-		q := dao.Use(tx).Message
-		do := q.WithContext(ctx)
-
 		message = &model.Message{
 			FromUserID: fromUserID,
 			TargetID:   groupID,
 			Type:       model.MessageTypeGroup,
+			Kind:       kind,
 			Content:    content,
 		}
 
 		if messageID != "" {
 			message.ID = messageID
 		}
+		if topicID != "" {
+			message.TopicID = &topicID
+		}
 
-		if err := do.Create(message); err != nil {
+		return createMessageWithReceipts(ctx, tx, message, recipientIDs, onlineUserIDs)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// createMessageWithReceipts 在事务中存储消息，并为离线的接收者创建未送达回执。
+// 频道的订阅者数量很大，不创建回执，离线的订阅者上线后通过消息记录接口按游标补齐
+func createMessageWithReceipts(ctx context.Context, tx *gorm.DB, message *model.Message, recipientIDs []string, onlineUserIDs map[string]bool) error {
+	if err := dao.Use(tx).Message.WithContext(ctx).Create(message); err != nil {
+		return err
+	}
+
+	if message.Type == model.MessageTypeGroup && len(recipientIDs) > 0 {
+		gq := dao.Use(tx).Group
+		group, err := gq.WithContext(ctx).Select(gq.IsChannel).Where(gq.ID.Eq(message.TargetID)).First()
+		if err != nil {
 			return err
 		}
+		if group.IsChannel {
+			recipientIDs = nil
+		}
+	}
 
-		rq := dao.Use(tx).MessageReceipt
-		rdo := rq.WithContext(ctx)
+	if message.TopicID != nil {
+		tq := dao.Use(tx).GroupTopic
+		if _, err := tq.WithContext(ctx).Where(tq.ID.Eq(*message.TopicID)).Update(tq.LastMessageAt, message.CreatedAt); err != nil {
+			return err
+		}
+	}
 
-		var receipts []*model.MessageReceipt
-		for _, recipientID := range recipientIDs {
-			if !onlineUserIDs[recipientID] {
-				receipt := &model.MessageReceipt{
-					MessageID:   message.ID,
-					UserID:      recipientID,
-					IsDelivered: false,
-				}
-				receipts = append(receipts, receipt)
+	var receipts []*model.MessageReceipt
+	for _, recipientID := range recipientIDs {
+		if !onlineUserIDs[recipientID] {
+			receipt := &model.MessageReceipt{
+				MessageID:   message.ID,
+				UserID:      recipientID,
+				IsDelivered: false,
 			}
+			receipts = append(receipts, receipt)
 		}
+	}
 
-		if len(receipts) > 0 {
-			if err := rdo.CreateInBatches(receipts, 100); err != nil {
-				return err
-			}
+	if len(receipts) > 0 {
+		if err := dao.Use(tx).MessageReceipt.WithContext(ctx).CreateInBatches(receipts, 100); err != nil {
+			return err
 		}
+	}
 
+	if message.Type != model.MessageTypeGroup {
 		return nil
-	})
-
-	if err != nil {
-		return nil, err
 	}
-
-	return message, nil
+	return enqueueWebhookEvent(ctx, tx, webhookEvent{
+		Name:    WebhookEventGroupMessageCreated,
+		GroupID: message.TargetID,
+		Data: dto.WebhookMessageData{
+			MessageID: message.ID,
+			GroupID:   message.TargetID,
+			SenderID:  message.FromUserID,
+			Kind:      string(message.Kind),
+			Content:   message.Content,
+			CreatedAt: message.CreatedAt,
+		},
+	})
 }
 
 func (s *MessageService) GetUndeliveredMessages(ctx context.Context, userID string) ([]dto.MessageResponse, error) {
@@ -324,6 +459,7 @@ func (s *MessageService) GetUndeliveredMessages(ctx context.Context, userID stri
 				FromUserID: msg.FromUserID,
 				TargetID:   msg.TargetID,
 				Type:       string(msg.Type),
+				Kind:       string(msg.Kind),
 				Content:    msg.Content,
 				CreatedAt:  msg.CreatedAt,
 				FromUser:   fromUser,
@@ -336,7 +472,9 @@ func (s *MessageService) GetUndeliveredMessages(ctx context.Context, userID stri
 				FromUserID:  msg.FromUserID,
 				TargetID:    msg.TargetID,
 				Type:        string(msg.Type),
+				Kind:        string(msg.Kind),
 				Content:     msg.Content,
+				TopicID:     messageTopicID(msg),
 				CreatedAt:   msg.CreatedAt,
 				FromUser:    fromUser,
 				TargetGroup: groupInfo,
@@ -344,6 +482,13 @@ func (s *MessageService) GetUndeliveredMessages(ctx context.Context, userID stri
 		}
 	}
 
+	if err := s.attachSenderNicknames(ctx, messageResponses); err != nil {
+		return nil, err
+	}
+	if err := s.attachMessageDetails(ctx, userID, messageResponses); err != nil {
+		return nil, err
+	}
+
 	return messageResponses, nil
 }
 
@@ -359,7 +504,31 @@ func (s *MessageService) MarkMessagesAsDelivered(ctx context.Context, userID str
 	return err
 }
 
-func (s *MessageService) GetConversationList(ctx context.Context, userID string) (*dto.GetConversationListResponse, error) {
+// MarkMessageDeliveredTo 将一条消息标记为已送达给指定用户，用于事务内存储、提交后才推送的消息
+func (s *MessageService) MarkMessageDeliveredTo(ctx context.Context, messageID string, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	rq := dao.Use(s.db).MessageReceipt
+	_, err := rq.WithContext(ctx).Where(
+		rq.MessageID.Eq(messageID),
+		rq.UserID.In(userIDs...),
+	).Update(rq.IsDelivered, true)
+
+	return err
+}
+
+// GetMessage 按ID获取已存储的消息
+func (s *MessageService) GetMessage(ctx context.Context, messageID string) (*model.Message, error) {
+	mq := dao.Use(s.db).Message
+	return mq.WithContext(ctx).Where(mq.ID.Eq(messageID)).First()
+}
+
+// GetConversationList 获取会话列表
+// archived 为 true 时只返回已归档的会话，否则只返回未归档的会话；
+// 置顶会话排在最前，被隐藏且没有新消息的私聊会话不返回
+func (s *MessageService) GetConversationList(ctx context.Context, userID string, archived bool) (*dto.GetConversationListResponse, error) {
 	privateConversations, err := s.getPrivateConversations(ctx, userID)
 	if err != nil {
 		return nil, err
@@ -370,9 +539,53 @@ func (s *MessageService) GetConversationList(ctx context.Context, userID string)
 		return nil, err
 	}
 
+	settings, err := NewConversationService(s.db).GetConversationSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	filteredPrivate := make([]dto.PrivateConversation, 0, len(privateConversations))
+	privateOrders := make([]conversationOrder, 0, len(privateConversations))
+	for _, conversation := range privateConversations {
+		setting := settings[conversationKey(model.MessageTypePrivate, conversation.UserID)]
+		if isHiddenConversation(setting, conversation.LastTime) {
+			continue
+		}
+		if setting != nil {
+			conversation.IsPinned = setting.IsPinned
+			conversation.IsArchived = setting.IsArchived
+			conversation.IsMuted = isMuted(setting)
+			conversation.MutedUntil = setting.MutedUntil
+		}
+		if conversation.IsArchived != archived {
+			continue
+		}
+		filteredPrivate = append(filteredPrivate, conversation)
+		privateOrders = append(privateOrders, newConversationOrder(setting, conversation.LastTime))
+	}
+	sortConversations(filteredPrivate, privateOrders)
+
+	filteredGroup := make([]dto.GroupConversation, 0, len(groupConversations))
+	groupOrders := make([]conversationOrder, 0, len(groupConversations))
+	for _, conversation := range groupConversations {
+		setting := settings[conversationKey(model.MessageTypeGroup, conversation.GroupID)]
+		if setting != nil {
+			conversation.IsPinned = setting.IsPinned
+			conversation.IsArchived = setting.IsArchived
+			conversation.IsMuted = isMuted(setting)
+			conversation.MutedUntil = setting.MutedUntil
+		}
+		if conversation.IsArchived != archived {
+			continue
+		}
+		filteredGroup = append(filteredGroup, conversation)
+		groupOrders = append(groupOrders, newConversationOrder(setting, conversation.LastTime))
+	}
+	sortConversations(filteredGroup, groupOrders)
+
 	return &dto.GetConversationListResponse{
-		PrivateConversations: privateConversations,
-		GroupConversations:   groupConversations,
+		PrivateConversations: filteredPrivate,
+		GroupConversations:   filteredGroup,
 	}, nil
 }
 
@@ -477,18 +690,20 @@ func (s *MessageService) getGroupConversations(ctx context.Context, userID strin
 		LastContent  string
 		LastTime     time.Time
 		LastSenderID string
+		LastTopicID  *string
 	}
 
 	var lastGroupMessages []LastGroupMessage
 
 	err = s.db.WithContext(ctx).Raw(`
-		SELECT target_id as group_id, content as last_content, created_at as last_time, from_user_id as last_sender_id
+		SELECT target_id as group_id, content as last_content, created_at as last_time, from_user_id as last_sender_id, topic_id as last_topic_id
 		FROM (
 			SELECT 
 				target_id,
 				content,
 				created_at,
 				from_user_id,
+				topic_id,
 				ROW_NUMBER() OVER (PARTITION BY target_id ORDER BY created_at DESC) as rn
 			FROM messages
 			WHERE type = ? AND target_id IN (?)
@@ -577,5 +792,59 @@ func (s *MessageService) getGroupConversations(ctx context.Context, userID strin
 		}
 	}
 
+	// 论坛模式的群组附带最后一条消息所属的话题和有未读消息的话题数
+	var forumGroupIDs, lastTopicIDs []string
+	for _, groupID := range groupIDs {
+		if group := groupMap[groupID]; group != nil && group.IsForum {
+			forumGroupIDs = append(forumGroupIDs, groupID)
+			if msg, ok := messageMap[groupID]; ok && msg.LastTopicID != nil {
+				lastTopicIDs = append(lastTopicIDs, *msg.LastTopicID)
+			}
+		}
+	}
+	if len(forumGroupIDs) > 0 {
+		topicNames := make(map[string]string, len(lastTopicIDs))
+		if len(lastTopicIDs) > 0 {
+			tq := dao.Use(s.db).GroupTopic
+			topics, err := tq.WithContext(ctx).Where(tq.ID.In(lastTopicIDs...)).Find()
+			if err != nil {
+				return nil, err
+			}
+			for _, topic := range topics {
+				topicNames[topic.ID] = topic.Name
+			}
+		}
+
+		unreadRows, err := countTopicUnread(ctx, s.db, userID, forumGroupIDs)
+		if err != nil {
+			return nil, err
+		}
+		unreadTopics := make(map[string]int, len(forumGroupIDs))
+		for _, row := range unreadRows {
+			unreadTopics[row.GroupID]++
+		}
+
+		for i := range conversations {
+			conversation := &conversations[i]
+			if group := groupMap[conversation.GroupID]; group == nil || !group.IsForum {
+				continue
+			}
+			conversation.Forum = true
+			conversation.UnreadTopics = unreadTopics[conversation.GroupID]
+			if msg, ok := messageMap[conversation.GroupID]; ok && msg.LastTopicID != nil {
+				conversation.LastTopicID = *msg.LastTopicID
+				conversation.LastTopicName = topicNames[*msg.LastTopicID]
+			}
+		}
+	}
+
 	return conversations, nil
 }
+
+// messageTopicID 返回消息所属的话题ID，不属于任何话题时为空字符串
+func messageTopicID(message *model.Message) string {
+	if message.TopicID == nil {
+		return ""
+	}
+	return *message.TopicID
+}
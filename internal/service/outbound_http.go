@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+const (
+	errInvalidOutboundURL = "url must be a public http(s) address"
+	errNonPublicAddress   = "refusing to connect to non-public address"
+)
+
+// nonPublicNetworks 除 net.IP 自带判断外，还需要拒绝的保留网段
+var nonPublicNetworks = mustParseCIDRs(
+	"0.0.0.0/8",       // 本网络
+	"100.64.0.0/10",   // 运营商级 NAT
+	"192.0.0.0/24",    // IETF 协议分配
+	"192.0.2.0/24",    // 文档示例
+	"198.18.0.0/15",   // 基准测试
+	"198.51.100.0/24", // 文档示例
+	"203.0.113.0/24",  // 文档示例
+	"240.0.0.0/4",     // 保留
+	"64:ff9b::/96",    // NAT64，可能映射到内网 IPv4 地址
+	"64:ff9b:1::/48",  // 本地 NAT64
+	"2001:db8::/32",   // 文档示例
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// isPublicIP 判断是否为公网地址，回环、内网、链路本地、组播等地址均不是公网地址
+func isPublicIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return false
+	}
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// validatePublicHTTPURL 校验 URL 是 http(s) 地址，且主机解析出的所有地址都是公网地址。
+// 注册时的校验不能防止 DNS 记录之后被修改，请求时还需要使用 newOutboundHTTPClient 创建的客户端
+func validatePublicHTTPURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return fmt.Errorf(errInvalidOutboundURL)
+	}
+
+	host := u.Hostname()
+	if ip := net.ParseIP(host); ip != nil {
+		if !isPublicIP(ip) {
+			return fmt.Errorf(errInvalidOutboundURL)
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		return fmt.Errorf(errInvalidOutboundURL)
+	}
+	for _, addr := range addrs {
+		if !isPublicIP(addr.IP) {
+			return fmt.Errorf(errInvalidOutboundURL)
+		}
+	}
+	return nil
+}
+
+// newOutboundHTTPClient 创建请求用户提供的外部地址（回调、Webhook）使用的 HTTP 客户端。
+// 建立连接时检查实际连接的 IP，拒绝非公网地址，重定向和 DNS 重绑定也无法绕过；不使用环境变量中的代理
+func newOutboundHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+		Control: func(network string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || !isPublicIP(ip) {
+				return fmt.Errorf("%s: %s", errNonPublicAddress, host)
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   timeout,
+			ExpectContinueTimeout: time.Second,
+		},
+	}
+}
@@ -0,0 +1,421 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	minPollOptions        = 2
+	maxPollOptions        = 10
+	maxPollQuestionLength = 300
+	maxPollOptionLength   = 100
+)
+
+const (
+	errPollNotFound        = "poll not found"
+	errPollClosed          = "poll is closed"
+	errInvalidPollQuestion = "poll question must be 1 to 300 characters"
+	errInvalidPollOptions  = "a poll needs 2 to 10 distinct non-empty options"
+	errInvalidClosesAt     = "invalid closes_at"
+	errInvalidPollOption   = "invalid poll option"
+	errSingleChoicePoll    = "only one option can be chosen in a single choice poll"
+)
+
+type PollService struct {
+	db  *gorm.DB
+	rdb *redis.Client
+}
+
+func NewPollService(db *gorm.DB, rdb *redis.Client) *PollService {
+	return &PollService{
+		db:  db,
+		rdb: rdb,
+	}
+}
+
+// CreatePoll 在群聊中发起投票，投票以一条 Kind 为 poll 的群消息发送
+// recipientIDs、onlineUserIDs 与 MessageService.SendGroupMessage 相同，用于创建离线回执；
+// 发起投票与发送消息一样受禁言、慢速模式和频道限制
+func (s *PollService) CreatePoll(ctx context.Context, userID string, req dto.CreatePollRequest, recipientIDs []string, onlineUserIDs map[string]bool) (*model.Message, *dto.PollResponse, error) {
+	role, err := getGroupMemberRole(ctx, s.db, req.GroupID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if role == "" {
+		return nil, nil, fmt.Errorf(errNotInGroup)
+	}
+
+	question := strings.TrimSpace(req.Question)
+	if question == "" || utf8.RuneCountInString(question) > maxPollQuestionLength {
+		return nil, nil, fmt.Errorf(errInvalidPollQuestion)
+	}
+
+	options := make([]string, 0, len(req.Options))
+	seen := make(map[string]bool, len(req.Options))
+	for _, option := range req.Options {
+		option = strings.TrimSpace(option)
+		if option == "" || seen[option] || utf8.RuneCountInString(option) > maxPollOptionLength {
+			return nil, nil, fmt.Errorf(errInvalidPollOptions)
+		}
+		seen[option] = true
+		options = append(options, option)
+	}
+	if len(options) < minPollOptions || len(options) > maxPollOptions {
+		return nil, nil, fmt.Errorf(errInvalidPollOptions)
+	}
+
+	var closesAt *time.Time
+	if req.ClosesAt != "" {
+		t, err := time.Parse(time.RFC3339, req.ClosesAt)
+		if err != nil || !t.After(time.Now()) {
+			return nil, nil, fmt.Errorf(errInvalidClosesAt)
+		}
+		closesAt = &t
+	}
+
+	// 发言检查放在最后，慢速模式检查通过即开始计算下一次发言的间隔
+	if err := NewGroupModerationService(s.db, s.rdb).EnsureCanPost(ctx, req.GroupID, userID); err != nil {
+		return nil, nil, err
+	}
+
+	var message *model.Message
+	var poll *model.Poll
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		message = &model.Message{
+			FromUserID: userID,
+			TargetID:   req.GroupID,
+			Type:       model.MessageTypeGroup,
+			Kind:       model.MessageKindPoll,
+			Content:    question,
+		}
+		if err := createMessageWithReceipts(ctx, tx, message, recipientIDs, onlineUserIDs); err != nil {
+			return err
+		}
+
+		poll = &model.Poll{
+			MessageID:      message.ID,
+			GroupID:        req.GroupID,
+			CreatorID:      userID,
+			Question:       question,
+			MultipleChoice: req.MultipleChoice,
+			Anonymous:      req.Anonymous,
+			ClosesAt:       closesAt,
+		}
+		if err := dao.Use(tx).Poll.WithContext(ctx).Create(poll); err != nil {
+			return err
+		}
+
+		pollOptions := make([]*model.PollOption, 0, len(options))
+		for i, option := range options {
+			pollOptions = append(pollOptions, &model.PollOption{
+				PollID:   poll.ID,
+				Position: i,
+				Text:     option,
+			})
+		}
+		return dao.Use(tx).PollOption.WithContext(ctx).Create(pollOptions...)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responses, err := s.buildPollResponses(ctx, []*model.Poll{poll}, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return message, responses[poll.ID], nil
+}
+
+// GetPoll 获取投票详情及当前用户的选择
+func (s *PollService) GetPoll(ctx context.Context, userID string, pollID string) (*dto.PollResponse, error) {
+	poll, err := s.getMemberPoll(ctx, userID, pollID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses, err := s.buildPollResponses(ctx, []*model.Poll{poll}, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return responses[poll.ID], nil
+}
+
+// Vote 投票，覆盖用户之前的选择；optionIDs 为空表示撤回投票
+func (s *PollService) Vote(ctx context.Context, userID string, pollID string, optionIDs []string) (*dto.PollResponse, error) {
+	poll, err := s.getMemberPoll(ctx, userID, pollID)
+	if err != nil {
+		return nil, err
+	}
+	if !poll.MultipleChoice && len(optionIDs) > 1 {
+		return nil, fmt.Errorf(errSingleChoicePoll)
+	}
+
+	oq := dao.Use(s.db).PollOption
+	options, err := oq.WithContext(ctx).Where(oq.PollID.Eq(poll.ID)).Find()
+	if err != nil {
+		return nil, err
+	}
+	validOptions := make(map[string]bool, len(options))
+	for _, option := range options {
+		validOptions[option.ID] = true
+	}
+
+	chosen := make(map[string]bool, len(optionIDs))
+	votes := make([]*model.PollVote, 0, len(optionIDs))
+	for _, optionID := range optionIDs {
+		if !validOptions[optionID] || chosen[optionID] {
+			return nil, fmt.Errorf(errInvalidPollOption)
+		}
+		chosen[optionID] = true
+		votes = append(votes, &model.PollVote{
+			PollID:   poll.ID,
+			UserID:   userID,
+			OptionID: optionID,
+		})
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// 锁定投票后再检查是否已关闭，避免与关闭投票并发时在关闭后写入选票
+		pq := dao.Use(tx).Poll
+		locked, err := pq.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where(pq.ID.Eq(poll.ID)).First()
+		if err != nil {
+			return fmt.Errorf(errPollNotFound)
+		}
+		if isPollClosed(locked, time.Now()) {
+			return fmt.Errorf(errPollClosed)
+		}
+		poll = locked
+
+		vq := dao.Use(tx).PollVote
+		vdo := vq.WithContext(ctx)
+
+		if _, err := vdo.Where(vq.PollID.Eq(poll.ID), vq.UserID.Eq(userID)).Delete(); err != nil {
+			return err
+		}
+		if len(votes) == 0 {
+			return nil
+		}
+		return vdo.Create(votes...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	responses, err := s.buildPollResponses(ctx, []*model.Poll{poll}, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return responses[poll.ID], nil
+}
+
+// ClosePoll 关闭投票，发起人或拥有管理投票权限的成员可操作
+func (s *PollService) ClosePoll(ctx context.Context, userID string, pollID string) (*dto.PollResponse, error) {
+	poll, err := s.getMemberPoll(ctx, userID, pollID)
+	if err != nil {
+		return nil, err
+	}
+
+	if poll.CreatorID != userID {
+		if _, err := requireGroupPermission(ctx, s.db, userID, poll.GroupID, PermissionManagePoll); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	if isPollClosed(poll, now) {
+		return nil, fmt.Errorf(errPollClosed)
+	}
+
+	// 只关闭尚未手动关闭的投票，与并发的关闭请求互斥
+	pq := dao.Use(s.db).Poll
+	result, err := pq.WithContext(ctx).Where(pq.ID.Eq(poll.ID), pq.ClosedAt.IsNull()).Update(pq.ClosedAt, now)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected == 0 {
+		return nil, fmt.Errorf(errPollClosed)
+	}
+	poll.ClosedAt = &now
+
+	responses, err := s.buildPollResponses(ctx, []*model.Poll{poll}, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return responses[poll.ID], nil
+}
+
+// GetPollsByMessageIDs 批量获取投票消息对应的投票详情，key 为消息ID
+func (s *PollService) GetPollsByMessageIDs(ctx context.Context, viewerID string, messageIDs []string) (map[string]*dto.PollResponse, error) {
+	result := make(map[string]*dto.PollResponse)
+	if len(messageIDs) == 0 {
+		return result, nil
+	}
+
+	pq := dao.Use(s.db).Poll
+	polls, err := pq.WithContext(ctx).Where(pq.MessageID.In(messageIDs...)).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	responses, err := s.buildPollResponses(ctx, polls, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, poll := range polls {
+		result[poll.MessageID] = responses[poll.ID]
+	}
+
+	return result, nil
+}
+
+// getMemberPoll 获取投票，并校验用户是投票所在群组的成员
+func (s *PollService) getMemberPoll(ctx context.Context, userID string, pollID string) (*model.Poll, error) {
+	pq := dao.Use(s.db).Poll
+	poll, err := pq.WithContext(ctx).Where(pq.ID.Eq(pollID)).First()
+	if err != nil {
+		return nil, fmt.Errorf(errPollNotFound)
+	}
+
+	isMember, err := NewGroupService(s.db).IsGroupMember(ctx, poll.GroupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, fmt.Errorf(errNotInGroup)
+	}
+
+	return poll, nil
+}
+
+// buildPollResponses 统计投票结果，key 为投票ID；viewerID 为空时不返回当前用户的选择
+func (s *PollService) buildPollResponses(ctx context.Context, polls []*model.Poll, viewerID string) (map[string]*dto.PollResponse, error) {
+	responses := make(map[string]*dto.PollResponse, len(polls))
+	if len(polls) == 0 {
+		return responses, nil
+	}
+
+	pollIDs := make([]string, 0, len(polls))
+	for _, poll := range polls {
+		pollIDs = append(pollIDs, poll.ID)
+	}
+
+	oq := dao.Use(s.db).PollOption
+	options, err := oq.WithContext(ctx).Where(oq.PollID.In(pollIDs...)).Order(oq.Position).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	vq := dao.Use(s.db).PollVote
+	votes, err := vq.WithContext(ctx).Where(vq.PollID.In(pollIDs...)).Order(vq.CreatedAt).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	// 仅实名投票需要查询投票人信息
+	anonymous := make(map[string]bool, len(polls))
+	for _, poll := range polls {
+		anonymous[poll.ID] = poll.Anonymous
+	}
+	voterIDs := make([]string, 0)
+	seenVoters := make(map[string]bool)
+	for _, vote := range votes {
+		if !anonymous[vote.PollID] && !seenVoters[vote.UserID] {
+			seenVoters[vote.UserID] = true
+			voterIDs = append(voterIDs, vote.UserID)
+		}
+	}
+	voters := make(map[string]dto.UserInfo, len(voterIDs))
+	if len(voterIDs) > 0 {
+		uq := dao.Use(s.db).User
+		users, err := uq.WithContext(ctx).Where(uq.ID.In(voterIDs...)).Find()
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			voters[user.ID] = dto.UserInfo{
+				UserID:   user.ID,
+				Username: user.Username,
+				Avatar:   NewMessageService(s.db).generateAvatarUrl(user.ID, user.Username),
+				IsBot:    user.Type != model.UserTypeUser,
+			}
+		}
+	}
+
+	now := time.Now()
+	optionIndex := make(map[string]int, len(options))
+	for _, poll := range polls {
+		responses[poll.ID] = &dto.PollResponse{
+			PollID:         poll.ID,
+			MessageID:      poll.MessageID,
+			GroupID:        poll.GroupID,
+			CreatorID:      poll.CreatorID,
+			Question:       poll.Question,
+			MultipleChoice: poll.MultipleChoice,
+			Anonymous:      poll.Anonymous,
+			ClosesAt:       poll.ClosesAt,
+			Closed:         isPollClosed(poll, now),
+			Options:        []dto.PollOptionResponse{},
+			CreatedAt:      poll.CreatedAt,
+		}
+	}
+	for _, option := range options {
+		response := responses[option.PollID]
+		optionIndex[option.ID] = len(response.Options)
+		response.Options = append(response.Options, dto.PollOptionResponse{
+			OptionID: option.ID,
+			Text:     option.Text,
+		})
+	}
+
+	pollVoters := make(map[string]map[string]bool, len(polls))
+	for _, vote := range votes {
+		response := responses[vote.PollID]
+		index, ok := optionIndex[vote.OptionID]
+		if !ok {
+			continue
+		}
+
+		option := &response.Options[index]
+		option.VoteCount++
+		if !response.Anonymous {
+			if voter, ok := voters[vote.UserID]; ok {
+				option.Voters = append(option.Voters, voter)
+			}
+		}
+
+		if pollVoters[vote.PollID] == nil {
+			pollVoters[vote.PollID] = make(map[string]bool)
+		}
+		pollVoters[vote.PollID][vote.UserID] = true
+
+		if viewerID != "" && vote.UserID == viewerID {
+			response.MyOptionIDs = append(response.MyOptionIDs, vote.OptionID)
+		}
+	}
+	for pollID, userIDs := range pollVoters {
+		responses[pollID].TotalVoters = len(userIDs)
+	}
+
+	return responses, nil
+}
+
+// isPollClosed 判断投票是否已关闭：手动关闭或已过截止时间
+func isPollClosed(poll *model.Poll, now time.Time) bool {
+	return poll.ClosedAt != nil || (poll.ClosesAt != nil && !now.Before(*poll.ClosesAt))
+}
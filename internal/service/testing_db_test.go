@@ -0,0 +1,203 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/model"
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// requireTestEnv 读取测试依赖的环境变量。本地未设置时跳过测试；设置了 CI 时直接失败，避免数据库测试在 CI 中被静默跳过
+func requireTestEnv(t *testing.T, name string) string {
+	t.Helper()
+
+	value := os.Getenv(name)
+	if value == "" {
+		if os.Getenv("CI") != "" {
+			t.Fatalf("%s is required when CI is set", name)
+		}
+		t.Skipf("%s is not set", name)
+	}
+	return value
+}
+
+// newTestDB 连接 TEST_DATABASE_DSN 指定的 PostgreSQL 测试库并迁移用到的表
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	dsn := requireTestEnv(t, "TEST_DATABASE_DSN")
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("open test database: %v", err)
+	}
+	err = db.AutoMigrate(
+		&model.User{},
+		&model.Friend{},
+		&model.Group{},
+		&model.GroupMember{},
+		&model.InvitationCode{},
+		&model.InvitationUse{},
+		&model.GroupJoinRequest{},
+		&model.GroupJoinRule{},
+		&model.GroupPermission{},
+		&model.GroupAuditLog{},
+		&model.GroupBan{},
+		&model.Message{},
+		&model.MessageReceipt{},
+		&model.Webhook{},
+		&model.WebhookDelivery{},
+	)
+	if err != nil {
+		t.Fatalf("migrate test database: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("get sql database: %v", err)
+	}
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	return db
+}
+
+// newTestRedis 连接 TEST_REDIS_ADDR 指定的 Redis
+func newTestRedis(t *testing.T) *redis.Client {
+	t.Helper()
+
+	addr := requireTestEnv(t, "TEST_REDIS_ADDR")
+
+	rdb := redis.NewClient(&redis.Options{Addr: addr})
+	if err := rdb.Ping(context.Background()).Err(); err != nil {
+		t.Fatalf("connect test redis: %v", err)
+	}
+	t.Cleanup(func() { _ = rdb.Close() })
+	return rdb
+}
+
+// createTestUser 创建测试用户，测试结束后删除
+func createTestUser(t *testing.T, db *gorm.DB, organization string) *model.User {
+	t.Helper()
+
+	user := &model.User{
+		ID:           uuid.New().String(),
+		Username:     "test_" + uuid.New().String(),
+		PasswordHash: "x",
+		Organization: organization,
+	}
+	if err := dao.Use(db).User.WithContext(context.Background()).Create(user); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	t.Cleanup(func() {
+		db.Unscoped().Where("user_a = ? OR user_b = ?", user.ID, user.ID).Delete(&model.Friend{})
+		db.Unscoped().Delete(user)
+	})
+	return user
+}
+
+// createTestGroup 创建群主为 owner 的测试群组，maxMembers 为成员上限，测试结束后删除群组及相关记录
+func createTestGroup(t *testing.T, db *gorm.DB, owner *model.User, maxMembers int) *model.Group {
+	t.Helper()
+
+	ctx := context.Background()
+	group := &model.Group{
+		ID:          uuid.New().String(),
+		Name:        "test group",
+		OwnerID:     owner.ID,
+		MemberCount: 1,
+		MaxMembers:  maxMembers,
+	}
+	if err := dao.Use(db).Group.WithContext(ctx).Create(group); err != nil {
+		t.Fatalf("create group: %v", err)
+	}
+	addTestMember(t, db, group.ID, owner.ID, RoleOwner)
+
+	t.Cleanup(func() {
+		db.Unscoped().Where("message_id IN (?)", db.Model(&model.Message{}).Select("id").Where("target_id = ?", group.ID)).Delete(&model.MessageReceipt{})
+		db.Unscoped().Where("target_id = ?", group.ID).Delete(&model.Message{})
+		db.Unscoped().Where("group_id = ?", group.ID).Delete(&model.GroupBan{})
+		db.Unscoped().Where("group_id = ?", group.ID).Delete(&model.GroupMember{})
+		db.Unscoped().Where("target_group_id = ?", group.ID).Delete(&model.GroupJoinRequest{})
+		db.Unscoped().Where("group_id = ?", group.ID).Delete(&model.GroupJoinRule{})
+		db.Unscoped().Where("group_id = ?", group.ID).Delete(&model.GroupAuditLog{})
+		db.Unscoped().Delete(group)
+	})
+	return group
+}
+
+// groupFixture 群组测试的公共数据：测试库、群组服务、群主和群主创建的群组
+type groupFixture struct {
+	db      *gorm.DB
+	service *GroupService
+	owner   *model.User
+	group   *model.Group
+}
+
+// newGroupFixture 连接测试库并创建群主和群组，maxMembers 为成员上限，0 表示不限
+func newGroupFixture(t *testing.T, maxMembers int) *groupFixture {
+	t.Helper()
+
+	db := newTestDB(t)
+	owner := createTestUser(t, db, "")
+	return &groupFixture{
+		db:      db,
+		service: NewGroupService(db),
+		owner:   owner,
+		group:   createTestGroup(t, db, owner, maxMembers),
+	}
+}
+
+// newPendingSender 创建一个用户并为其提交待处理的入群申请
+func (f *groupFixture) newPendingSender(t *testing.T) (*model.User, *model.GroupJoinRequest) {
+	t.Helper()
+
+	sender := createTestUser(t, f.db, "")
+	return sender, createTestJoinRequest(t, f.db, f.group.ID, sender.ID)
+}
+
+// addTestMember 直接写入成员记录，不修改群组的成员数
+func addTestMember(t *testing.T, db *gorm.DB, groupID string, userID string, role string) {
+	t.Helper()
+
+	err := dao.Use(db).GroupMember.WithContext(context.Background()).Create(&model.GroupMember{
+		GroupID: groupID,
+		UserID:  userID,
+		Role:    role,
+	})
+	if err != nil {
+		t.Fatalf("add member: %v", err)
+	}
+}
+
+// createTestJoinRequest 创建待处理的入群申请
+func createTestJoinRequest(t *testing.T, db *gorm.DB, groupID string, senderID string) *model.GroupJoinRequest {
+	t.Helper()
+
+	joinRequest := &model.GroupJoinRequest{
+		SenderID:      senderID,
+		TargetGroupID: groupID,
+		Status:        StatusPending,
+	}
+	if err := dao.Use(db).GroupJoinRequest.WithContext(context.Background()).Create(joinRequest); err != nil {
+		t.Fatalf("create join request: %v", err)
+	}
+	return joinRequest
+}
+
+// getTestGroup 重新读取群组，用于检查成员数
+func getTestGroup(t *testing.T, db *gorm.DB, groupID string) *model.Group {
+	t.Helper()
+
+	gq := dao.Use(db).Group
+	group, err := gq.WithContext(context.Background()).Where(gq.ID.Eq(groupID)).First()
+	if err != nil {
+		t.Fatalf("get group: %v", err)
+	}
+	return group
+}
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"time"
+	"unicode/utf8"
 
 	"gorm.io/gorm"
 )
@@ -43,6 +44,7 @@ const (
 	errFriendRelationNotFound      = "好友关系不存在"
 	errCannotDeleteNonNormalFriend = "只能删除正常的好友关系"
 	errDeleteFriendFailed          = "删除好友失败"
+	errCannotAddBotAsFriend        = "不能添加机器人为好友"
 )
 
 type UserService struct {
@@ -61,13 +63,40 @@ func (s *UserService) GetMe(ctx context.Context, userID string, username string)
 	if err != nil {
 		return nil, err
 	}
+
+	uq := dao.Use(s.db).User
+	user, err := uq.WithContext(ctx).Select(uq.Organization).Where(uq.ID.Eq(userID)).First()
+	if err != nil {
+		return nil, err
+	}
+
 	return &dto.UserInfoResponse{
-		UserID:   userID,
-		Username: username,
-		Avatar:   avatarUrl,
+		UserID:       userID,
+		Username:     username,
+		Avatar:       avatarUrl,
+		Organization: user.Organization,
 	}, nil
 }
 
+// SetOrganization 设置用户所属的组织，organization 为空时清除。组织用于入群自动审批规则，
+// 用户不能自行填写，只能由运维通过命令行设置
+func (s *UserService) SetOrganization(ctx context.Context, username string, organization string) error {
+	organization = NormalizeOrganization(organization)
+	if utf8.RuneCountInString(organization) > maxOrganizationLength {
+		return fmt.Errorf(errInvalidOrganization)
+	}
+
+	uq := dao.Use(s.db).User
+	result, err := uq.WithContext(ctx).Where(uq.Username.Eq(username)).Update(uq.Organization, organization)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf(errUserNotFound)
+	}
+	return nil
+}
+
 // GetUserInfo 获取用户资料
 func (s *UserService) GetUserInfo(ctx context.Context, userID string, username string) (*dto.UserInfoResponse, error) {
 	avatarUrl, err := s.GetUserAvatarUrl(userID, username)
@@ -144,6 +173,15 @@ func clamp(v, min, max byte) byte {
 
 // SendAddFriendRequest 发送添加好友申请
 func (s *UserService) SendAddFriendRequest(ctx context.Context, userID string, friendID string) (*dto.AddFriendResponse, error) {
+	// 机器人不参与好友关系，只能与创建者私聊
+	isBot, err := s.IsBot(ctx, friendID)
+	if err != nil {
+		return nil, err
+	}
+	if isBot {
+		return nil, fmt.Errorf(errCannotAddBotAsFriend)
+	}
+
 	// 1. 检查 Friend 表是否已存在好友关系
 	friendQ := dao.Use(s.db).Friend
 	friendDo := friendQ.WithContext(ctx)
@@ -335,26 +373,41 @@ func (s *UserService) ProcessFriendRequest(ctx context.Context, userID string, f
 
 	switch action {
 	case ActionParamAccept:
-		// 接受好友申请：在Friend表创建normal记录
-		friendQ := dao.Use(s.db).Friend
-		friendDo := friendQ.WithContext(ctx)
-
-		friendRecord := model.Friend{
-			UserA:  friendID,
-			UserB:  userID,
-			Status: FriendStatusNormal,
-		}
-
-		if err := friendDo.Create(&friendRecord); err != nil {
-			return nil, fmt.Errorf("%s: %v", errCreateFriendRelationFailed, err)
-		}
-
-		// 删除FriendRequest表中的对应记录（软删除）
-		if _, err := requestDo.Where(
-			requestQ.SenderID.Eq(friendID),
-			requestQ.ReceiverID.Eq(userID),
-		).Delete(); err != nil {
-			return nil, fmt.Errorf("%s: %v", errDeleteFriendRequestFailed, err)
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			// 接受好友申请：在Friend表创建normal记录
+			friendQ := dao.Use(tx).Friend
+			friendDo := friendQ.WithContext(ctx)
+
+			friendRecord := model.Friend{
+				UserA:  friendID,
+				UserB:  userID,
+				Status: FriendStatusNormal,
+			}
+
+			if err := friendDo.Create(&friendRecord); err != nil {
+				return fmt.Errorf("%s: %v", errCreateFriendRelationFailed, err)
+			}
+
+			// 删除FriendRequest表中的对应记录（软删除）
+			txRequestQ := dao.Use(tx).FriendRequest
+			if _, err := txRequestQ.WithContext(ctx).Where(
+				txRequestQ.SenderID.Eq(friendID),
+				txRequestQ.ReceiverID.Eq(userID),
+			).Delete(); err != nil {
+				return fmt.Errorf("%s: %v", errDeleteFriendRequestFailed, err)
+			}
+
+			return enqueueWebhookEvent(ctx, tx, webhookEvent{
+				Name:    WebhookEventFriendRequestAccepted,
+				UserIDs: []string{friendID, userID},
+				Data: dto.WebhookFriendData{
+					SenderID:   friendID,
+					ReceiverID: userID,
+				},
+			})
+		})
+		if err != nil {
+			return nil, err
 		}
 
 		return &dto.AddFriendResponse{
@@ -436,3 +489,42 @@ func (s *UserService) IsFriend(ctx context.Context, userID string, targetUserID
 
 	return count > 0, nil
 }
+
+// IsBot 判断用户是否为机器人或集成账号
+func (s *UserService) IsBot(ctx context.Context, userID string) (bool, error) {
+	q := dao.Use(s.db).User
+	do := q.WithContext(ctx)
+
+	count, err := do.Where(q.ID.Eq(userID), q.Type.Neq(model.UserTypeUser)).Count()
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// CanChatPrivately 判断两个用户之间能否私聊：好友之间，或机器人与其创建者之间
+func (s *UserService) CanChatPrivately(ctx context.Context, userID string, targetUserID string) (bool, error) {
+	isFriend, err := s.IsFriend(ctx, userID, targetUserID)
+	if err != nil || isFriend {
+		return isFriend, err
+	}
+
+	q := dao.Use(s.db).User
+	do := q.WithContext(ctx)
+
+	count, err := do.Where(
+		q.ID.Eq(userID),
+		q.Type.Eq(model.UserTypeBot),
+		q.BotOwnerID.Eq(targetUserID),
+	).Or(
+		q.ID.Eq(targetUserID),
+		q.Type.Eq(model.UserTypeBot),
+		q.BotOwnerID.Eq(userID),
+	).Count()
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,704 @@
+package service
+
+import (
+	"chat_backend/internal/dao"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"chat_backend/pkg/logger"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	WebhookScopeGroup = "group"
+	WebhookScopeUser  = "user"
+
+	WebhookEventGroupMessageCreated   = "group.message_created"
+	WebhookEventGroupMemberJoined     = "group.member_joined"
+	WebhookEventGroupMemberLeft       = "group.member_left"
+	WebhookEventGroupDisbanded        = "group.disbanded"
+	WebhookEventFriendRequestAccepted = "friend.request_accepted"
+
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+
+	// 请求头
+	WebhookHeaderEvent     = "X-Webhook-Event"
+	WebhookHeaderDelivery  = "X-Webhook-Delivery"
+	WebhookHeaderTimestamp = "X-Webhook-Timestamp"
+	WebhookHeaderSignature = "X-Webhook-Signature" // sha256=HMAC(secret, timestamp + "." + body)
+
+	webhookSecretPrefix     = "whsec_"
+	webhookSecretBytes      = 32
+	maxWebhooksPerTarget    = 10
+	webhookRequestTimeout   = 10 * time.Second
+	webhookPollInterval     = 2 * time.Second
+	webhookClaimBatchSize   = 50
+	webhookClaimLease       = time.Minute // 投递中的记录在租期内不会被其他实例重复领取
+	webhookMaxAttempts      = 8
+	webhookBaseBackoff      = 10 * time.Second
+	webhookMaxBackoff       = time.Hour
+	webhookDisableThreshold = 20 // 连续失败达到该次数后自动停用
+	maxWebhookDeliveryLimit = 100
+)
+
+const (
+	errWebhookNotFound         = "webhook not found"
+	errWebhookDeliveryNotFound = "webhook delivery not found"
+	errInvalidWebhookScope     = "invalid webhook scope"
+	errInvalidWebhookURL       = "invalid webhook url"
+	errInvalidWebhookEvents    = "invalid webhook events"
+	errTooManyWebhooks         = "too many webhooks"
+)
+
+// webhookEventScopes 各事件可以被哪些范围的 Webhook 订阅
+var webhookEventScopes = map[string][]string{
+	WebhookEventGroupMessageCreated:   {WebhookScopeGroup},
+	WebhookEventGroupMemberJoined:     {WebhookScopeGroup, WebhookScopeUser},
+	WebhookEventGroupMemberLeft:       {WebhookScopeGroup, WebhookScopeUser},
+	WebhookEventGroupDisbanded:        {WebhookScopeGroup, WebhookScopeUser},
+	WebhookEventFriendRequestAccepted: {WebhookScopeUser},
+}
+
+var webhookClient = newOutboundHTTPClient(webhookRequestTimeout)
+
+// webhookEvent 需要投递的事件
+type webhookEvent struct {
+	Name    string
+	GroupID string   // 订阅该群组的 Webhook 会收到事件
+	UserIDs []string // 订阅这些用户的 Webhook 会收到事件
+	Data    interface{}
+}
+
+type WebhookService struct {
+	db *gorm.DB
+}
+
+func NewWebhookService(db *gorm.DB) *WebhookService {
+	return &WebhookService{
+		db: db,
+	}
+}
+
+// CreateWebhook 注册 Webhook：群组 Webhook 仅群主可以注册，用户 Webhook 只能订阅自己的事件
+func (s *WebhookService) CreateWebhook(ctx context.Context, userID string, req dto.CreateWebhookRequest) (*dto.WebhookSecretResponse, error) {
+	targetID := req.TargetID
+	if req.Scope == WebhookScopeUser {
+		targetID = userID
+	}
+	if err := s.checkWebhookTarget(ctx, userID, req.Scope, targetID); err != nil {
+		return nil, err
+	}
+	if err := validatePublicHTTPURL(ctx, req.URL); err != nil {
+		return nil, fmt.Errorf(errInvalidWebhookURL)
+	}
+	events, err := normalizeWebhookEvents(req.Scope, req.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	wq := dao.Use(s.db).Webhook
+	count, err := wq.WithContext(ctx).Where(wq.Scope.Eq(req.Scope), wq.TargetID.Eq(targetID)).Count()
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxWebhooksPerTarget {
+		return nil, fmt.Errorf(errTooManyWebhooks)
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	webhook := &model.Webhook{
+		CreatorID: userID,
+		Scope:     req.Scope,
+		TargetID:  targetID,
+		URL:       req.URL,
+		Secret:    secret,
+		Events:    strings.Join(events, ","),
+		Enabled:   true,
+	}
+	if err := wq.WithContext(ctx).Create(webhook); err != nil {
+		return nil, err
+	}
+
+	return &dto.WebhookSecretResponse{
+		Webhook: toWebhookResponse(webhook),
+		Secret:  secret,
+	}, nil
+}
+
+// ListWebhooks 获取用户可管理的 Webhook：自己的用户 Webhook 以及作为群主的群组 Webhook
+func (s *WebhookService) ListWebhooks(ctx context.Context, userID string, groupID string) ([]*dto.WebhookResponse, error) {
+	wq := dao.Use(s.db).Webhook
+	wdo := wq.WithContext(ctx)
+
+	var webhooks []*model.Webhook
+	var err error
+	if groupID != "" {
+		if err := s.checkWebhookTarget(ctx, userID, WebhookScopeGroup, groupID); err != nil {
+			return nil, err
+		}
+		webhooks, err = wdo.Where(wq.Scope.Eq(WebhookScopeGroup), wq.TargetID.Eq(groupID)).Order(wq.CreatedAt).Find()
+	} else {
+		webhooks, err = wdo.Where(wq.Scope.Eq(WebhookScopeUser), wq.TargetID.Eq(userID)).Order(wq.CreatedAt).Find()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.WebhookResponse, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		response := toWebhookResponse(webhook)
+		result = append(result, &response)
+	}
+
+	return result, nil
+}
+
+// UpdateWebhook 更新 Webhook 地址、订阅事件或启用状态
+func (s *WebhookService) UpdateWebhook(ctx context.Context, userID string, webhookID string, req dto.UpdateWebhookRequest) (*dto.WebhookResponse, error) {
+	webhook, err := s.getManagedWebhook(ctx, userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		if err := validatePublicHTTPURL(ctx, *req.URL); err != nil {
+			return nil, fmt.Errorf(errInvalidWebhookURL)
+		}
+		webhook.URL = *req.URL
+	}
+	if req.Events != nil {
+		events, err := normalizeWebhookEvents(webhook.Scope, req.Events)
+		if err != nil {
+			return nil, err
+		}
+		webhook.Events = strings.Join(events, ",")
+	}
+	if req.Enabled != nil {
+		if *req.Enabled && !webhook.Enabled {
+			webhook.FailureCount = 0
+			webhook.DisabledReason = ""
+		}
+		webhook.Enabled = *req.Enabled
+	}
+
+	wq := dao.Use(s.db).Webhook
+	if _, err := wq.WithContext(ctx).Where(wq.ID.Eq(webhook.ID)).Select(
+		wq.URL, wq.Events, wq.Enabled, wq.FailureCount, wq.DisabledReason,
+	).Updates(webhook); err != nil {
+		return nil, err
+	}
+
+	response := toWebhookResponse(webhook)
+	return &response, nil
+}
+
+// RotateWebhookSecret 重新生成签名密钥，旧密钥立即失效
+func (s *WebhookService) RotateWebhookSecret(ctx context.Context, userID string, webhookID string) (*dto.WebhookSecretResponse, error) {
+	webhook, err := s.getManagedWebhook(ctx, userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	wq := dao.Use(s.db).Webhook
+	if _, err := wq.WithContext(ctx).Where(wq.ID.Eq(webhook.ID)).Update(wq.Secret, secret); err != nil {
+		return nil, err
+	}
+	webhook.Secret = secret
+
+	return &dto.WebhookSecretResponse{
+		Webhook: toWebhookResponse(webhook),
+		Secret:  secret,
+	}, nil
+}
+
+// DeleteWebhook 删除 Webhook 及其投递记录
+func (s *WebhookService) DeleteWebhook(ctx context.Context, userID string, webhookID string) error {
+	webhook, err := s.getManagedWebhook(ctx, userID, webhookID)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		dq := dao.Use(tx).WebhookDelivery
+		if _, err := dq.WithContext(ctx).Where(dq.WebhookID.Eq(webhook.ID)).Delete(); err != nil {
+			return err
+		}
+
+		wq := dao.Use(tx).Webhook
+		_, err := wq.WithContext(ctx).Where(wq.ID.Eq(webhook.ID)).Delete()
+		return err
+	})
+}
+
+// ListDeliveries 获取 Webhook 的投递日志，按时间倒序
+func (s *WebhookService) ListDeliveries(ctx context.Context, userID string, webhookID string, status string, limit int) ([]*dto.WebhookDeliveryResponse, error) {
+	webhook, err := s.getManagedWebhook(ctx, userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > maxWebhookDeliveryLimit {
+		limit = maxWebhookDeliveryLimit
+	}
+
+	dq := dao.Use(s.db).WebhookDelivery
+	ddo := dq.WithContext(ctx).Where(dq.WebhookID.Eq(webhook.ID))
+	if status != "" {
+		ddo = ddo.Where(dq.Status.Eq(status))
+	}
+	deliveries, err := ddo.Order(dq.CreatedAt.Desc()).Limit(limit).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.WebhookDeliveryResponse, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		result = append(result, toWebhookDeliveryResponse(delivery))
+	}
+
+	return result, nil
+}
+
+// RetryDelivery 手动重新投递失败的记录
+func (s *WebhookService) RetryDelivery(ctx context.Context, userID string, webhookID string, deliveryID string) (*dto.WebhookDeliveryResponse, error) {
+	webhook, err := s.getManagedWebhook(ctx, userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	dq := dao.Use(s.db).WebhookDelivery
+	delivery, err := dq.WithContext(ctx).Where(dq.ID.Eq(deliveryID), dq.WebhookID.Eq(webhook.ID)).First()
+	if err != nil {
+		return nil, fmt.Errorf(errWebhookDeliveryNotFound)
+	}
+
+	delivery.Status = WebhookDeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = time.Now()
+	if _, err := dq.WithContext(ctx).Where(dq.ID.Eq(delivery.ID)).Select(
+		dq.Status, dq.Attempts, dq.NextAttemptAt,
+	).Updates(delivery); err != nil {
+		return nil, err
+	}
+
+	return toWebhookDeliveryResponse(delivery), nil
+}
+
+// StartWebhookWorker 启动后台投递协程，定期领取到期的投递记录并发送，直到 ctx 取消
+func (s *WebhookService) StartWebhookWorker(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(webhookPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.processDueDeliveries(ctx)
+			}
+		}
+	}()
+}
+
+// processDueDeliveries 领取并发送一批到期的投递记录
+func (s *WebhookService) processDueDeliveries(ctx context.Context) {
+	deliveries, err := s.claimDueDeliveries(ctx)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to claim webhook deliveries", "error", err)
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func(delivery *model.WebhookDelivery) {
+			defer wg.Done()
+			if err := s.attemptDelivery(ctx, delivery); err != nil {
+				logger.GetLogger().Errorw("Failed to update webhook delivery", "delivery_id", delivery.ID, "error", err)
+			}
+		}(delivery)
+	}
+	wg.Wait()
+}
+
+// claimDueDeliveries 锁定到期的投递记录并延后下次投递时间，多实例部署时不会重复领取
+func (s *WebhookService) claimDueDeliveries(ctx context.Context) ([]*model.WebhookDelivery, error) {
+	var deliveries []*model.WebhookDelivery
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		dq := dao.Use(tx).WebhookDelivery
+		now := time.Now()
+
+		var err error
+		deliveries, err = dq.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).Where(
+			dq.Status.Eq(WebhookDeliveryPending),
+			dq.NextAttemptAt.Lte(now),
+		).Order(dq.NextAttemptAt).Limit(webhookClaimBatchSize).Find()
+		if err != nil || len(deliveries) == 0 {
+			return err
+		}
+
+		ids := make([]string, 0, len(deliveries))
+		for _, delivery := range deliveries {
+			ids = append(ids, delivery.ID)
+		}
+		_, err = dq.WithContext(ctx).Where(dq.ID.In(ids...)).Update(dq.NextAttemptAt, now.Add(webhookClaimLease))
+		return err
+	})
+
+	return deliveries, err
+}
+
+// attemptDelivery 发送一次投递并记录结果
+func (s *WebhookService) attemptDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	wq := dao.Use(s.db).Webhook
+	webhook, err := wq.WithContext(ctx).Where(wq.ID.Eq(delivery.WebhookID)).First()
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if webhook == nil || !webhook.Enabled {
+		dq := dao.Use(s.db).WebhookDelivery
+		_, err := dq.WithContext(ctx).Where(dq.ID.Eq(delivery.ID)).Updates(map[string]interface{}{
+			"status":     WebhookDeliveryFailed,
+			"last_error": "webhook disabled",
+		})
+		return err
+	}
+
+	statusCode, sendErr := sendWebhookRequest(ctx, webhook, delivery)
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastStatusCode = statusCode
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		dq := dao.Use(tx).WebhookDelivery
+		wq := dao.Use(tx).Webhook
+
+		if sendErr == nil {
+			delivery.Status = WebhookDeliverySucceeded
+			delivery.LastError = ""
+			delivery.DeliveredAt = &now
+			if _, err := dq.WithContext(ctx).Where(dq.ID.Eq(delivery.ID)).Select(
+				dq.Status, dq.Attempts, dq.LastStatusCode, dq.LastError, dq.DeliveredAt,
+			).Updates(delivery); err != nil {
+				return err
+			}
+			_, err := wq.WithContext(ctx).Where(wq.ID.Eq(webhook.ID), wq.FailureCount.Gt(0)).Update(wq.FailureCount, 0)
+			return err
+		}
+
+		delivery.LastError = sendErr.Error()
+		if delivery.Attempts >= webhookMaxAttempts {
+			delivery.Status = WebhookDeliveryFailed
+		} else {
+			delivery.NextAttemptAt = now.Add(webhookBackoff(delivery.Attempts))
+		}
+		if _, err := dq.WithContext(ctx).Where(dq.ID.Eq(delivery.ID)).Select(
+			dq.Status, dq.Attempts, dq.NextAttemptAt, dq.LastStatusCode, dq.LastError,
+		).Updates(delivery); err != nil {
+			return err
+		}
+
+		if _, err := wq.WithContext(ctx).Where(wq.ID.Eq(webhook.ID)).UpdateSimple(wq.FailureCount.Add(1)); err != nil {
+			return err
+		}
+		current, err := wq.WithContext(ctx).Where(wq.ID.Eq(webhook.ID)).First()
+		if err != nil {
+			return err
+		}
+		if !current.Enabled || current.FailureCount < webhookDisableThreshold {
+			return nil
+		}
+
+		// 连续失败过多，停用 Webhook 并放弃剩余的投递
+		logger.GetLogger().Warnw("Webhook disabled after consecutive failures", "webhook_id", webhook.ID, "url", webhook.URL)
+		if _, err := wq.WithContext(ctx).Where(wq.ID.Eq(webhook.ID)).Updates(map[string]interface{}{
+			"enabled":         false,
+			"disabled_reason": fmt.Sprintf("disabled after %d consecutive failures: %s", webhookDisableThreshold, delivery.LastError),
+		}); err != nil {
+			return err
+		}
+		_, err = dq.WithContext(ctx).Where(dq.WebhookID.Eq(webhook.ID), dq.Status.Eq(WebhookDeliveryPending)).Updates(map[string]interface{}{
+			"status":     WebhookDeliveryFailed,
+			"last_error": "webhook disabled",
+		})
+		return err
+	})
+}
+
+// checkWebhookTarget 检查用户是否可以管理该范围的 Webhook
+func (s *WebhookService) checkWebhookTarget(ctx context.Context, userID string, scope string, targetID string) error {
+	switch scope {
+	case WebhookScopeUser:
+		if targetID != userID {
+			return fmt.Errorf(errPermissionDenied)
+		}
+		return nil
+	case WebhookScopeGroup:
+		gq := dao.Use(s.db).Group
+		group, err := gq.WithContext(ctx).Where(gq.ID.Eq(targetID)).First()
+		if err != nil {
+			return fmt.Errorf(errGroupNotFound)
+		}
+		if group.OwnerID != userID {
+			return fmt.Errorf(errPermissionDenied)
+		}
+		return nil
+	default:
+		return fmt.Errorf(errInvalidWebhookScope)
+	}
+}
+
+// getManagedWebhook 获取用户可以管理的 Webhook
+func (s *WebhookService) getManagedWebhook(ctx context.Context, userID string, webhookID string) (*model.Webhook, error) {
+	wq := dao.Use(s.db).Webhook
+	webhook, err := wq.WithContext(ctx).Where(wq.ID.Eq(webhookID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf(errWebhookNotFound)
+		}
+		return nil, err
+	}
+
+	if err := s.checkWebhookTarget(ctx, userID, webhook.Scope, webhook.TargetID); err != nil {
+		if err.Error() == errGroupNotFound {
+			return nil, fmt.Errorf(errWebhookNotFound)
+		}
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// enqueueWebhookEvent 在业务事务中为订阅了事件的 Webhook 写入待投递记录，业务回滚时不会投递
+func enqueueWebhookEvent(ctx context.Context, tx *gorm.DB, event webhookEvent) error {
+	wq := dao.Use(tx).Webhook
+
+	var webhooks []*model.Webhook
+	if event.GroupID != "" {
+		groupHooks, err := wq.WithContext(ctx).Where(
+			wq.Scope.Eq(WebhookScopeGroup),
+			wq.TargetID.Eq(event.GroupID),
+			wq.Enabled.Is(true),
+		).Find()
+		if err != nil {
+			return err
+		}
+		webhooks = append(webhooks, groupHooks...)
+	}
+	if len(event.UserIDs) > 0 {
+		userHooks, err := wq.WithContext(ctx).Where(
+			wq.Scope.Eq(WebhookScopeUser),
+			wq.TargetID.In(event.UserIDs...),
+			wq.Enabled.Is(true),
+		).Find()
+		if err != nil {
+			return err
+		}
+		webhooks = append(webhooks, userHooks...)
+	}
+
+	var subscribed []*model.Webhook
+	for _, webhook := range webhooks {
+		if webhookSubscribes(webhook, event.Name) {
+			subscribed = append(subscribed, webhook)
+		}
+	}
+	if len(subscribed) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	payload, err := json.Marshal(dto.WebhookEventPayload{
+		ID:        uuid.New().String(),
+		Event:     event.Name,
+		CreatedAt: now,
+		Data:      event.Data,
+	})
+	if err != nil {
+		return err
+	}
+
+	deliveries := make([]*model.WebhookDelivery, 0, len(subscribed))
+	for _, webhook := range subscribed {
+		deliveries = append(deliveries, &model.WebhookDelivery{
+			WebhookID:     webhook.ID,
+			Event:         event.Name,
+			Payload:       string(payload),
+			Status:        WebhookDeliveryPending,
+			NextAttemptAt: now,
+		})
+	}
+
+	return dao.Use(tx).WebhookDelivery.WithContext(ctx).CreateInBatches(deliveries, 100)
+}
+
+// sendWebhookRequest 发送签名后的请求，返回响应状态码
+func sendWebhookRequest(ctx context.Context, webhook *model.Webhook, delivery *model.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookHeaderEvent, delivery.Event)
+	req.Header.Set(WebhookHeaderDelivery, delivery.ID)
+	setSignatureHeaders(req, webhook.Secret, []byte(delivery.Payload))
+
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// 不记录响应内容，避免通过投递日志读取目标地址返回的数据
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// setSignatureHeaders 设置请求的时间戳和签名请求头，Webhook 投递、卡片回调和命令回调使用相同的签名方式
+func setSignatureHeaders(req *http.Request, secret string, body []byte) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set(WebhookHeaderTimestamp, timestamp)
+	req.Header.Set(WebhookHeaderSignature, "sha256="+signWebhookPayload(secret, timestamp, body))
+}
+
+// signWebhookPayload 计算请求签名，接收方使用相同的密钥校验
+func signWebhookPayload(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookBackoff 第 n 次失败后的重试间隔：10s、20s、40s……最长 1 小时
+func webhookBackoff(attempts int) time.Duration {
+	backoff := webhookBaseBackoff
+	for i := 1; i < attempts && backoff < webhookMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > webhookMaxBackoff {
+		backoff = webhookMaxBackoff
+	}
+	return backoff
+}
+
+// normalizeWebhookEvents 校验并去重订阅事件
+func normalizeWebhookEvents(scope string, events []string) ([]string, error) {
+	if len(events) == 0 {
+		return nil, fmt.Errorf(errInvalidWebhookEvents)
+	}
+
+	seen := make(map[string]bool, len(events))
+	result := make([]string, 0, len(events))
+	for _, event := range events {
+		event = strings.TrimSpace(event)
+		if seen[event] {
+			continue
+		}
+		allowed := false
+		for _, s := range webhookEventScopes[event] {
+			if s == scope {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return nil, fmt.Errorf(errInvalidWebhookEvents)
+		}
+		seen[event] = true
+		result = append(result, event)
+	}
+
+	return result, nil
+}
+
+func webhookSubscribes(webhook *model.Webhook, event string) bool {
+	for _, subscribed := range strings.Split(webhook.Events, ",") {
+		if subscribed == event {
+			return true
+		}
+	}
+	return false
+}
+
+func generateWebhookSecret() (string, error) {
+	buf := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return webhookSecretPrefix + hex.EncodeToString(buf), nil
+}
+
+func toWebhookResponse(webhook *model.Webhook) dto.WebhookResponse {
+	return dto.WebhookResponse{
+		WebhookID:      webhook.ID,
+		Scope:          webhook.Scope,
+		TargetID:       webhook.TargetID,
+		URL:            webhook.URL,
+		Events:         strings.Split(webhook.Events, ","),
+		Enabled:        webhook.Enabled,
+		FailureCount:   webhook.FailureCount,
+		DisabledReason: webhook.DisabledReason,
+		CreatedAt:      webhook.CreatedAt,
+		UpdatedAt:      webhook.UpdatedAt,
+	}
+}
+
+func toWebhookDeliveryResponse(delivery *model.WebhookDelivery) *dto.WebhookDeliveryResponse {
+	return &dto.WebhookDeliveryResponse{
+		DeliveryID:     delivery.ID,
+		Event:          delivery.Event,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		CreatedAt:      delivery.CreatedAt,
+		DeliveredAt:    delivery.DeliveredAt,
+	}
+}
+
+// newMemberWebhookEvent 成员加入或离开群组的事件，同时投递给群组和该成员订阅的 Webhook
+func newMemberWebhookEvent(name string, groupID string, userID string, operatorID string) webhookEvent {
+	return webhookEvent{
+		Name:    name,
+		GroupID: groupID,
+		UserIDs: []string{userID},
+		Data: dto.WebhookMemberData{
+			GroupID:    groupID,
+			UserID:     userID,
+			OperatorID: operatorID,
+		},
+	}
+}
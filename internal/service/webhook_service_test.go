@@ -0,0 +1,57 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSetSignatureHeaders(t *testing.T) {
+	secret := "whsec_test"
+	body := []byte(`{"event":"group.member_joined"}`)
+
+	req, err := http.NewRequest(http.MethodPost, "https://example.com/hook", nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	setSignatureHeaders(req, secret, body)
+
+	timestamp := req.Header.Get(WebhookHeaderTimestamp)
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || time.Since(time.Unix(unix, 0)).Abs() > time.Minute {
+		t.Fatalf("timestamp header = %q, want the current unix time", timestamp)
+	}
+
+	// 按 README 中接收方的校验方式重新计算签名
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + string(body)))
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	if got := req.Header.Get(WebhookHeaderSignature); !hmac.Equal([]byte(got), []byte(want)) {
+		t.Errorf("signature header = %q, want %q", got, want)
+	}
+
+	if signWebhookPayload("other", timestamp, body) == signWebhookPayload(secret, timestamp, body) {
+		t.Errorf("signatures with different secrets should differ")
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, webhookBaseBackoff},
+		{2, 2 * webhookBaseBackoff},
+		{3, 4 * webhookBaseBackoff},
+		{100, webhookMaxBackoff},
+	}
+	for _, tt := range tests {
+		if got := webhookBackoff(tt.attempts); got != tt.want {
+			t.Errorf("webhookBackoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package websocket
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"chat_backend/internal/service"
+	"chat_backend/pkg/logger"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// assistantReplyTimeout 助手生成并发送一条回复的超时时间
+	assistantReplyTimeout = 2 * time.Minute
+	// assistantFailedMessage 助手生成失败时推送给客户端的原因
+	assistantFailedMessage = "assistant failed to reply"
+)
+
+var errAssistantReplyTooLong = errors.New("assistant reply too long")
+
+// assistantsReplying 正在生成回复的群组，同一群组同时只生成一条回复，生成期间的提及会被忽略
+var assistantsReplying sync.Map
+
+// triggerAssistant 在群消息存储并广播之后异步检查是否提及了群组助手，提及时生成回复。
+// 回复生成期间通过 assistant_delta 事件推送增量内容，生成结束后作为普通群消息存储并推送，
+// 增量事件和最终消息使用相同的消息ID，客户端据此将流式内容替换为最终消息
+func triggerAssistant(senderID string, groupID string, messageID string, content string) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), assistantReplyTimeout)
+		defer cancel()
+
+		assistantService := service.NewAssistantService(database.GetDB())
+		invocation, err := assistantService.PrepareAssistantInvocation(ctx, groupID, senderID, messageID, content)
+		if err != nil {
+			logger.GetLogger().Errorw("Failed to prepare assistant invocation", "group_id", groupID, "error", err)
+			return
+		}
+		if invocation == nil {
+			return
+		}
+
+		if _, replying := assistantsReplying.LoadOrStore(groupID, struct{}{}); replying {
+			return
+		}
+		defer assistantsReplying.Delete(groupID)
+
+		streamAssistantReply(ctx, invocation)
+	}()
+}
+
+// streamAssistantReply 调用回复器生成回复，逐段推送给群组在线成员，完成后存储为群消息
+func streamAssistantReply(ctx context.Context, invocation *service.AssistantInvocation) {
+	groupID := invocation.Assistant.GroupID
+	assistantID := invocation.Assistant.UserID
+
+	recipientIDs, onlineUserIDs, err := GetMessageRecipients(ctx, model.MessageTypeGroup, groupID, assistantID)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to get group members", "group_id", groupID, "error", err)
+		return
+	}
+
+	replyID := uuid.New().String()
+	var reply strings.Builder
+	emit := func(delta string) error {
+		if utf8.RuneCountInString(reply.String())+utf8.RuneCountInString(delta) > service.MaxAssistantReplyLength {
+			return errAssistantReplyTooLong
+		}
+		reply.WriteString(delta)
+		broadcastAssistantDelta(groupID, recipientIDs, dto.AssistantMessageDelta{
+			MessageID: replyID,
+			GroupID:   groupID,
+			From:      assistantID,
+			Delta:     delta,
+		})
+		return nil
+	}
+
+	err = invocation.Responder.Respond(ctx, invocation.Request, emit)
+	if err != nil && !errors.Is(err, errAssistantReplyTooLong) {
+		logger.GetLogger().Errorw("Assistant failed to reply", "group_id", groupID, "responder", invocation.Assistant.Responder, "error", err)
+		broadcastAssistantDelta(groupID, recipientIDs, dto.AssistantMessageDelta{
+			MessageID: replyID,
+			GroupID:   groupID,
+			From:      assistantID,
+			Done:      true,
+			Error:     assistantFailedMessage,
+		})
+		return
+	}
+
+	content := strings.TrimSpace(reply.String())
+	broadcastAssistantDelta(groupID, recipientIDs, dto.AssistantMessageDelta{
+		MessageID: replyID,
+		GroupID:   groupID,
+		From:      assistantID,
+		Done:      true,
+	})
+	if content == "" {
+		return
+	}
+
+	messageService := service.NewMessageService(database.GetDB())
+	message, err := messageService.SendGroupMessage(ctx, assistantID, groupID, "", model.MessageKindText, content, replyID, recipientIDs, onlineUserIDs)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to store assistant reply", "group_id", groupID, "message_id", replyID, "error", err)
+		return
+	}
+
+	DeliverMessage(message, nil, recipientIDs)
+}
+
+// broadcastAssistantDelta 向群组在线成员推送助手回复的增量更新
+func broadcastAssistantDelta(groupID string, recipientIDs []string, delta dto.AssistantMessageDelta) {
+	msg, err := NewEventMessage(MessageTypeAssistantDelta, groupID, &delta)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to build event message", "type", MessageTypeAssistantDelta, "group_id", groupID, "error", err)
+		return
+	}
+	msg.ChatType = ChatTypeGroup
+	msg.MessageID = delta.MessageID
+
+	GetConnectionManager().BroadcastToGroup(msg, recipientIDs)
+}
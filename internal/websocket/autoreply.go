@@ -0,0 +1,54 @@
+package websocket
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/model"
+	"chat_backend/internal/service"
+	"chat_backend/pkg/logger"
+	"context"
+	"time"
+)
+
+// autoReplyTimeout 单次自动回复（匹配规则、存储并广播回复）的超时时间
+const autoReplyTimeout = 10 * time.Second
+
+// SendWelcomeMessage 在新成员入群后向群组发送欢迎语，群组未设置欢迎语时不发送。
+// 欢迎语以系统消息的形式存储，发送者记为新成员，新成员自己也会收到
+func SendWelcomeMessage(ctx context.Context, groupID string, userID string) {
+	autoReplyService := service.NewAutoReplyService(database.GetDB(), database.GetRedis())
+	content, err := autoReplyService.RenderWelcomeMessage(ctx, groupID, userID)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to render welcome message", "group_id", groupID, "user_id", userID, "error", err)
+		return
+	}
+	if content == "" {
+		return
+	}
+
+	if _, err := postGroupCommandMessage(ctx, userID, groupID, model.MessageKindSystem, content, ""); err != nil {
+		logger.GetLogger().Errorw("Failed to send welcome message", "group_id", groupID, "user_id", userID, "error", err)
+	}
+}
+
+// triggerAutoReply 在群消息存储并广播之后异步匹配自动回复规则，不阻塞发送者。
+// 机器人和集成账号的消息不触发自动回复，避免互相回复形成循环
+func triggerAutoReply(senderID string, groupID string, content string) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), autoReplyTimeout)
+		defer cancel()
+
+		autoReplyService := service.NewAutoReplyService(database.GetDB(), database.GetRedis())
+		rule, err := autoReplyService.MatchAutoReply(ctx, groupID, content)
+		if err != nil {
+			logger.GetLogger().Errorw("Failed to match auto reply", "group_id", groupID, "error", err)
+			return
+		}
+		if rule == nil {
+			return
+		}
+
+		if _, err := postGroupCommandMessage(ctx, senderID, groupID, model.MessageKindSystem, rule.Reply, ""); err != nil {
+			logger.GetLogger().Errorw("Failed to send auto reply", "group_id", groupID, "rule_id", rule.ID, "error", err)
+		}
+	}()
+}
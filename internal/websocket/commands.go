@@ -0,0 +1,517 @@
+package websocket
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"chat_backend/internal/service"
+	"chat_backend/pkg/logger"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// CommandPrefix 斜杠命令前缀，以两个前缀开头的消息会去掉一个前缀后作为普通消息发送
+const CommandPrefix = "/"
+
+const maxCommandDuration = 365 * 24 * time.Hour
+
+// 执行命令的错误，错误信息会作为系统消息返回给调用者
+var (
+	ErrUnknownCommand     = errors.New("未知的命令，输入 /help 查看可用命令")
+	ErrCommandGroupOnly   = errors.New("该命令只能在群聊中使用")
+	ErrCommandUnavailable = errors.New("处理该命令的机器人当前不在线")
+	ErrCommandFailed      = errors.New("命令执行失败")
+)
+
+// CommandContext 命令的调用上下文
+type CommandContext struct {
+	UserID   string   // 调用者用户ID
+	Username string   // 调用者用户名
+	ChatType ChatType // 调用命令的会话类型
+	TargetID string   // 私聊对象的用户ID或群组ID
+	Name     string   // 命令名，不含前缀
+	Args     string   // 命令名之后的原始参数
+}
+
+// CommandResult 命令的回复，Visibility 为 group 时作为系统消息发送到群组，否则仅调用者可见
+type CommandResult struct {
+	Text       string
+	Visibility string
+}
+
+// CommandHandler 命令处理函数，返回 nil 表示没有回复
+type CommandHandler func(ctx context.Context, cmd *CommandContext) (*CommandResult, error)
+
+// Command 内置命令
+type Command struct {
+	Name        string
+	Description string
+	Usage       string
+	GroupOnly   bool // 仅群聊可用
+	Handler     CommandHandler
+}
+
+var (
+	commandsMu sync.RWMutex
+	commands   = map[string]*Command{}
+)
+
+func init() {
+	RegisterCommand(Command{Name: "help", Description: "查看可用命令", Usage: "/help", Handler: helpCommand})
+	RegisterCommand(Command{Name: "mute", Description: "当前会话免打扰", Usage: "/mute <时长，如 30m、2h、1d> | /mute off", Handler: muteCommand})
+	RegisterCommand(Command{Name: "kick", Description: "将成员移出群组（需要移除成员的权限）", Usage: "/kick @用户名", GroupOnly: true, Handler: kickCommand})
+	RegisterCommand(Command{Name: "poll", Description: "发起投票", Usage: `/poll "问题" "选项1" "选项2" [--multi] [--anonymous]`, GroupOnly: true, Handler: pollCommand})
+	RegisterCommand(Command{Name: "remind", Description: "设置提醒", Usage: "/remind <时长，如 30m、2h、1d> <内容>", Handler: remindCommand})
+	RegisterCommand(Command{Name: "topic", Description: "查看或设置群话题（设置需要修改群资料的权限）", Usage: "/topic [新话题] | /topic --clear", GroupOnly: true, Handler: topicCommand})
+}
+
+// RegisterCommand 注册内置命令，同名命令会被覆盖
+func RegisterCommand(cmd Command) {
+	commandsMu.Lock()
+	defer commandsMu.Unlock()
+	commands[cmd.Name] = &cmd
+}
+
+// IsBuiltinCommand 判断是否为内置命令，机器人不能注册同名命令
+func IsBuiltinCommand(name string) bool {
+	_, ok := getCommand(name)
+	return ok
+}
+
+// BuiltinCommands 获取内置命令列表
+func BuiltinCommands(chatType ChatType) []*dto.CommandInfo {
+	commandsMu.RLock()
+	defer commandsMu.RUnlock()
+
+	result := make([]*dto.CommandInfo, 0, len(commands))
+	for _, cmd := range commands {
+		if cmd.GroupOnly && chatType != ChatTypeGroup {
+			continue
+		}
+		result = append(result, &dto.CommandInfo{
+			Name:        cmd.Name,
+			Description: cmd.Description,
+			Usage:       cmd.Usage,
+			Builtin:     true,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	return result
+}
+
+func getCommand(name string) (*Command, bool) {
+	commandsMu.RLock()
+	defer commandsMu.RUnlock()
+	cmd, ok := commands[name]
+	return cmd, ok
+}
+
+// ExecuteCommand 执行斜杠命令，代替普通消息的存储和投递
+// 参数:
+//   - ctx: 上下文
+//   - senderID: 调用者用户ID
+//   - msg: 以 / 开头的文本消息
+//
+// 返回:
+//   - WSMessage: 命令回复，Content 为仅调用者可见的回复内容（可能为空）
+//   - error: 命令不存在、无权限或执行失败时返回错误
+func ExecuteCommand(ctx context.Context, senderID string, msg WSMessage) (WSMessage, error) {
+	name, args := parseCommand(msg.Content)
+
+	// 与普通消息一样校验会话权限
+	switch msg.ChatType {
+	case ChatTypePrivate:
+		if msg.To == "" {
+			return WSMessage{}, ErrMissingTargetUser
+		}
+		canChat, err := service.NewUserService(database.GetDB()).CanChatPrivately(ctx, senderID, msg.To)
+		if err != nil {
+			return WSMessage{}, ErrCheckFriendFailed
+		}
+		if !canChat {
+			return WSMessage{}, ErrNotFriends
+		}
+	case ChatTypeGroup:
+		if msg.To == "" {
+			return WSMessage{}, ErrMissingTargetGroup
+		}
+		isMember, err := service.NewGroupMemberService(database.GetDB(), database.GetRedis()).IsMember(ctx, msg.To, senderID)
+		if err != nil {
+			return WSMessage{}, ErrCheckMemberFailed
+		}
+		if !isMember {
+			return WSMessage{}, ErrNotGroupMember
+		}
+
+		// 执行命令前与普通消息一样检查禁言、慢速模式和频道限制，命令中发起投票等操作不再重复检查
+		if err := service.NewGroupModerationService(database.GetDB(), database.GetRedis()).EnsureCanPost(ctx, msg.To, senderID); err != nil {
+			return WSMessage{}, postingRestrictionError(err)
+		}
+		ctx = service.WithPostingChecked(ctx, msg.To, senderID)
+	default:
+		return WSMessage{}, ErrInvalidChatType
+	}
+
+	username, err := service.NewUserService(database.GetDB()).GetUsernameByUserID(ctx, senderID)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to get username", "user_id", senderID, "error", err)
+	}
+
+	cmdCtx := &CommandContext{
+		UserID:   senderID,
+		Username: username,
+		ChatType: msg.ChatType,
+		TargetID: msg.To,
+		Name:     name,
+		Args:     args,
+	}
+
+	var result *CommandResult
+	if cmd, ok := getCommand(name); ok {
+		if cmd.GroupOnly && msg.ChatType != ChatTypeGroup {
+			return WSMessage{}, ErrCommandGroupOnly
+		}
+		result, err = cmd.Handler(ctx, cmdCtx)
+	} else if msg.ChatType == ChatTypeGroup {
+		result, err = runGroupCommand(ctx, cmdCtx)
+	} else {
+		err = ErrUnknownCommand
+	}
+	if err != nil {
+		logger.GetLogger().Infow("Command failed", "command", name, "user_id", senderID, "error", err)
+		return WSMessage{}, err
+	}
+
+	reply := WSMessage{
+		Type:      MessageTypeCommandResponse,
+		ChatType:  msg.ChatType,
+		From:      "system",
+		To:        msg.To,
+		MessageID: uuid.New().String(),
+		Timestamp: time.Now().UnixMilli(),
+	}
+	if result == nil || result.Text == "" {
+		return reply, nil
+	}
+
+	if result.Visibility == service.CommandVisibilityGroup && msg.ChatType == ChatTypeGroup {
+		message, err := postGroupCommandMessage(ctx, senderID, msg.To, model.MessageKindSystem, result.Text, "")
+		if err != nil {
+			return WSMessage{}, ErrCommandFailed
+		}
+		reply.MessageID = message.ID
+		return reply, nil
+	}
+
+	// 仅调用者可见的回复，同步到调用者的所有在线设备
+	reply.Content = result.Text
+	GetConnectionManager().SendToUser(senderID, reply)
+	return reply, nil
+}
+
+// runGroupCommand 执行机器人或集成在群组中注册的命令
+// 有回调地址时同步调用并处理回复，否则将调用转发给在线的机器人，由机器人自行发送消息
+func runGroupCommand(ctx context.Context, cmd *CommandContext) (*CommandResult, error) {
+	commandService := service.NewCommandService(database.GetDB())
+	command, err := commandService.GetGroupCommand(ctx, cmd.TargetID, cmd.Name)
+	if err != nil {
+		return nil, ErrUnknownCommand
+	}
+
+	invocation := dto.CommandInvocation{
+		InvocationID: uuid.New().String(),
+		Command:      cmd.Name,
+		Args:         cmd.Args,
+		GroupID:      cmd.TargetID,
+		UserID:       cmd.UserID,
+		Username:     cmd.Username,
+		Timestamp:    time.Now().UnixMilli(),
+	}
+
+	if command.CallbackURL == "" {
+		if !SendEventToUser(command.BotID, MessageTypeCommand, invocation) {
+			return nil, ErrCommandUnavailable
+		}
+		return nil, nil
+	}
+
+	resp, err := commandService.InvokeCommandCallback(ctx, command, invocation)
+	if err != nil {
+		logger.GetLogger().Errorw("Command callback failed", "command", cmd.Name, "group_id", cmd.TargetID, "error", err)
+		return nil, ErrCommandFailed
+	}
+	if resp.Text == "" {
+		return nil, nil
+	}
+
+	if resp.Visibility == service.CommandVisibilityGroup {
+		// 以机器人的身份发送到群组
+		if _, err := postGroupCommandMessage(ctx, command.BotID, cmd.TargetID, model.MessageKindText, resp.Text, command.BotID); err != nil {
+			return nil, ErrCommandFailed
+		}
+		return nil, nil
+	}
+
+	return &CommandResult{Text: resp.Text, Visibility: service.CommandVisibilityEphemeral}, nil
+}
+
+// postGroupCommandMessage 存储命令产生的群消息并推送给在线成员
+// excludeID 为不需要接收该消息的用户，系统通知会发给包括调用者在内的所有成员
+func postGroupCommandMessage(ctx context.Context, fromUserID string, groupID string, kind model.MessageKind, content string, excludeID string) (*model.Message, error) {
+	recipientIDs, onlineUserIDs, err := GetMessageRecipients(ctx, model.MessageTypeGroup, groupID, excludeID)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to get group members", "group_id", groupID, "error", err)
+		return nil, err
+	}
+
+	messageService := service.NewMessageService(database.GetDB())
+	message, err := messageService.SendGroupMessage(ctx, fromUserID, groupID, "", kind, content, "", recipientIDs, onlineUserIDs)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to store command message", "group_id", groupID, "error", err)
+		return nil, err
+	}
+
+	DeliverMessage(message, nil, recipientIDs)
+	return message, nil
+}
+
+// helpCommand 列出当前会话可用的命令
+func helpCommand(ctx context.Context, cmd *CommandContext) (*CommandResult, error) {
+	infos := BuiltinCommands(cmd.ChatType)
+	if cmd.ChatType == ChatTypeGroup {
+		groupCommands, err := service.NewCommandService(database.GetDB()).ListGroupCommands(ctx, cmd.UserID, cmd.TargetID)
+		if err != nil {
+			return nil, ErrCommandFailed
+		}
+		infos = append(infos, groupCommands...)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("可用命令：")
+	for _, info := range infos {
+		sb.WriteString("\n" + CommandPrefix + info.Name)
+		if info.Description != "" {
+			sb.WriteString(" - " + info.Description)
+		}
+		if info.BotName != "" {
+			sb.WriteString("（" + info.BotName + "）")
+		}
+	}
+
+	return &CommandResult{Text: sb.String()}, nil
+}
+
+// muteCommand 为调用者设置当前会话的免打扰
+func muteCommand(ctx context.Context, cmd *CommandContext) (*CommandResult, error) {
+	args := strings.TrimSpace(cmd.Args)
+	if args == "" {
+		return nil, usageError(cmd.Name)
+	}
+
+	mutedUntil := ""
+	text := "已关闭免打扰"
+	if args != "off" {
+		duration, err := parseCommandDuration(args)
+		if err != nil {
+			return nil, usageError(cmd.Name)
+		}
+		until := time.Now().Add(duration)
+		mutedUntil = until.Format(time.RFC3339)
+		text = "已开启免打扰，截止 " + until.Format("2006-01-02 15:04")
+	}
+
+	conversationService := service.NewConversationService(database.GetDB())
+	setting, err := conversationService.UpdateConversationSetting(ctx, cmd.UserID, string(cmd.ChatType), cmd.TargetID, dto.UpdateConversationSettingRequest{
+		MutedUntil: &mutedUntil,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 同步到该用户所有在线设备
+	SendEventToUser(cmd.UserID, MessageTypeConversationSync, setting)
+
+	return &CommandResult{Text: text}, nil
+}
+
+// kickCommand 有移除成员权限的成员将其他成员移出群组，并在群内发送通知
+func kickCommand(ctx context.Context, cmd *CommandContext) (*CommandResult, error) {
+	username := strings.TrimPrefix(strings.TrimSpace(cmd.Args), "@")
+	if username == "" {
+		return nil, usageError(cmd.Name)
+	}
+
+	targetUserID, err := service.NewUserService(database.GetDB()).GetUserIDByUsername(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("用户 %s 不存在", username)
+	}
+
+	event, err := service.NewGroupService(database.GetDB()).RemoveMember(ctx, cmd.UserID, cmd.TargetID, targetUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 移除成员的系统消息即为群内通知，不再单独回复
+	NotifyGroupMemberChange(ctx, event)
+	return nil, nil
+}
+
+// pollCommand 在群组中发起投票，投票消息本身即为回复
+func pollCommand(ctx context.Context, cmd *CommandContext) (*CommandResult, error) {
+	req := dto.CreatePollRequest{GroupID: cmd.TargetID}
+	var texts []string
+	for _, arg := range splitCommandArgs(cmd.Args) {
+		switch arg {
+		case "--multi":
+			req.MultipleChoice = true
+		case "--anonymous":
+			req.Anonymous = true
+		default:
+			texts = append(texts, arg)
+		}
+	}
+	if len(texts) < 3 {
+		return nil, usageError(cmd.Name)
+	}
+	req.Question = texts[0]
+	req.Options = texts[1:]
+
+	recipientIDs, onlineUserIDs, err := GetMessageRecipients(ctx, model.MessageTypeGroup, cmd.TargetID, cmd.UserID)
+	if err != nil {
+		return nil, ErrGetGroupMembersFailed
+	}
+
+	message, poll, err := service.NewPollService(database.GetDB(), database.GetRedis()).CreatePoll(ctx, cmd.UserID, req, recipientIDs, onlineUserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// 调用者也需要收到投票消息，实时事件中不包含个人的选择
+	broadcastPoll := *poll
+	broadcastPoll.MyOptionIDs = nil
+	DeliverMessage(message, &broadcastPoll, append(recipientIDs, cmd.UserID))
+
+	return nil, nil
+}
+
+// remindCommand 为调用者创建提醒
+func remindCommand(ctx context.Context, cmd *CommandContext) (*CommandResult, error) {
+	durationArg, text, _ := strings.Cut(strings.TrimSpace(cmd.Args), " ")
+	duration, err := parseCommandDuration(durationArg)
+	if err != nil || strings.TrimSpace(text) == "" {
+		return nil, usageError(cmd.Name)
+	}
+
+	commandService := service.NewCommandService(database.GetDB())
+	reminder, err := commandService.CreateReminder(ctx, cmd.UserID, model.MessageType(cmd.ChatType), cmd.TargetID, text, time.Now().Add(duration))
+	if err != nil {
+		return nil, err
+	}
+
+	return &CommandResult{Text: fmt.Sprintf("好的，将在 %s 提醒你：%s", reminder.RemindAt.Format("2006-01-02 15:04"), reminder.Text)}, nil
+}
+
+// topicCommand 查看或设置群话题，设置后在群内发送通知
+func topicCommand(ctx context.Context, cmd *CommandContext) (*CommandResult, error) {
+	commandService := service.NewCommandService(database.GetDB())
+	topic := strings.TrimSpace(cmd.Args)
+
+	if topic == "" {
+		current, err := commandService.GetGroupTopic(ctx, cmd.TargetID)
+		if err != nil {
+			return nil, err
+		}
+		if current == "" {
+			return &CommandResult{Text: "当前群组没有设置话题"}, nil
+		}
+		return &CommandResult{Text: "当前话题：" + current}, nil
+	}
+
+	text := fmt.Sprintf("%s 将群话题设置为：%s", cmd.Username, topic)
+	if topic == "--clear" {
+		topic = ""
+		text = cmd.Username + " 清除了群话题"
+	}
+	if err := commandService.SetGroupTopic(ctx, cmd.UserID, cmd.TargetID, topic); err != nil {
+		return nil, err
+	}
+
+	return &CommandResult{Text: text, Visibility: service.CommandVisibilityGroup}, nil
+}
+
+func usageError(name string) error {
+	if cmd, ok := getCommand(name); ok {
+		return errors.New("用法：" + cmd.Usage)
+	}
+	return ErrUnknownCommand
+}
+
+// parseCommand 拆分命令名和参数，命令名统一转为小写
+func parseCommand(content string) (string, string) {
+	content = strings.TrimPrefix(content, CommandPrefix)
+	name, args, _ := strings.Cut(content, " ")
+	return strings.ToLower(strings.TrimSpace(name)), strings.TrimSpace(args)
+}
+
+// parseCommandDuration 解析时长，在 time.ParseDuration 的基础上支持以 d 表示天
+func parseCommandDuration(s string) (time.Duration, error) {
+	var duration time.Duration
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+		duration = time.Duration(n) * 24 * time.Hour
+	} else {
+		var err error
+		duration, err = time.ParseDuration(s)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if duration <= 0 || duration > maxCommandDuration {
+		return 0, fmt.Errorf("duration out of range: %s", s)
+	}
+	return duration, nil
+}
+
+// splitCommandArgs 按空白拆分参数，英文或中文双引号包裹的内容作为一个参数
+func splitCommandArgs(args string) []string {
+	var result []string
+	var current strings.Builder
+	inQuotes := false
+	hasToken := false
+
+	for _, r := range args {
+		switch {
+		case r == '"' || r == '“' || r == '”':
+			inQuotes = !inQuotes
+			hasToken = true
+		case unicode.IsSpace(r) && !inQuotes:
+			if hasToken {
+				result = append(result, current.String())
+				current.Reset()
+				hasToken = false
+			}
+		default:
+			current.WriteRune(r)
+			hasToken = true
+		}
+	}
+	if hasToken {
+		result = append(result, current.String())
+	}
+
+	return result
+}
@@ -0,0 +1,254 @@
+package websocket
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/model"
+	"chat_backend/internal/service"
+	"chat_backend/pkg/logger"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// 发送聊天消息的错误，错误信息会作为系统消息返回给 WebSocket 客户端
+var (
+	ErrInvalidMessageType    = errors.New("未知的消息类型")
+	ErrInvalidMessageContent = errors.New("消息内容无效")
+	ErrInvalidChatType       = errors.New("未知的聊天类型")
+	ErrMissingTargetUser     = errors.New("缺少目标用户")
+	ErrMissingTargetGroup    = errors.New("缺少目标群组")
+	ErrCheckFriendFailed     = errors.New("验证好友关系失败")
+	ErrNotFriends            = errors.New("只能向好友发送消息")
+	ErrCheckMemberFailed     = errors.New("验证群组成员失败")
+	ErrNotGroupMember        = errors.New("只有群组成员才能发送消息")
+	ErrMentionAllNotAllowed  = errors.New("没有使用 @all 的权限")
+	ErrMemberMuted           = errors.New("你已被禁言")
+	ErrGroupMuted            = errors.New("群组已开启全员禁言，仅群主和管理员可以发言")
+	ErrSlowMode              = errors.New("群组已开启慢速模式")
+	ErrChannelReadOnly       = errors.New("频道中仅群主和管理员可以发言")
+	ErrTopicNotFound         = errors.New("话题不存在")
+	ErrGetGroupMembersFailed = errors.New("获取群组成员失败")
+	ErrSendMessageFailed     = errors.New("消息发送失败")
+)
+
+// SendChatMessage 校验、存储并投递一条聊天消息
+// WebSocket 连接和 HTTP 发送接口共用此函数，发送者始终以认证得到的用户ID为准
+// 参数:
+//   - ctx: 上下文
+//   - senderID: 发送者用户ID（普通用户或机器人）
+//   - msg: 客户端提交的消息，使用其中的 Type、ChatType、To、Content 和 TopicID
+//
+// 返回:
+//   - WSMessage: 投递给接收者的消息，包含后端生成的消息ID
+//   - error: 校验或存储失败时返回上面定义的错误
+func SendChatMessage(ctx context.Context, senderID string, msg WSMessage) (WSMessage, error) {
+	switch msg.Type {
+	case MessageTypeText, MessageTypeImage, MessageTypeFile:
+	default:
+		return WSMessage{}, ErrInvalidMessageType
+	}
+
+	// 验证消息内容
+	if !validateMessageContent(msg.Type, msg.Content) {
+		logger.GetLogger().Warnw("Invalid message content", "type", msg.Type, "from", senderID, "content_length", utf8.RuneCountInString(msg.Content))
+		return WSMessage{}, ErrInvalidMessageContent
+	}
+
+	// 以 / 开头的文本消息作为斜杠命令执行，以 // 开头的去掉一个 / 后作为普通消息发送
+	if msg.Type == MessageTypeText && strings.HasPrefix(msg.Content, CommandPrefix) {
+		if !strings.HasPrefix(msg.Content, CommandPrefix+CommandPrefix) {
+			return ExecuteCommand(ctx, senderID, msg)
+		}
+		msg.Content = strings.TrimPrefix(msg.Content, CommandPrefix)
+	}
+
+	// 获取发送者用户信息
+	userService := service.NewUserService(database.GetDB())
+	fromUsername, err := userService.GetUsernameByUserID(ctx, senderID)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to get username", "user_id", senderID, "error", err)
+		fromUsername = ""
+	}
+	fromAvatar, err := userService.GetUserAvatarUrl(senderID, fromUsername)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to get avatar", "user_id", senderID, "error", err)
+		fromAvatar = ""
+	}
+	fromBot, err := userService.IsBot(ctx, senderID)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to get user type", "user_id", senderID, "error", err)
+	}
+
+	// 统一使用后端生成的消息ID
+	messageID := uuid.New().String()
+	// 创建新的消息对象用于广播，避免修改原始消息对象
+	broadcastMsg := WSMessage{
+		Type:         msg.Type,
+		ChatType:     msg.ChatType,
+		From:         senderID,
+		FromUsername: fromUsername,
+		FromAvatar:   fromAvatar,
+		FromBot:      fromBot,
+		To:           msg.To,
+		Content:      msg.Content,
+		MessageID:    messageID,
+		Timestamp:    time.Now().UnixMilli(),
+	}
+
+	messageService := service.NewMessageService(database.GetDB())
+	cm := GetConnectionManager()
+
+	// 根据聊天类型分发消息
+	switch msg.ChatType {
+	case ChatTypePrivate:
+		// 检查是否有接收者
+		if msg.To == "" {
+			logger.GetLogger().Warnw("Private message missing target", "from", senderID)
+			return WSMessage{}, ErrMissingTargetUser
+		}
+
+		// 验证好友关系（机器人与其创建者之间也可以私聊）
+		canChat, err := userService.CanChatPrivately(ctx, senderID, msg.To)
+		if err != nil {
+			logger.GetLogger().Errorw("Failed to check friend relationship", "from", senderID, "to", msg.To, "error", err)
+			return WSMessage{}, ErrCheckFriendFailed
+		}
+		if !canChat {
+			logger.GetLogger().Warnw("Not friends", "from", senderID, "to", msg.To)
+			return WSMessage{}, ErrNotFriends
+		}
+
+		// 检查接收者是否在线
+		isTargetOnline := cm.IsOnline(msg.To)
+
+		// 存储消息到数据库并处理离线消息
+		_, err = messageService.SendPrivateMessage(ctx, senderID, msg.To, model.MessageKind(msg.Type), msg.Content, messageID, isTargetOnline)
+		if err != nil {
+			logger.GetLogger().Errorw("Failed to store message", "message_id", messageID, "error", err)
+			return WSMessage{}, ErrSendMessageFailed
+		}
+
+		// 在线则直接发送
+		if isTargetOnline {
+			cm.SendToUser(broadcastMsg.To, broadcastMsg)
+			logger.GetLogger().Infow("Message sent to online user", "to", broadcastMsg.To, "message_id", messageID)
+		} else {
+			logger.GetLogger().Infow("User offline, message stored", "to", broadcastMsg.To, "message_id", messageID)
+		}
+
+	case ChatTypeGroup:
+		// 检查是否有目标群组
+		if msg.To == "" {
+			logger.GetLogger().Warnw("Group message missing target", "from", senderID)
+			return WSMessage{}, ErrMissingTargetGroup
+		}
+
+		// 验证发送者是否是群组成员
+		memberService := service.NewGroupMemberService(database.GetDB(), database.GetRedis())
+		isMember, err := memberService.IsMember(ctx, msg.To, senderID)
+		if err != nil {
+			logger.GetLogger().Errorw("Failed to check group membership", "from", senderID, "group_id", msg.To, "error", err)
+			return WSMessage{}, ErrCheckMemberFailed
+		}
+		if !isMember {
+			logger.GetLogger().Warnw("Not group member", "from", senderID, "group_id", msg.To)
+			return WSMessage{}, ErrNotGroupMember
+		}
+
+		// 设置了群昵称的成员在群消息中显示群昵称
+		groupService := service.NewGroupService(database.GetDB())
+		if nickname, err := groupService.GetMemberNickname(ctx, msg.To, senderID); err != nil {
+			logger.GetLogger().Errorw("Failed to get group nickname", "user_id", senderID, "group_id", msg.To, "error", err)
+		} else if nickname != "" {
+			broadcastMsg.FromUsername = nickname
+		}
+
+		// 论坛模式的群组中，消息可以发送到指定话题
+		if msg.TopicID != "" {
+			if err := groupService.CheckGroupTopic(ctx, msg.To, msg.TopicID); err != nil {
+				logger.GetLogger().Warnw("Invalid group topic", "from", senderID, "group_id", msg.To, "topic_id", msg.TopicID, "error", err)
+				return WSMessage{}, ErrTopicNotFound
+			}
+			broadcastMsg.TopicID = msg.TopicID
+		}
+
+		if msg.Type == MessageTypeText && service.MentionsAll(msg.Content) {
+			allowed, err := groupService.HasPermission(ctx, msg.To, senderID, service.PermissionMentionAll)
+			if err != nil {
+				logger.GetLogger().Errorw("Failed to check group permission", "from", senderID, "group_id", msg.To, "error", err)
+				return WSMessage{}, ErrCheckMemberFailed
+			}
+			if !allowed {
+				return WSMessage{}, ErrMentionAllNotAllowed
+			}
+		}
+
+		// 禁言和慢速模式检查放在最后，慢速模式检查通过即开始计算下一次发言的间隔
+		moderationService := service.NewGroupModerationService(database.GetDB(), database.GetRedis())
+		if err := moderationService.EnsureCanPost(ctx, msg.To, senderID); err != nil {
+			return WSMessage{}, postingRestrictionError(err)
+		}
+
+		// 构建接收者列表（排除发送者）和在线用户映射
+		recipientIDs, onlineUserIDs, err := GetMessageRecipients(ctx, model.MessageTypeGroup, msg.To, senderID)
+		if err != nil {
+			logger.GetLogger().Errorw("Failed to get group members", "group_id", msg.To, "error", err)
+			return WSMessage{}, ErrGetGroupMembersFailed
+		}
+
+		// 存储群组消息到数据库并处理离线消息
+		_, err = messageService.SendGroupMessage(ctx, senderID, msg.To, msg.TopicID, model.MessageKind(msg.Type), msg.Content, messageID, recipientIDs, onlineUserIDs)
+		if err != nil {
+			logger.GetLogger().Errorw("Failed to store group message", "message_id", messageID, "error", err)
+			return WSMessage{}, ErrSendMessageFailed
+		}
+
+		// 广播消息给群组内所有在线用户（排除发送者自己）
+		cm.BroadcastToGroup(broadcastMsg, recipientIDs)
+		logger.GetLogger().Infow("Group message broadcasted", "group_id", broadcastMsg.To, "message_id", messageID, "recipient_count", len(recipientIDs))
+
+		if msg.Type == MessageTypeText && !fromBot {
+			triggerAutoReply(senderID, msg.To, msg.Content)
+			triggerAssistant(senderID, msg.To, messageID, msg.Content)
+		}
+
+	default:
+		logger.GetLogger().Warnw("Unknown chat type", "chat_type", msg.ChatType, "from", senderID)
+		return WSMessage{}, ErrInvalidChatType
+	}
+
+	return broadcastMsg, nil
+}
+
+// postingRestrictionError 将发言检查的错误转换为发送给客户端的错误，错误信息中包含限制解除的时间
+func postingRestrictionError(err error) error {
+	var restricted *service.PostingRestrictedError
+	if !errors.As(err, &restricted) {
+		logger.GetLogger().Errorw("Failed to check posting restriction", "error", err)
+		return ErrCheckMemberFailed
+	}
+
+	restriction := restricted.Restriction
+	switch restriction.Reason {
+	case service.PostingRestrictionMuted:
+		return fmt.Errorf("%w，%s 解除", ErrMemberMuted, restriction.Until.Format(time.DateTime))
+	case service.PostingRestrictionSlowMode:
+		seconds := int(math.Ceil(time.Until(restriction.Until).Seconds()))
+		return fmt.Errorf("%w，请 %d 秒后再发言", ErrSlowMode, max(seconds, 1))
+	case service.PostingRestrictionChannel:
+		return ErrChannelReadOnly
+	default:
+		return ErrGroupMuted
+	}
+}
+
+// IsPostingRestricted 判断发送失败是否因为禁言、慢速模式或频道限制
+func IsPostingRestricted(err error) bool {
+	return errors.Is(err, ErrMemberMuted) || errors.Is(err, ErrGroupMuted) || errors.Is(err, ErrSlowMode) || errors.Is(err, ErrChannelReadOnly)
+}
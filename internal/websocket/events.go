@@ -0,0 +1,169 @@
+package websocket
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/model"
+	"chat_backend/internal/service"
+	"chat_backend/pkg/logger"
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// NewEventMessage 构建携带结构化数据的事件消息
+// 参数:
+//   - msgType: 事件消息类型
+//   - to: 接收者用户ID或群组ID
+//   - payload: 事件数据，会被序列化为JSON
+//
+// 返回:
+//   - WSMessage: 构建好的事件消息
+//   - error: 序列化失败时返回错误
+func NewEventMessage(msgType MessageType, to string, payload interface{}) (WSMessage, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return WSMessage{}, err
+	}
+
+	return WSMessage{
+		Type:      msgType,
+		From:      "system",
+		To:        to,
+		Payload:   data,
+		Timestamp: time.Now().UnixMilli(),
+	}, nil
+}
+
+// SendEventToUser 向指定用户的所有在线设备发送事件消息
+// 参数:
+//   - userID: 目标用户ID
+//   - msgType: 事件消息类型
+//   - payload: 事件数据
+//
+// 返回:
+//   - bool: 是否至少向一个设备成功发送
+func SendEventToUser(userID string, msgType MessageType, payload interface{}) bool {
+	msg, err := NewEventMessage(msgType, userID, payload)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to build event message", "type", msgType, "user_id", userID, "error", err)
+		return false
+	}
+
+	return GetConnectionManager().SendToUser(userID, msg)
+}
+
+// GetMessageRecipients 获取消息的接收者列表（排除发送者）和接收者在线状态
+// 参数:
+//   - ctx: 上下文
+//   - chatType: 会话类型，私聊或群聊
+//   - targetID: 接收者用户ID（私聊）或群组ID（群聊）
+//   - senderID: 发送者用户ID
+//
+// 返回:
+//   - []string: 接收者用户ID列表
+//   - map[string]bool: 接收者在线状态
+//   - error: 获取群组成员失败时返回错误
+func GetMessageRecipients(ctx context.Context, chatType model.MessageType, targetID string, senderID string) ([]string, map[string]bool, error) {
+	memberIDs := []string{targetID}
+	if chatType == model.MessageTypeGroup {
+		var err error
+		memberIDs, err = service.NewGroupMemberService(database.GetDB(), database.GetRedis()).GetMemberIDs(ctx, targetID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	cm := GetConnectionManager()
+	recipientIDs := make([]string, 0, len(memberIDs))
+	onlineUserIDs := make(map[string]bool, len(memberIDs))
+	for _, memberID := range memberIDs {
+		if memberID != senderID {
+			recipientIDs = append(recipientIDs, memberID)
+		}
+		onlineUserIDs[memberID] = cm.IsOnline(memberID)
+	}
+
+	return recipientIDs, onlineUserIDs, nil
+}
+
+// DeliverMessage 将已存储的消息推送给在线的接收者
+// 用于通过 HTTP 接口发送的消息（如投票、卡片），消息需先通过 service 层存储
+// 参数:
+//   - message: 已存储的消息
+//   - payload: 结构化的附加数据（可选）
+//   - recipientIDs: 接收者用户ID列表
+func DeliverMessage(message *model.Message, payload interface{}, recipientIDs []string) {
+	userService := service.NewUserService(database.GetDB())
+	fromUsername, err := userService.GetUsernameByUserID(context.Background(), message.FromUserID)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to get username", "user_id", message.FromUserID, "error", err)
+		fromUsername = ""
+	}
+	fromAvatar, err := userService.GetUserAvatarUrl(message.FromUserID, fromUsername)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to get avatar", "user_id", message.FromUserID, "error", err)
+		fromAvatar = ""
+	}
+	fromBot, err := userService.IsBot(context.Background(), message.FromUserID)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to get user type", "user_id", message.FromUserID, "error", err)
+	}
+	if message.Type == model.MessageTypeGroup {
+		nickname, err := service.NewGroupService(database.GetDB()).GetMemberNickname(context.Background(), message.TargetID, message.FromUserID)
+		if err != nil {
+			logger.GetLogger().Errorw("Failed to get group nickname", "user_id", message.FromUserID, "group_id", message.TargetID, "error", err)
+		} else if nickname != "" {
+			fromUsername = nickname
+		}
+	}
+
+	msg := WSMessage{
+		Type:         MessageType(message.Kind),
+		ChatType:     ChatType(message.Type),
+		From:         message.FromUserID,
+		FromUsername: fromUsername,
+		FromAvatar:   fromAvatar,
+		FromBot:      fromBot,
+		To:           message.TargetID,
+		Content:      message.Content,
+		MessageID:    message.ID,
+		Timestamp:    message.CreatedAt.UnixMilli(),
+	}
+	if message.TopicID != nil {
+		msg.TopicID = *message.TopicID
+	}
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			logger.GetLogger().Errorw("Failed to marshal message payload", "message_id", message.ID, "error", err)
+			return
+		}
+		msg.Payload = data
+	}
+
+	GetConnectionManager().BroadcastToGroup(msg, recipientIDs)
+	logger.GetLogger().Infow("Message delivered", "chat_type", message.Type, "target_id", message.TargetID, "message_id", message.ID, "recipient_count", len(recipientIDs))
+}
+
+// BroadcastEventToGroup 向群组所有在线成员发送事件消息
+// 参数:
+//   - ctx: 上下文
+//   - groupID: 群组ID
+//   - msgType: 事件消息类型
+//   - payload: 事件数据
+func BroadcastEventToGroup(ctx context.Context, groupID string, msgType MessageType, payload interface{}) {
+	memberIDs, err := service.NewGroupMemberService(database.GetDB(), database.GetRedis()).GetMemberIDs(ctx, groupID)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to get group members", "group_id", groupID, "error", err)
+		return
+	}
+
+	msg, err := NewEventMessage(msgType, groupID, payload)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to build event message", "type", msgType, "group_id", groupID, "error", err)
+		return
+	}
+	msg.ChatType = ChatTypeGroup
+
+	GetConnectionManager().BroadcastToGroup(msg, memberIDs)
+}
@@ -12,7 +12,6 @@ import (
 	"unicode/utf8"
 
 	"github.com/coder/websocket"
-	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
@@ -61,16 +60,17 @@ func HandleWebSocket(c echo.Context) error {
 
 	// 获取连接管理器并添加连接
 	cm := GetConnectionManager()
-	cm.AddConnection(userIDStr, userConn)
-
-	// 通知在线的好友该用户已上线
-	NotifyFriendsUserStatusChange(userIDStr, true)
+	if cm.AddConnection(userIDStr, userConn) {
+		// 用户的第一个设备连接，通知在线的好友该用户已上线
+		NotifyFriendsUserStatusChange(userIDStr, true)
+	}
 
 	// 延迟执行：连接关闭时从管理器移除并通知好友
 	defer func() {
-		cm.RemoveConnection(userIDStr)
-		// 通知在线的好友该用户已下线
-		NotifyFriendsUserStatusChange(userIDStr, false)
+		if cm.RemoveConnection(userConn) {
+			// 用户的最后一个设备断开，通知在线的好友该用户已下线
+			NotifyFriendsUserStatusChange(userIDStr, false)
+		}
 	}()
 
 	// 发送连接成功消息
@@ -114,18 +114,33 @@ func HandleWebSocket(c echo.Context) error {
 				logger.GetLogger().Errorw("Failed to get avatar", "user_id", msg.FromUserID, "error", err)
 				fromAvatar = ""
 			}
+			if msg.FromUser != nil && msg.FromUser.Nickname != "" {
+				fromUsername = msg.FromUser.Nickname
+			}
 
 			wsMsg := WSMessage{
-				Type:         MessageTypeText,
+				Type:         MessageType(msg.Kind),
 				ChatType:     chatType,
 				From:         msg.FromUserID,
 				FromUsername: fromUsername,
 				FromAvatar:   fromAvatar,
+				FromBot:      msg.FromUser != nil && msg.FromUser.IsBot,
 				To:           msg.TargetID,
 				Content:      msg.Content,
 				MessageID:    msg.MessageID,
 				Timestamp:    msg.CreatedAt.UnixMilli(),
 			}
+			var payload interface{}
+			if msg.Poll != nil {
+				payload = msg.Poll
+			} else if msg.Card != nil {
+				payload = msg.Card
+			}
+			if payload != nil {
+				if data, err := json.Marshal(payload); err == nil {
+					wsMsg.Payload = data
+				}
+			}
 
 			userConn.Send(wsMsg)
 			messageIDs = append(messageIDs, msg.MessageID)
@@ -137,6 +152,9 @@ func HandleWebSocket(c echo.Context) error {
 		}
 	}
 
+	// 推送离线期间到期的提醒
+	deliverPendingReminders(ctx, userConn)
+
 	// 启动写入泵（goroutine）
 	go userConn.WritePump(ctx)
 
@@ -174,176 +192,25 @@ func validateMessageContent(msgType MessageType, content string) bool {
 //   - conn: 用户连接对象
 //   - msg: 接收到的消息
 func handleMessage(conn *UserConnection, msg WSMessage) {
-	logger.GetLogger().Infow("Received message", "type", msg.Type, "chat_type", msg.ChatType, "from", msg.From, "to", msg.To)
-
-	// 根据消息类型进行处理
-	switch msg.Type {
-	case MessageTypeText, MessageTypeImage, MessageTypeFile:
-		// 验证消息内容
-		if !validateMessageContent(msg.Type, msg.Content) {
-			logger.GetLogger().Warnw("Invalid message content", "type", msg.Type, "from", msg.From, "content_length", utf8.RuneCountInString(msg.Content))
-			SendSystemMessage(conn.UserID, "消息内容无效")
-			return
-		}
-
-		// 获取发送者用户信息
-		userService := service.NewUserService(database.GetDB())
-		fromUsername, err := userService.GetUsernameByUserID(context.Background(), msg.From)
-		if err != nil {
-			logger.GetLogger().Errorw("Failed to get username", "user_id", msg.From, "error", err)
-			fromUsername = ""
-		}
-		fromAvatar, err := userService.GetUserAvatarUrl(msg.From, fromUsername)
-		if err != nil {
-			logger.GetLogger().Errorw("Failed to get avatar", "user_id", msg.From, "error", err)
-			fromAvatar = ""
-		}
+	logger.GetLogger().Infow("Received message", "type", msg.Type, "chat_type", msg.ChatType, "from", conn.UserID, "to", msg.To)
 
-		// 统一使用后端生成的消息ID
-		messageID := uuid.New().String()
-		// 创建新的消息对象用于广播，避免修改原始消息对象
-		broadcastMsg := WSMessage{
-			Type:         msg.Type,
-			ChatType:     msg.ChatType,
-			From:         msg.From,
-			FromUsername: fromUsername,
-			FromAvatar:   fromAvatar,
-			To:           msg.To,
-			Content:      msg.Content,
-			MessageID:    messageID,
-			Timestamp:    time.Now().UnixMilli(),
-		}
-
-		messageService := service.NewMessageService(database.GetDB())
-		cm := GetConnectionManager()
-
-		// 根据聊天类型分发消息
-		switch msg.ChatType {
-		case ChatTypePrivate:
-			// 检查是否有接收者
-			if msg.To == "" {
-				logger.GetLogger().Warnw("Private message missing target", "from", msg.From)
-				SendSystemMessage(conn.UserID, "缺少目标用户")
-				return
-			}
-
-			// 验证好友关系
-			userService := service.NewUserService(database.GetDB())
-			isFriend, err := userService.IsFriend(context.Background(), msg.From, msg.To)
-			if err != nil {
-				logger.GetLogger().Errorw("Failed to check friend relationship", "from", msg.From, "to", msg.To, "error", err)
-				SendSystemMessage(conn.UserID, "验证好友关系失败")
-				return
-			}
-			if !isFriend {
-				logger.GetLogger().Warnw("Not friends", "from", msg.From, "to", msg.To)
-				SendSystemMessage(conn.UserID, "只能向好友发送消息")
-				return
-			}
-
-			// 检查接收者是否在线
-			isTargetOnline := cm.IsOnline(msg.To)
-
-			// 存储消息到数据库并处理离线消息
-			_, err = messageService.SendPrivateMessage(context.Background(), msg.From, msg.To, msg.Content, messageID, isTargetOnline)
-			if err != nil {
-				logger.GetLogger().Errorw("Failed to store message", "message_id", messageID, "error", err)
-				SendSystemMessage(conn.UserID, "消息发送失败")
-				return
-			}
-
-			// 在线则直接发送
-			if isTargetOnline {
-				cm.SendToUser(broadcastMsg.To, broadcastMsg)
-				logger.GetLogger().Infow("Message sent to online user", "to", broadcastMsg.To, "message_id", messageID)
-			} else {
-				logger.GetLogger().Infow("User offline, message stored", "to", broadcastMsg.To, "message_id", messageID)
-			}
-
-			// 发送确认消息给发送者
-			ackMsg := WSMessage{
-				Type:      MessageTypeAck,
-				MessageID: messageID,
-				From:      "system",
-				To:        conn.UserID,
-				Content:   "message_sent",
-				Timestamp: time.Now().UnixMilli(),
-			}
-			conn.Send(ackMsg)
-
-		case ChatTypeGroup:
-			// 检查是否有目标群组
-			if msg.To == "" {
-				logger.GetLogger().Warnw("Group message missing target", "from", msg.From)
-				SendSystemMessage(conn.UserID, "缺少目标群组")
-				return
-			}
-
-			// 验证发送者是否是群组成员
-			groupService := service.NewGroupService(database.GetDB())
-			isMember, err := groupService.IsGroupMember(context.Background(), msg.To, msg.From)
-			if err != nil {
-				logger.GetLogger().Errorw("Failed to check group membership", "from", msg.From, "group_id", msg.To, "error", err)
-				SendSystemMessage(conn.UserID, "验证群组成员失败")
-				return
-			}
-			if !isMember {
-				logger.GetLogger().Warnw("Not group member", "from", msg.From, "group_id", msg.To)
-				SendSystemMessage(conn.UserID, "只有群组成员才能发送消息")
-				return
-			}
-
-			// 获取群组成员列表
-			memberIDs, err := groupService.GetGroupMemberIDs(context.Background(), msg.To)
-			if err != nil {
-				logger.GetLogger().Errorw("Failed to get group members", "group_id", msg.To, "error", err)
-				SendSystemMessage(conn.UserID, "获取群组成员失败")
-				return
-			}
-
-			// 构建接收者列表（排除发送者）和在线用户映射
-			recipientIDs := make([]string, 0, len(memberIDs))
-			onlineUserIDs := make(map[string]bool)
-			for _, memberID := range memberIDs {
-				if memberID != conn.UserID {
-					recipientIDs = append(recipientIDs, memberID)
-				}
-				onlineUserIDs[memberID] = cm.IsOnline(memberID)
-			}
-
-			// 存储群组消息到数据库并处理离线消息
-			_, err = messageService.SendGroupMessage(context.Background(), msg.From, msg.To, msg.Content, messageID, recipientIDs, onlineUserIDs)
-			if err != nil {
-				logger.GetLogger().Errorw("Failed to store group message", "message_id", messageID, "error", err)
-				SendSystemMessage(conn.UserID, "消息发送失败")
-				return
-			}
-
-			// 广播消息给群组内所有在线用户（排除发送者自己）
-			cm.BroadcastToGroup(broadcastMsg, recipientIDs)
-			logger.GetLogger().Infow("Group message broadcasted", "group_id", broadcastMsg.To, "message_id", messageID, "recipient_count", len(recipientIDs))
-
-			// 发送确认消息给发送者
-			ackMsg := WSMessage{
-				Type:      MessageTypeAck,
-				MessageID: messageID,
-				From:      "system",
-				To:        conn.UserID,
-				Content:   "message_sent",
-				Timestamp: time.Now().UnixMilli(),
-			}
-			conn.Send(ackMsg)
-
-		default:
-			logger.GetLogger().Warnw("Unknown chat type", "chat_type", msg.ChatType, "from", msg.From)
-			SendSystemMessage(conn.UserID, "未知的聊天类型")
-		}
+	// 发送者以连接认证的用户为准，忽略客户端提交的 From
+	sentMsg, err := SendChatMessage(context.Background(), conn.UserID, msg)
+	if err != nil {
+		SendSystemMessage(conn.UserID, err.Error())
+		return
+	}
 
-	// 未知消息类型
-	default:
-		logger.GetLogger().Warnw("Unknown message type", "type", msg.Type, "from", msg.From)
-		SendSystemMessage(conn.UserID, "未知的消息类型")
+	// 发送确认消息给发送者
+	ackMsg := WSMessage{
+		Type:      MessageTypeAck,
+		MessageID: sentMsg.MessageID,
+		From:      "system",
+		To:        conn.UserID,
+		Content:   "message_sent",
+		Timestamp: time.Now().UnixMilli(),
 	}
+	conn.Send(ackMsg)
 }
 
 // GetOnlineUsers 获取所有在线用户信息
@@ -0,0 +1,82 @@
+package websocket
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/service"
+	"chat_backend/pkg/logger"
+	"context"
+	"time"
+)
+
+const joinRequestExpiryInterval = time.Minute
+
+// NotifyJoinRequestSubmitted 向群组中有审批权限的在线成员推送新提交的入群申请
+// 参数:
+//   - ctx: 上下文
+//   - groupID: 群组ID
+//   - userID: 申请者
+func NotifyJoinRequestSubmitted(ctx context.Context, groupID string, userID string) {
+	event, err := service.NewGroupService(database.GetDB()).GetPendingJoinRequestEvent(ctx, groupID, userID)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to build join request event", "group_id", groupID, "user_id", userID, "error", err)
+		return
+	}
+
+	sendJoinRequestEventToApprovers(ctx, event)
+}
+
+// NotifyJoinRequestUpdated 向申请者和群组中有审批权限的在线成员推送入群申请的处理结果
+// 参数:
+//   - ctx: 上下文
+//   - event: 审批、过期或撤回后的入群申请事件
+func NotifyJoinRequestUpdated(ctx context.Context, event *dto.JoinRequestEvent) {
+	SendEventToUser(event.UserID, MessageTypeGroupJoinRequest, event)
+	sendJoinRequestEventToApprovers(ctx, event)
+}
+
+func sendJoinRequestEventToApprovers(ctx context.Context, event *dto.JoinRequestEvent) {
+	approverIDs, err := service.NewGroupService(database.GetDB()).GetJoinApproverIDs(ctx, event.GroupID)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to get join request approvers", "group_id", event.GroupID, "error", err)
+		return
+	}
+
+	msg, err := NewEventMessage(MessageTypeGroupJoinRequest, event.GroupID, event)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to build event message", "type", MessageTypeGroupJoinRequest, "group_id", event.GroupID, "error", err)
+		return
+	}
+
+	// 申请者已单独推送，审批通过后申请者也是群成员，不再重复推送
+	GetConnectionManager().BroadcastToGroup(msg, approverIDs, event.UserID)
+}
+
+// StartJoinRequestExpiryWorker 启动入群申请过期任务，定期将超过有效期的申请标记为已过期并通知相关用户，直到 ctx 取消
+func StartJoinRequestExpiryWorker(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(joinRequestExpiryInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				expireJoinRequests(ctx)
+			}
+		}
+	}()
+}
+
+func expireJoinRequests(ctx context.Context) {
+	events, err := service.NewGroupService(database.GetDB()).ExpireJoinRequests(ctx)
+	// 出错前已过期的申请仍然需要通知
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to expire join requests", "error", err)
+	}
+
+	for _, event := range events {
+		NotifyJoinRequestUpdated(ctx, event)
+	}
+}
@@ -8,9 +8,10 @@ import (
 
 // ConnectionManager WebSocket连接管理器
 // 负责管理所有用户的WebSocket连接，提供连接的增删查改功能
+// 同一用户可以在多个设备上同时在线，每个设备对应一个连接
 type ConnectionManager struct {
-	// connections 存储所有用户连接的映射表，key为用户ID，value为连接对象
-	connections map[string]*UserConnection
+	// connections 存储所有用户连接的映射表，key为用户ID，value为该用户所有设备的连接集合
+	connections map[string]map[*UserConnection]struct{}
 	// mu 读写锁，用于保护connections的并发访问
 	mu sync.RWMutex
 }
@@ -30,95 +31,133 @@ var (
 func GetConnectionManager() *ConnectionManager {
 	once.Do(func() {
 		instance = &ConnectionManager{
-			connections: make(map[string]*UserConnection),
+			connections: make(map[string]map[*UserConnection]struct{}),
 		}
 	})
 	return instance
 }
 
 // AddConnection 添加用户连接到管理器
-// 如果用户已有连接，会先关闭旧连接
+// 同一用户的多个设备连接会同时保留
 // 参数:
 //   - userID: 用户唯一标识符
 //   - conn: 用户连接对象
-func (cm *ConnectionManager) AddConnection(userID string, conn *UserConnection) {
+//
+// 返回:
+//   - bool: 是否为该用户的第一个连接（即用户刚刚上线）
+func (cm *ConnectionManager) AddConnection(userID string, conn *UserConnection) bool {
 	cm.mu.Lock()
 	defer cm.mu.Unlock()
 
-	// 检查是否已存在该用户的连接
-	if existingConn, exists := cm.connections[userID]; exists {
-		logger.GetLogger().Infow("Closing existing connection", "user_id", userID)
-		existingConn.Close()
+	userConns, exists := cm.connections[userID]
+	if !exists {
+		userConns = make(map[*UserConnection]struct{})
+		cm.connections[userID] = userConns
 	}
 
 	// 存储新连接
-	cm.connections[userID] = conn
-	logger.GetLogger().Infow("Connection added", "user_id", userID, "total_connections", len(cm.connections))
+	userConns[conn] = struct{}{}
+	logger.GetLogger().Infow("Connection added", "user_id", userID, "device_connections", len(userConns), "online_users", len(cm.connections))
+
+	return len(userConns) == 1
 }
 
-// RemoveConnection 从管理器移除用户连接
+// RemoveConnection 从管理器移除用户的某个设备连接
 // 参数:
-//   - userID: 用户唯一标识符
-func (cm *ConnectionManager) RemoveConnection(userID string) {
+//   - conn: 用户连接对象
+//
+// 返回:
+//   - bool: 是否为该用户的最后一个连接（即用户已经完全下线）
+func (cm *ConnectionManager) RemoveConnection(conn *UserConnection) bool {
 	cm.mu.Lock()
 	defer cm.mu.Unlock()
 
-	if conn, exists := cm.connections[userID]; exists {
-		// 关闭连接
-		conn.Close()
-		// 从映射表中删除
-		delete(cm.connections, userID)
-		logger.GetLogger().Infow("Connection removed", "user_id", userID, "total_connections", len(cm.connections))
+	// 关闭连接
+	conn.Close()
+
+	userConns, exists := cm.connections[conn.UserID]
+	if !exists {
+		return false
 	}
+	if _, ok := userConns[conn]; !ok {
+		return false
+	}
+
+	// 从映射表中删除
+	delete(userConns, conn)
+	if len(userConns) == 0 {
+		delete(cm.connections, conn.UserID)
+	}
+	logger.GetLogger().Infow("Connection removed", "user_id", conn.UserID, "device_connections", len(userConns), "online_users", len(cm.connections))
+
+	return len(userConns) == 0
 }
 
-// GetConnection 获取指定用户的连接
+// GetConnections 获取指定用户所有设备的连接
 // 参数:
 //   - userID: 用户唯一标识符
+//
 // 返回:
-//   - *UserConnection: 用户连接对象
-//   - bool: 是否存在该连接
-func (cm *ConnectionManager) GetConnection(userID string) (*UserConnection, bool) {
+//   - []*UserConnection: 用户连接对象列表
+func (cm *ConnectionManager) GetConnections(userID string) []*UserConnection {
 	cm.mu.RLock()
 	defer cm.mu.RUnlock()
 
-	conn, exists := cm.connections[userID]
-	return conn, exists
+	conns := make([]*UserConnection, 0, len(cm.connections[userID]))
+	for conn := range cm.connections[userID] {
+		conns = append(conns, conn)
+	}
+	return conns
 }
 
 // IsOnline 检查用户是否在线
+// 只要用户有任意一个设备的连接未关闭即视为在线
 // 参数:
 //   - userID: 用户唯一标识符
+//
 // 返回:
 //   - bool: 用户是否在线
 func (cm *ConnectionManager) IsOnline(userID string) bool {
 	cm.mu.RLock()
 	defer cm.mu.RUnlock()
 
-	conn, exists := cm.connections[userID]
-	if !exists {
-		return false
+	return cm.isOnlineLocked(userID)
+}
+
+// isOnlineLocked 检查用户是否在线，调用方需持有读锁
+func (cm *ConnectionManager) isOnlineLocked(userID string) bool {
+	for conn := range cm.connections[userID] {
+		// 还需要检查连接是否已关闭
+		if !conn.IsClosed() {
+			return true
+		}
 	}
-	// 还需要检查连接是否已关闭
-	return !conn.IsClosed()
+	return false
 }
 
-// SendToUser 向指定用户发送消息
+// SendToUser 向指定用户的所有设备发送消息
 // 参数:
 //   - userID: 目标用户ID
 //   - msg: 要发送的消息
+//
 // 返回:
-//   - bool: 是否成功发送
+//   - bool: 是否至少向一个设备成功发送
 func (cm *ConnectionManager) SendToUser(userID string, msg WSMessage) bool {
 	cm.mu.RLock()
 	defer cm.mu.RUnlock()
 
-	conn, exists := cm.connections[userID]
-	if !exists {
-		return false
-	}
+	return cm.sendToUserLocked(userID, msg)
+}
 
-	return conn.Send(msg)
+// sendToUserLocked 向指定用户的所有设备发送消息，调用方需持有读锁
+func (cm *ConnectionManager) sendToUserLocked(userID string, msg WSMessage) bool {
+	sent := false
+	for conn := range cm.connections[userID] {
+		if conn.Send(msg) {
+			sent = true
+		}
+	}
+	return sent
 }
 
 // Broadcast 广播消息给所有在线用户
@@ -137,13 +176,13 @@ func (cm *ConnectionManager) Broadcast(msg WSMessage, excludeUserIDs ...string)
 
 	// 统计成功发送的消息数量
 	sentCount := 0
-	for userID, conn := range cm.connections {
+	for userID := range cm.connections {
 		// 跳过被排除的用户
 		if exclude[userID] {
 			continue
 		}
 		// 尝试发送消息
-		if conn.Send(msg) {
+		if cm.sendToUserLocked(userID, msg) {
 			sentCount++
 		}
 	}
@@ -159,8 +198,8 @@ func (cm *ConnectionManager) GetOnlineUserCount() int {
 	defer cm.mu.RUnlock()
 
 	count := 0
-	for _, conn := range cm.connections {
-		if !conn.IsClosed() {
+	for userID := range cm.connections {
+		if cm.isOnlineLocked(userID) {
 			count++
 		}
 	}
@@ -175,8 +214,8 @@ func (cm *ConnectionManager) GetOnlineUserIDs() []string {
 	defer cm.mu.RUnlock()
 
 	userIDs := make([]string, 0, len(cm.connections))
-	for userID, conn := range cm.connections {
-		if !conn.IsClosed() {
+	for userID := range cm.connections {
+		if cm.isOnlineLocked(userID) {
 			userIDs = append(userIDs, userID)
 		}
 	}
@@ -206,7 +245,7 @@ func (cm *ConnectionManager) BroadcastToGroup(msg WSMessage, targetUserIDs []str
 
 	// 统计成功发送的消息数量
 	sentCount := 0
-	for userID, conn := range cm.connections {
+	for userID := range cm.connections {
 		// 只向目标用户发送
 		if !targetSet[userID] {
 			continue
@@ -216,7 +255,7 @@ func (cm *ConnectionManager) BroadcastToGroup(msg WSMessage, targetUserIDs []str
 			continue
 		}
 		// 尝试发送消息
-		if conn.Send(msg) {
+		if cm.sendToUserLocked(userID, msg) {
 			sentCount++
 		}
 	}
@@ -232,8 +271,10 @@ func (cm *ConnectionManager) CloseAll() {
 
 	logger.GetLogger().Infow("Closing all connections", "count", len(cm.connections))
 
-	for userID, conn := range cm.connections {
-		conn.Close()
+	for userID, userConns := range cm.connections {
+		for conn := range userConns {
+			conn.Close()
+		}
 		delete(cm.connections, userID)
 		logger.GetLogger().Infow("Connection closed", "user_id", userID)
 	}
@@ -251,20 +292,25 @@ func (cm *ConnectionManager) CleanupStaleConnections(timeout time.Duration) {
 	now := time.Now()
 	cleanedCount := 0
 
-	for userID, conn := range cm.connections {
-		// 清理已关闭的连接
-		if conn.IsClosed() {
-			delete(cm.connections, userID)
-			cleanedCount++
-			continue
+	for userID, userConns := range cm.connections {
+		for conn := range userConns {
+			// 清理已关闭的连接
+			if conn.IsClosed() {
+				delete(userConns, conn)
+				cleanedCount++
+				continue
+			}
+
+			// 清理超时的连接
+			if now.Sub(conn.ConnectedAt) > timeout {
+				conn.Close()
+				delete(userConns, conn)
+				cleanedCount++
+				logger.GetLogger().Infow("Stale connection removed", "user_id", userID, "connected_at", conn.ConnectedAt)
+			}
 		}
-
-		// 清理超时的连接
-		if now.Sub(conn.ConnectedAt) > timeout {
-			conn.Close()
+		if len(userConns) == 0 {
 			delete(cm.connections, userID)
-			cleanedCount++
-			logger.GetLogger().Infow("Stale connection removed", "user_id", userID, "connected_at", conn.ConnectedAt)
 		}
 	}
 
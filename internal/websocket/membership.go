@@ -0,0 +1,91 @@
+package websocket
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/dto"
+	"chat_backend/internal/model"
+	"chat_backend/internal/service"
+	"chat_backend/pkg/logger"
+	"context"
+)
+
+// NotifyGroupMemberChange 使群组的成员缓存失效，推送成员变化时在事务中存储的系统消息，并向群组成员推送成员变化事件。
+// 需要在成员变化的事务提交之后调用；退出和被移除的成员已不在群组中，单独向其推送事件
+// 参数:
+//   - ctx: 上下文
+//   - event: 成员变化的 service 方法返回的事件，为空时不推送
+func NotifyGroupMemberChange(ctx context.Context, event *dto.GroupMemberEvent) {
+	if event == nil {
+		return
+	}
+	invalidateGroupMembers(ctx, event.GroupID)
+
+	if event.MessageID != "" {
+		deliverGroupMemberMessage(ctx, event)
+	}
+
+	BroadcastEventToGroup(ctx, event.GroupID, MessageTypeGroupMember, event)
+	if event.Action == service.GroupMemberLeft || event.Action == service.GroupMemberRemoved {
+		SendEventToUser(event.UserID, MessageTypeGroupMember, event)
+	}
+}
+
+// deliverGroupMemberMessage 将成员变化的系统消息推送给在线的群成员，并将其回执标记为已送达，离线成员上线后再拉取
+func deliverGroupMemberMessage(ctx context.Context, event *dto.GroupMemberEvent) {
+	messageService := service.NewMessageService(database.GetDB())
+	message, err := messageService.GetMessage(ctx, event.MessageID)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to get group member message", "group_id", event.GroupID, "message_id", event.MessageID, "error", err)
+		return
+	}
+
+	recipientIDs, onlineUserIDs, err := GetMessageRecipients(ctx, model.MessageTypeGroup, event.GroupID, "")
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to get group members", "group_id", event.GroupID, "error", err)
+		return
+	}
+
+	DeliverMessage(message, nil, recipientIDs)
+
+	onlineIDs := make([]string, 0, len(recipientIDs))
+	for _, recipientID := range recipientIDs {
+		if onlineUserIDs[recipientID] {
+			onlineIDs = append(onlineIDs, recipientID)
+		}
+	}
+	if err := messageService.MarkMessageDeliveredTo(ctx, message.ID, onlineIDs); err != nil {
+		logger.GetLogger().Errorw("Failed to mark group member message delivered", "message_id", message.ID, "error", err)
+	}
+}
+
+// NotifyGroupDisbanded 使群组的成员缓存失效，并向解散前的群组成员推送群组解散事件，群组已删除，不再存储系统消息
+// 参数:
+//   - ctx: 上下文
+//   - groupID: 群组ID
+//   - operatorID: 解散群组的群主
+//   - memberIDs: 解散前的成员ID列表
+func NotifyGroupDisbanded(ctx context.Context, groupID string, operatorID string, memberIDs []string) {
+	invalidateGroupMembers(ctx, groupID)
+
+	event, err := service.NewGroupService(database.GetDB()).NewGroupMemberEvent(ctx, groupID, service.GroupMemberDisbanded, "", operatorID)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to build group member event", "group_id", groupID, "action", service.GroupMemberDisbanded, "error", err)
+		return
+	}
+
+	msg, err := NewEventMessage(MessageTypeGroupMember, groupID, event)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to build event message", "type", MessageTypeGroupMember, "group_id", groupID, "error", err)
+		return
+	}
+	msg.ChatType = ChatTypeGroup
+
+	GetConnectionManager().BroadcastToGroup(msg, memberIDs)
+}
+
+// invalidateGroupMembers 递增群组的成员版本，之后推送消息时重新读取成员
+func invalidateGroupMembers(ctx context.Context, groupID string) {
+	if err := service.NewGroupMemberService(database.GetDB(), database.GetRedis()).InvalidateMemberCache(ctx, groupID); err != nil {
+		logger.GetLogger().Errorw("Failed to invalidate group member cache", "group_id", groupID, "error", err)
+	}
+}
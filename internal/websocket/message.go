@@ -1,5 +1,7 @@
 package websocket
 
+import "encoding/json"
+
 // MessageType 定义了WebSocket消息的类型
 // 用于区分不同类型的消息内容
 type MessageType string
@@ -19,6 +21,36 @@ const (
 	MessageTypeAck MessageType = "ack"
 	// MessageTypeConnected 连接成功消息，用于通知客户端连接已建立
 	MessageTypeConnected MessageType = "connected"
+	// MessageTypeConversationSync 会话设置同步消息，用于在用户的多个设备间同步置顶、归档等设置
+	MessageTypeConversationSync MessageType = "conversation_sync"
+	// MessageTypePoll 投票消息，Payload 中包含投票详情
+	MessageTypePoll MessageType = "poll"
+	// MessageTypePollUpdate 投票结果更新消息，投票或关闭后推送给群组在线成员
+	MessageTypePollUpdate MessageType = "poll_update"
+	// MessageTypeCard 交互式卡片消息，Payload 中包含卡片内容
+	MessageTypeCard MessageType = "card"
+	// MessageTypeCardUpdate 卡片更新消息，卡片被按钮点击更新后推送给会话中的所有在线用户
+	MessageTypeCardUpdate MessageType = "card_update"
+	// MessageTypeCommandResponse 斜杠命令的回复，仅调用者可见
+	MessageTypeCommandResponse MessageType = "command_response"
+	// MessageTypeCommand 转发给机器人的命令调用，Payload 中包含调用详情
+	MessageTypeCommand MessageType = "command"
+	// MessageTypeReminder 到期的提醒，Payload 中包含提醒详情
+	MessageTypeReminder MessageType = "reminder"
+	// MessageTypeAssistantDelta 群组助手流式回复的增量更新，Payload 中包含新增的内容
+	MessageTypeAssistantDelta MessageType = "assistant_delta"
+	// MessageTypeGroupModeration 群组禁言或慢速模式变化事件，推送给群组所有在线成员
+	MessageTypeGroupModeration MessageType = "group_moderation"
+	// MessageTypeGroupProfile 群组简介、头像、标签或公告变化事件，推送给群组所有在线成员
+	MessageTypeGroupProfile MessageType = "group_profile"
+	// MessageTypeGroupTopic 论坛话题创建或置顶变化事件，推送给群组所有在线成员
+	MessageTypeGroupTopic MessageType = "group_topic"
+	// MessageTypeGroupNickname 成员群昵称变化事件，推送给群组所有在线成员
+	MessageTypeGroupNickname MessageType = "group_nickname"
+	// MessageTypeGroupMember 成员加入、退出、被移除、群主转让和群组解散事件，推送给群组成员和退出的成员
+	MessageTypeGroupMember MessageType = "group_member"
+	// MessageTypeGroupJoinRequest 入群申请提交、审批、过期和撤回事件，推送给申请者和有审批权限的成员
+	MessageTypeGroupJoinRequest MessageType = "group_join_request"
 )
 
 // ChatType 定义了聊天的类型
@@ -45,14 +77,20 @@ type WSMessage struct {
 	FromUsername string `json:"fromUsername,omitempty"`
 	// FromAvatar 发送者头像URL
 	FromAvatar string `json:"fromAvatar,omitempty"`
+	// FromBot 发送者是否为机器人
+	FromBot bool `json:"fromBot,omitempty"`
 	// To 接收者用户ID（私聊）或群组ID（群聊）
 	To string `json:"to"`
 	// Content 消息内容
 	Content string `json:"content"`
+	// TopicID 论坛模式群组中消息所属的话题ID（可选）
+	TopicID string `json:"topicId,omitempty"`
 	// MessageID 消息唯一标识符，用于消息去重和确认
 	MessageID string `json:"messageId"`
 	// Timestamp 消息发送时间戳（毫秒）
 	Timestamp int64 `json:"timestamp"`
+	// Payload 结构化的附加数据（可选），用于会话同步等事件消息
+	Payload json.RawMessage `json:"payload,omitempty"`
 }
 
 // AckMessage 确认消息结构体
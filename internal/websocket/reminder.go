@@ -0,0 +1,74 @@
+package websocket
+
+import (
+	"chat_backend/internal/database"
+	"chat_backend/internal/service"
+	"chat_backend/pkg/logger"
+	"context"
+	"time"
+)
+
+const reminderPollInterval = 5 * time.Second
+
+// StartReminderWorker 启动提醒推送任务，定期触发到期的提醒并推送给在线用户，直到 ctx 取消
+// 用户离线时提醒保持未送达状态，在下次连接时推送
+func StartReminderWorker(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(reminderPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				fireDueReminders(ctx)
+			}
+		}
+	}()
+}
+
+func fireDueReminders(ctx context.Context) {
+	commandService := service.NewCommandService(database.GetDB())
+	reminders, err := commandService.FireDueReminders(ctx)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to fire reminders", "error", err)
+		return
+	}
+
+	deliveredIDs := make([]string, 0, len(reminders))
+	for _, reminder := range reminders {
+		if SendEventToUser(reminder.UserID, MessageTypeReminder, reminder) {
+			deliveredIDs = append(deliveredIDs, reminder.ReminderID)
+		}
+	}
+
+	if err := commandService.MarkRemindersDelivered(ctx, deliveredIDs); err != nil {
+		logger.GetLogger().Errorw("Failed to mark reminders as delivered", "error", err)
+	}
+}
+
+// deliverPendingReminders 向刚连接的用户推送离线期间到期的提醒
+func deliverPendingReminders(ctx context.Context, conn *UserConnection) {
+	commandService := service.NewCommandService(database.GetDB())
+	reminders, err := commandService.GetUndeliveredReminders(ctx, conn.UserID)
+	if err != nil {
+		logger.GetLogger().Errorw("Failed to get undelivered reminders", "user_id", conn.UserID, "error", err)
+		return
+	}
+
+	deliveredIDs := make([]string, 0, len(reminders))
+	for _, reminder := range reminders {
+		msg, err := NewEventMessage(MessageTypeReminder, conn.UserID, reminder)
+		if err != nil {
+			logger.GetLogger().Errorw("Failed to build reminder event", "reminder_id", reminder.ReminderID, "error", err)
+			continue
+		}
+		conn.Send(msg)
+		deliveredIDs = append(deliveredIDs, reminder.ReminderID)
+	}
+
+	if err := commandService.MarkRemindersDelivered(ctx, deliveredIDs); err != nil {
+		logger.GetLogger().Errorw("Failed to mark reminders as delivered", "user_id", conn.UserID, "error", err)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"chat_backend/internal/database"
 	"chat_backend/internal/middleware"
 	"chat_backend/internal/router"
+	"chat_backend/internal/service"
+	"chat_backend/internal/websocket"
 	"chat_backend/pkg/logger"
 	"context"
 	"errors"
@@ -12,6 +14,8 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -21,6 +25,11 @@ func main() {
 	// 解析命令行参数
 	var migrateFlag = flag.Bool("migrate", false, "执行数据库迁移")
 	var resetFlag = flag.Bool("reset-db", false, "重置数据库（删除并重新创建所有表）")
+	var importFlag = flag.String("import", "", "从 JSON 或 NDJSON 文件批量导入用户、群组和聊天记录")
+	var importDryRunFlag = flag.Bool("import-dry-run", false, "仅校验导入文件并输出统计，不写入数据库")
+	var importResumeFlag = flag.Bool("import-resume", false, "从上次中断的位置继续导入")
+	var setOrganizationFlag = flag.String("set-organization", "", "设置指定用户名的用户所属的组织，与 --organization 一起使用")
+	var organizationFlag = flag.String("organization", "", "用户所属的组织，为空时清除")
 	flag.Parse()
 
 	// 加载配置
@@ -71,8 +80,52 @@ func main() {
 		os.Exit(0)
 	}
 
+	if *importFlag != "" {
+		// 批量导入历史数据
+		logger.GetLogger().Infow("正在导入历史数据...", "file", *importFlag, "dry_run", *importDryRunFlag, "resume", *importResumeFlag)
+		report, err := service.NewImportService(database.GetDB()).Import(context.Background(), *importFlag, service.ImportOptions{
+			DryRun: *importDryRunFlag,
+			Resume: *importResumeFlag,
+		})
+		if report != nil {
+			logger.GetLogger().Infow("导入统计",
+				"job_id", report.JobID,
+				"dry_run", report.DryRun,
+				"skipped", report.Skipped,
+				"processed", report.Processed,
+				"users_created", report.UsersCreated,
+				"users_matched", report.UsersMatched,
+				"groups_created", report.GroupsCreated,
+				"members_added", report.MembersAdded,
+				"messages_added", report.MessagesAdded,
+				"invalid", report.Invalid,
+			)
+			for _, validationErr := range report.ValidationErrs {
+				logger.GetLogger().Warnw("导入记录校验失败", "error", validationErr)
+			}
+		}
+		if err != nil {
+			logger.GetLogger().Fatalw("导入历史数据失败", "error", err)
+		}
+		logger.GetLogger().Infow("导入历史数据完成")
+		os.Exit(0)
+	}
+
+	if *setOrganizationFlag != "" {
+		// 设置用户所属的组织，用于入群自动审批规则
+		err := service.NewUserService(database.GetDB()).SetOrganization(context.Background(), *setOrganizationFlag, *organizationFlag)
+		if err != nil {
+			logger.GetLogger().Fatalw("设置用户组织失败", "username", *setOrganizationFlag, "error", err)
+		}
+		logger.GetLogger().Infow("设置用户组织成功", "username", *setOrganizationFlag, "organization", service.NormalizeOrganization(*organizationFlag))
+		os.Exit(0)
+	}
+
+	// 收到 SIGINT / SIGTERM 时取消 ctx，后台任务随之停止，服务器优雅关闭
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	// 数据库健康检查
-	ctx := context.Background()
 	status := database.HealthCheck(ctx)
 	for service, state := range status {
 		if state != "healthy" {
@@ -89,10 +142,36 @@ func main() {
 		time.Duration(cfg.JWT.RefreshExpiry)*time.Hour,
 	)
 
-	startServer(cfg)
+	// 初始化机器人令牌认证
+	middleware.InitBotAuth(service.NewBotService(database.GetDB()).AuthenticateBotToken)
+
+	// 启动 Webhook 投递任务
+	service.NewWebhookService(database.GetDB()).StartWebhookWorker(ctx)
+
+	// 启动聊天记录导出任务
+	exportDone := service.NewExportService(database.GetDB()).StartExportWorker(ctx)
+
+	// 启动提醒推送任务
+	websocket.StartReminderWorker(ctx)
+
+	// 启动入群申请过期任务
+	websocket.StartJoinRequestExpiryWorker(ctx)
+
+	startServer(ctx, cfg)
+
+	// 等待正在执行的导出任务结束或放回队列
+	select {
+	case <-exportDone:
+	case <-time.After(shutdownTimeout):
+		logger.GetLogger().Warnw("等待导出任务结束超时")
+	}
 }
 
-func startServer(cfg *config.Config) {
+// shutdownTimeout 关闭服务时等待请求处理完成和导出任务放回队列的最长时间
+const shutdownTimeout = 10 * time.Second
+
+// startServer 启动 HTTP 服务器，ctx 取消后停止接收新请求并等待处理中的请求完成
+func startServer(ctx context.Context, cfg *config.Config) {
 	e := echo.New()
 
 	// 添加中间件
@@ -141,7 +220,18 @@ func startServer(cfg *config.Config) {
 	addr := fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port)
 	logger.GetLogger().Infow("正在启动服务器", "address", addr)
 
-	if err := e.Start(addr); err != nil && !errors.Is(err, http.ErrServerClosed) {
-		logger.GetLogger().Fatalw("启动服务器失败", "error", err)
+	go func() {
+		if err := e.Start(addr); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.GetLogger().Fatalw("启动服务器失败", "error", err)
+		}
+	}()
+
+	<-ctx.Done()
+	logger.GetLogger().Infow("正在关闭服务器")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := e.Shutdown(shutdownCtx); err != nil {
+		logger.GetLogger().Errorw("关闭服务器失败", "error", err)
 	}
 }